@@ -151,9 +151,11 @@ func (x *ChannelItem) GetEnabled() bool {
 }
 
 type ChannelConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channels      []*ChannelItem         `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
-	RetryPolicy   *RetryConfig           `protobuf:"bytes,2,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Channels    []*ChannelItem         `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	RetryPolicy *RetryConfig           `protobuf:"bytes,2,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// 开启渠道降级，主渠道发送失败或被禁用时按优先级尝试其他渠道
+	Fallback      bool `protobuf:"varint,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -202,6 +204,13 @@ func (x *ChannelConfig) GetRetryPolicy() *RetryConfig {
 	return nil
 }
 
+func (x *ChannelConfig) GetFallback() bool {
+	if x != nil {
+		return x.Fallback
+	}
+	return false
+}
+
 type TxnConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
@@ -410,6 +419,303 @@ func (x *CallbackConfig) GetRetryPolicy() *RetryConfig {
 	return nil
 }
 
+// WebhookConfig WEBHOOK 渠道配置
+type WebhookConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 请求体签名密钥
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// 单次请求超时时间，单位毫秒
+	TimeoutMs     int64 `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookConfig) Reset() {
+	*x = WebhookConfig{}
+	mi := &file_config_v1_config_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookConfig) ProtoMessage() {}
+
+func (x *WebhookConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookConfig.ProtoReflect.Descriptor instead.
+func (*WebhookConfig) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WebhookConfig) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookConfig) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// QuietHoursRule 免打扰规则，渠道或业务类型为空时对所有渠道或业务类型生效
+type QuietHoursRule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Channels []string               `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// 业务类型：1 - 推广营销，2 - 通知，验证码不受免打扰限制
+	BusinessTypes []int32 `protobuf:"varint,2,rep,packed,name=business_types,json=businessTypes,proto3" json:"business_types,omitempty"`
+	// 开始和结束时刻，格式 HH:MM，开始晚于结束时跨天
+	Start string `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// IANA 时区名，通知带有接收者时区时以接收者时区为准，为空时使用 UTC
+	Timezone      string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietHoursRule) Reset() {
+	*x = QuietHoursRule{}
+	mi := &file_config_v1_config_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietHoursRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHoursRule) ProtoMessage() {}
+
+func (x *QuietHoursRule) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHoursRule.ProtoReflect.Descriptor instead.
+func (*QuietHoursRule) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QuietHoursRule) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *QuietHoursRule) GetBusinessTypes() []int32 {
+	if x != nil {
+		return x.BusinessTypes
+	}
+	return nil
+}
+
+func (x *QuietHoursRule) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *QuietHoursRule) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *QuietHoursRule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// QuietHoursConfig 免打扰配置，落在免打扰时段内的通知推迟到时段结束后发送
+type QuietHoursConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*QuietHoursRule      `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietHoursConfig) Reset() {
+	*x = QuietHoursConfig{}
+	mi := &file_config_v1_config_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietHoursConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHoursConfig) ProtoMessage() {}
+
+func (x *QuietHoursConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHoursConfig.ProtoReflect.Descriptor instead.
+func (*QuietHoursConfig) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QuietHoursConfig) GetRules() []*QuietHoursRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// FrequencyCapRule 接收者发送频率上限规则，渠道或业务类型为空时对所有渠道或业务类型生效
+type FrequencyCapRule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Channels []string               `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// 业务类型：1 - 推广营销，2 - 通知，3 - 验证码
+	BusinessTypes []int32 `protobuf:"varint,2,rep,packed,name=business_types,json=businessTypes,proto3" json:"business_types,omitempty"`
+	// 滑动窗口时长，单位秒，最长 7 天
+	WindowSeconds int64 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// 窗口内最多发送的次数
+	Max           int32 `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrequencyCapRule) Reset() {
+	*x = FrequencyCapRule{}
+	mi := &file_config_v1_config_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrequencyCapRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrequencyCapRule) ProtoMessage() {}
+
+func (x *FrequencyCapRule) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrequencyCapRule.ProtoReflect.Descriptor instead.
+func (*FrequencyCapRule) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FrequencyCapRule) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *FrequencyCapRule) GetBusinessTypes() []int32 {
+	if x != nil {
+		return x.BusinessTypes
+	}
+	return nil
+}
+
+func (x *FrequencyCapRule) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *FrequencyCapRule) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// FrequencyCapConfig 接收者发送频率上限配置，发送次数按接收者、渠道和业务类型统计
+type FrequencyCapConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*FrequencyCapRule    `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrequencyCapConfig) Reset() {
+	*x = FrequencyCapConfig{}
+	mi := &file_config_v1_config_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrequencyCapConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrequencyCapConfig) ProtoMessage() {}
+
+func (x *FrequencyCapConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrequencyCapConfig.ProtoReflect.Descriptor instead.
+func (*FrequencyCapConfig) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FrequencyCapConfig) GetRules() []*FrequencyCapRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 type BusinessConfig struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OwnerId        int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
@@ -419,13 +725,16 @@ type BusinessConfig struct {
 	ReteLimit      int32                  `protobuf:"varint,5,opt,name=rete_limit,json=reteLimit,proto3" json:"rete_limit,omitempty"`
 	Quota          *QuotaConfig           `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota,omitempty"`
 	CallbackConfig *CallbackConfig        `protobuf:"bytes,7,opt,name=callback_config,json=callbackConfig,proto3" json:"callback_config,omitempty"`
+	WebhookConfig  *WebhookConfig         `protobuf:"bytes,8,opt,name=webhook_config,json=webhookConfig,proto3" json:"webhook_config,omitempty"`
+	QuietHours     *QuietHoursConfig      `protobuf:"bytes,9,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	FrequencyCap   *FrequencyCapConfig    `protobuf:"bytes,10,opt,name=frequency_cap,json=frequencyCap,proto3" json:"frequency_cap,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BusinessConfig) Reset() {
 	*x = BusinessConfig{}
-	mi := &file_config_v1_config_proto_msgTypes[7]
+	mi := &file_config_v1_config_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +746,7 @@ func (x *BusinessConfig) String() string {
 func (*BusinessConfig) ProtoMessage() {}
 
 func (x *BusinessConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[7]
+	mi := &file_config_v1_config_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +759,7 @@ func (x *BusinessConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BusinessConfig.ProtoReflect.Descriptor instead.
 func (*BusinessConfig) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{7}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BusinessConfig) GetOwnerId() int64 {
@@ -502,6 +811,27 @@ func (x *BusinessConfig) GetCallbackConfig() *CallbackConfig {
 	return nil
 }
 
+func (x *BusinessConfig) GetWebhookConfig() *WebhookConfig {
+	if x != nil {
+		return x.WebhookConfig
+	}
+	return nil
+}
+
+func (x *BusinessConfig) GetQuietHours() *QuietHoursConfig {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *BusinessConfig) GetFrequencyCap() *FrequencyCapConfig {
+	if x != nil {
+		return x.FrequencyCap
+	}
+	return nil
+}
+
 type GetByIDsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
@@ -511,7 +841,7 @@ type GetByIDsRequest struct {
 
 func (x *GetByIDsRequest) Reset() {
 	*x = GetByIDsRequest{}
-	mi := &file_config_v1_config_proto_msgTypes[8]
+	mi := &file_config_v1_config_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -523,7 +853,7 @@ func (x *GetByIDsRequest) String() string {
 func (*GetByIDsRequest) ProtoMessage() {}
 
 func (x *GetByIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[8]
+	mi := &file_config_v1_config_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,7 +866,7 @@ func (x *GetByIDsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDsRequest.ProtoReflect.Descriptor instead.
 func (*GetByIDsRequest) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{8}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetByIDsRequest) GetIds() []int64 {
@@ -555,7 +885,7 @@ type GetByIDsResponse struct {
 
 func (x *GetByIDsResponse) Reset() {
 	*x = GetByIDsResponse{}
-	mi := &file_config_v1_config_proto_msgTypes[9]
+	mi := &file_config_v1_config_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +897,7 @@ func (x *GetByIDsResponse) String() string {
 func (*GetByIDsResponse) ProtoMessage() {}
 
 func (x *GetByIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[9]
+	mi := &file_config_v1_config_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +910,7 @@ func (x *GetByIDsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDsResponse.ProtoReflect.Descriptor instead.
 func (*GetByIDsResponse) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{9}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetByIDsResponse) GetConfigs() map[int64]*BusinessConfig {
@@ -599,7 +929,7 @@ type GetByIDRequest struct {
 
 func (x *GetByIDRequest) Reset() {
 	*x = GetByIDRequest{}
-	mi := &file_config_v1_config_proto_msgTypes[10]
+	mi := &file_config_v1_config_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -611,7 +941,7 @@ func (x *GetByIDRequest) String() string {
 func (*GetByIDRequest) ProtoMessage() {}
 
 func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[10]
+	mi := &file_config_v1_config_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +954,7 @@ func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetByIDRequest) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{10}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetByIDRequest) GetId() int64 {
@@ -643,7 +973,7 @@ type GetByIDResponse struct {
 
 func (x *GetByIDResponse) Reset() {
 	*x = GetByIDResponse{}
-	mi := &file_config_v1_config_proto_msgTypes[11]
+	mi := &file_config_v1_config_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -655,7 +985,7 @@ func (x *GetByIDResponse) String() string {
 func (*GetByIDResponse) ProtoMessage() {}
 
 func (x *GetByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[11]
+	mi := &file_config_v1_config_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +998,7 @@ func (x *GetByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetByIDResponse) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{11}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetByIDResponse) GetConfig() *BusinessConfig {
@@ -687,7 +1017,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_config_v1_config_proto_msgTypes[12]
+	mi := &file_config_v1_config_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -699,7 +1029,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[12]
+	mi := &file_config_v1_config_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +1042,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{12}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteRequest) GetId() int64 {
@@ -731,7 +1061,7 @@ type DeleteResponse struct {
 
 func (x *DeleteResponse) Reset() {
 	*x = DeleteResponse{}
-	mi := &file_config_v1_config_proto_msgTypes[13]
+	mi := &file_config_v1_config_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -743,7 +1073,7 @@ func (x *DeleteResponse) String() string {
 func (*DeleteResponse) ProtoMessage() {}
 
 func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[13]
+	mi := &file_config_v1_config_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +1086,7 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{13}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeleteResponse) GetSuccess() bool {
@@ -775,7 +1105,7 @@ type SaveConfigRequest struct {
 
 func (x *SaveConfigRequest) Reset() {
 	*x = SaveConfigRequest{}
-	mi := &file_config_v1_config_proto_msgTypes[14]
+	mi := &file_config_v1_config_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -787,7 +1117,7 @@ func (x *SaveConfigRequest) String() string {
 func (*SaveConfigRequest) ProtoMessage() {}
 
 func (x *SaveConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[14]
+	mi := &file_config_v1_config_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +1130,7 @@ func (x *SaveConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveConfigRequest.ProtoReflect.Descriptor instead.
 func (*SaveConfigRequest) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{14}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SaveConfigRequest) GetConfig() *BusinessConfig {
@@ -819,7 +1149,7 @@ type SaveConfigResponse struct {
 
 func (x *SaveConfigResponse) Reset() {
 	*x = SaveConfigResponse{}
-	mi := &file_config_v1_config_proto_msgTypes[15]
+	mi := &file_config_v1_config_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -831,7 +1161,7 @@ func (x *SaveConfigResponse) String() string {
 func (*SaveConfigResponse) ProtoMessage() {}
 
 func (x *SaveConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[15]
+	mi := &file_config_v1_config_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +1174,7 @@ func (x *SaveConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveConfigResponse.ProtoReflect.Descriptor instead.
 func (*SaveConfigResponse) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{15}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SaveConfigResponse) GetSuccess() bool {
@@ -867,10 +1197,11 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\vChannelItem\x12\x18\n" +
 	"\achannel\x18\x01 \x01(\tR\achannel\x12\x1a\n" +
 	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12\x18\n" +
-	"\aenabled\x18\x03 \x01(\bR\aenabled\"~\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"\x9a\x01\n" +
 	"\rChannelConfig\x122\n" +
 	"\bchannels\x18\x01 \x03(\v2\x16.config.v1.ChannelItemR\bchannels\x129\n" +
-	"\fretry_policy\x18\x02 \x01(\v2\x16.config.v1.RetryConfigR\vretryPolicy\"\x8e\x01\n" +
+	"\fretry_policy\x18\x02 \x01(\v2\x16.config.v1.RetryConfigR\vretryPolicy\x12\x1a\n" +
+	"\bfallback\x18\x03 \x01(\bR\bfallback\"\x8e\x01\n" +
 	"\tTxnConfig\x12!\n" +
 	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x12#\n" +
 	"\rinitial_delay\x18\x02 \x01(\x05R\finitialDelay\x129\n" +
@@ -882,7 +1213,26 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\amonthly\x18\x01 \x01(\v2\x18.config.v1.MonthlyConfigR\amonthly\"n\n" +
 	"\x0eCallbackConfig\x12!\n" +
 	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x129\n" +
-	"\fretry_policy\x18\x02 \x01(\v2\x16.config.v1.RetryConfigR\vretryPolicy\"\xd1\x02\n" +
+	"\fretry_policy\x18\x02 \x01(\v2\x16.config.v1.RetryConfigR\vretryPolicy\"F\n" +
+	"\rWebhookConfig\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x02 \x01(\x03R\ttimeoutMs\"\x97\x01\n" +
+	"\x0eQuietHoursRule\x12\x1a\n" +
+	"\bchannels\x18\x01 \x03(\tR\bchannels\x12%\n" +
+	"\x0ebusiness_types\x18\x02 \x03(\x05R\rbusinessTypes\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\"C\n" +
+	"\x10QuietHoursConfig\x12/\n" +
+	"\x05rules\x18\x01 \x03(\v2\x19.config.v1.QuietHoursRuleR\x05rules\"\x8e\x01\n" +
+	"\x10FrequencyCapRule\x12\x1a\n" +
+	"\bchannels\x18\x01 \x03(\tR\bchannels\x12%\n" +
+	"\x0ebusiness_types\x18\x02 \x03(\x05R\rbusinessTypes\x12%\n" +
+	"\x0ewindow_seconds\x18\x03 \x01(\x03R\rwindowSeconds\x12\x10\n" +
+	"\x03max\x18\x04 \x01(\x05R\x03max\"G\n" +
+	"\x12FrequencyCapConfig\x121\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1b.config.v1.FrequencyCapRuleR\x05rules\"\x94\x04\n" +
 	"\x0eBusinessConfig\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x1d\n" +
 	"\n" +
@@ -893,7 +1243,12 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\n" +
 	"rete_limit\x18\x05 \x01(\x05R\treteLimit\x12,\n" +
 	"\x05quota\x18\x06 \x01(\v2\x16.config.v1.QuotaConfigR\x05quota\x12B\n" +
-	"\x0fcallback_config\x18\a \x01(\v2\x19.config.v1.CallbackConfigR\x0ecallbackConfig\"#\n" +
+	"\x0fcallback_config\x18\a \x01(\v2\x19.config.v1.CallbackConfigR\x0ecallbackConfig\x12?\n" +
+	"\x0ewebhook_config\x18\b \x01(\v2\x18.config.v1.WebhookConfigR\rwebhookConfig\x12<\n" +
+	"\vquiet_hours\x18\t \x01(\v2\x1b.config.v1.QuietHoursConfigR\n" +
+	"quietHours\x12B\n" +
+	"\rfrequency_cap\x18\n" +
+	" \x01(\v2\x1d.config.v1.FrequencyCapConfigR\ffrequencyCap\"#\n" +
 	"\x0fGetByIDsRequest\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\x03R\x03ids\"\xad\x01\n" +
 	"\x10GetByIDsResponse\x12B\n" +
@@ -934,7 +1289,7 @@ func file_config_v1_config_proto_rawDescGZIP() []byte {
 	return file_config_v1_config_proto_rawDescData
 }
 
-var file_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_config_v1_config_proto_goTypes = []any{
 	(*RetryConfig)(nil),        // 0: config.v1.RetryConfig
 	(*ChannelItem)(nil),        // 1: config.v1.ChannelItem
@@ -943,16 +1298,21 @@ var file_config_v1_config_proto_goTypes = []any{
 	(*MonthlyConfig)(nil),      // 4: config.v1.MonthlyConfig
 	(*QuotaConfig)(nil),        // 5: config.v1.QuotaConfig
 	(*CallbackConfig)(nil),     // 6: config.v1.CallbackConfig
-	(*BusinessConfig)(nil),     // 7: config.v1.BusinessConfig
-	(*GetByIDsRequest)(nil),    // 8: config.v1.GetByIDsRequest
-	(*GetByIDsResponse)(nil),   // 9: config.v1.GetByIDsResponse
-	(*GetByIDRequest)(nil),     // 10: config.v1.GetByIDRequest
-	(*GetByIDResponse)(nil),    // 11: config.v1.GetByIDResponse
-	(*DeleteRequest)(nil),      // 12: config.v1.DeleteRequest
-	(*DeleteResponse)(nil),     // 13: config.v1.DeleteResponse
-	(*SaveConfigRequest)(nil),  // 14: config.v1.SaveConfigRequest
-	(*SaveConfigResponse)(nil), // 15: config.v1.SaveConfigResponse
-	nil,                        // 16: config.v1.GetByIDsResponse.ConfigsEntry
+	(*WebhookConfig)(nil),      // 7: config.v1.WebhookConfig
+	(*QuietHoursRule)(nil),     // 8: config.v1.QuietHoursRule
+	(*QuietHoursConfig)(nil),   // 9: config.v1.QuietHoursConfig
+	(*FrequencyCapRule)(nil),   // 10: config.v1.FrequencyCapRule
+	(*FrequencyCapConfig)(nil), // 11: config.v1.FrequencyCapConfig
+	(*BusinessConfig)(nil),     // 12: config.v1.BusinessConfig
+	(*GetByIDsRequest)(nil),    // 13: config.v1.GetByIDsRequest
+	(*GetByIDsResponse)(nil),   // 14: config.v1.GetByIDsResponse
+	(*GetByIDRequest)(nil),     // 15: config.v1.GetByIDRequest
+	(*GetByIDResponse)(nil),    // 16: config.v1.GetByIDResponse
+	(*DeleteRequest)(nil),      // 17: config.v1.DeleteRequest
+	(*DeleteResponse)(nil),     // 18: config.v1.DeleteResponse
+	(*SaveConfigRequest)(nil),  // 19: config.v1.SaveConfigRequest
+	(*SaveConfigResponse)(nil), // 20: config.v1.SaveConfigResponse
+	nil,                        // 21: config.v1.GetByIDsResponse.ConfigsEntry
 }
 var file_config_v1_config_proto_depIdxs = []int32{
 	1,  // 0: config.v1.ChannelConfig.channels:type_name -> config.v1.ChannelItem
@@ -960,27 +1320,32 @@ var file_config_v1_config_proto_depIdxs = []int32{
 	0,  // 2: config.v1.TxnConfig.retry_policy:type_name -> config.v1.RetryConfig
 	4,  // 3: config.v1.QuotaConfig.monthly:type_name -> config.v1.MonthlyConfig
 	0,  // 4: config.v1.CallbackConfig.retry_policy:type_name -> config.v1.RetryConfig
-	2,  // 5: config.v1.BusinessConfig.channel_config:type_name -> config.v1.ChannelConfig
-	3,  // 6: config.v1.BusinessConfig.txn_config:type_name -> config.v1.TxnConfig
-	5,  // 7: config.v1.BusinessConfig.quota:type_name -> config.v1.QuotaConfig
-	6,  // 8: config.v1.BusinessConfig.callback_config:type_name -> config.v1.CallbackConfig
-	16, // 9: config.v1.GetByIDsResponse.configs:type_name -> config.v1.GetByIDsResponse.ConfigsEntry
-	7,  // 10: config.v1.GetByIDResponse.config:type_name -> config.v1.BusinessConfig
-	7,  // 11: config.v1.SaveConfigRequest.config:type_name -> config.v1.BusinessConfig
-	7,  // 12: config.v1.GetByIDsResponse.ConfigsEntry.value:type_name -> config.v1.BusinessConfig
-	8,  // 13: config.v1.BusinessConfigService.GetByIDs:input_type -> config.v1.GetByIDsRequest
-	10, // 14: config.v1.BusinessConfigService.GetByID:input_type -> config.v1.GetByIDRequest
-	12, // 15: config.v1.BusinessConfigService.Delete:input_type -> config.v1.DeleteRequest
-	14, // 16: config.v1.BusinessConfigService.SaveConfig:input_type -> config.v1.SaveConfigRequest
-	9,  // 17: config.v1.BusinessConfigService.GetByIDs:output_type -> config.v1.GetByIDsResponse
-	11, // 18: config.v1.BusinessConfigService.GetByID:output_type -> config.v1.GetByIDResponse
-	13, // 19: config.v1.BusinessConfigService.Delete:output_type -> config.v1.DeleteResponse
-	15, // 20: config.v1.BusinessConfigService.SaveConfig:output_type -> config.v1.SaveConfigResponse
-	17, // [17:21] is the sub-list for method output_type
-	13, // [13:17] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	8,  // 5: config.v1.QuietHoursConfig.rules:type_name -> config.v1.QuietHoursRule
+	10, // 6: config.v1.FrequencyCapConfig.rules:type_name -> config.v1.FrequencyCapRule
+	2,  // 7: config.v1.BusinessConfig.channel_config:type_name -> config.v1.ChannelConfig
+	3,  // 8: config.v1.BusinessConfig.txn_config:type_name -> config.v1.TxnConfig
+	5,  // 9: config.v1.BusinessConfig.quota:type_name -> config.v1.QuotaConfig
+	6,  // 10: config.v1.BusinessConfig.callback_config:type_name -> config.v1.CallbackConfig
+	7,  // 11: config.v1.BusinessConfig.webhook_config:type_name -> config.v1.WebhookConfig
+	9,  // 12: config.v1.BusinessConfig.quiet_hours:type_name -> config.v1.QuietHoursConfig
+	11, // 13: config.v1.BusinessConfig.frequency_cap:type_name -> config.v1.FrequencyCapConfig
+	21, // 14: config.v1.GetByIDsResponse.configs:type_name -> config.v1.GetByIDsResponse.ConfigsEntry
+	12, // 15: config.v1.GetByIDResponse.config:type_name -> config.v1.BusinessConfig
+	12, // 16: config.v1.SaveConfigRequest.config:type_name -> config.v1.BusinessConfig
+	12, // 17: config.v1.GetByIDsResponse.ConfigsEntry.value:type_name -> config.v1.BusinessConfig
+	13, // 18: config.v1.BusinessConfigService.GetByIDs:input_type -> config.v1.GetByIDsRequest
+	15, // 19: config.v1.BusinessConfigService.GetByID:input_type -> config.v1.GetByIDRequest
+	17, // 20: config.v1.BusinessConfigService.Delete:input_type -> config.v1.DeleteRequest
+	19, // 21: config.v1.BusinessConfigService.SaveConfig:input_type -> config.v1.SaveConfigRequest
+	14, // 22: config.v1.BusinessConfigService.GetByIDs:output_type -> config.v1.GetByIDsResponse
+	16, // 23: config.v1.BusinessConfigService.GetByID:output_type -> config.v1.GetByIDResponse
+	18, // 24: config.v1.BusinessConfigService.Delete:output_type -> config.v1.DeleteResponse
+	20, // 25: config.v1.BusinessConfigService.SaveConfig:output_type -> config.v1.SaveConfigResponse
+	22, // [22:26] is the sub-list for method output_type
+	18, // [18:22] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_config_v1_config_proto_init() }
@@ -994,7 +1359,7 @@ func file_config_v1_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_config_proto_rawDesc), len(file_config_v1_config_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -332,6 +332,8 @@ func (m *ChannelConfig) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Fallback
+
 	if len(errors) > 0 {
 		return ChannelConfigMultiError(errors)
 	}
@@ -905,123 +907,707 @@ var _ interface {
 	ErrorName() string
 } = CallbackConfigValidationError{}
 
-// Validate checks the field values on BusinessConfig with the rules defined in
+// Validate checks the field values on WebhookConfig with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *BusinessConfig) Validate() error {
+func (m *WebhookConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BusinessConfig with the rules defined
+// ValidateAll checks the field values on WebhookConfig with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BusinessConfigMultiError,
+// result is a list of violation errors wrapped in WebhookConfigMultiError, or
+// nil if none found.
+func (m *WebhookConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for TimeoutMs
+
+	if len(errors) > 0 {
+		return WebhookConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookConfigMultiError is an error wrapping multiple validation errors
+// returned by WebhookConfig.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookConfigMultiError) AllErrors() []error { return m }
+
+// WebhookConfigValidationError is the validation error returned by
+// WebhookConfig.Validate if the designated constraints aren't met.
+type WebhookConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookConfigValidationError) ErrorName() string { return "WebhookConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookConfigValidationError{}
+
+// Validate checks the field values on QuietHoursRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuietHoursRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuietHoursRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuietHoursRuleMultiError,
 // or nil if none found.
-func (m *BusinessConfig) ValidateAll() error {
+func (m *QuietHoursRule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BusinessConfig) validate(all bool) error {
+func (m *QuietHoursRule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OwnerId
+	// no validation rules for Start
 
-	// no validation rules for OwnerType
+	// no validation rules for End
 
-	if all {
-		switch v := interface{}(m.GetChannelConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BusinessConfigValidationError{
-					field:  "ChannelConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, BusinessConfigValidationError{
-					field:  "ChannelConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetChannelConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BusinessConfigValidationError{
-				field:  "ChannelConfig",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for Timezone
+
+	if len(errors) > 0 {
+		return QuietHoursRuleMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetTxnConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BusinessConfigValidationError{
-					field:  "TxnConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, BusinessConfigValidationError{
-					field:  "TxnConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTxnConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BusinessConfigValidationError{
-				field:  "TxnConfig",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// QuietHoursRuleMultiError is an error wrapping multiple validation errors
+// returned by QuietHoursRule.ValidateAll() if the designated constraints
+// aren't met.
+type QuietHoursRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuietHoursRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for ReteLimit
+// AllErrors returns a list of validation violation errors.
+func (m QuietHoursRuleMultiError) AllErrors() []error { return m }
 
-	if all {
-		switch v := interface{}(m.GetQuota()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BusinessConfigValidationError{
-					field:  "Quota",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+// QuietHoursRuleValidationError is the validation error returned by
+// QuietHoursRule.Validate if the designated constraints aren't met.
+type QuietHoursRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuietHoursRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuietHoursRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuietHoursRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuietHoursRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuietHoursRuleValidationError) ErrorName() string { return "QuietHoursRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuietHoursRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuietHoursRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuietHoursRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuietHoursRuleValidationError{}
+
+// Validate checks the field values on QuietHoursConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QuietHoursConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuietHoursConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QuietHoursConfigMultiError, or nil if none found.
+func (m *QuietHoursConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuietHoursConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuietHoursConfigValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuietHoursConfigValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, BusinessConfigValidationError{
-					field:  "Quota",
+				return QuietHoursConfigValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BusinessConfigValidationError{
-				field:  "Quota",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetCallbackConfig()).(type) {
+	if len(errors) > 0 {
+		return QuietHoursConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuietHoursConfigMultiError is an error wrapping multiple validation errors
+// returned by QuietHoursConfig.ValidateAll() if the designated constraints
+// aren't met.
+type QuietHoursConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuietHoursConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuietHoursConfigMultiError) AllErrors() []error { return m }
+
+// QuietHoursConfigValidationError is the validation error returned by
+// QuietHoursConfig.Validate if the designated constraints aren't met.
+type QuietHoursConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuietHoursConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuietHoursConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuietHoursConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuietHoursConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuietHoursConfigValidationError) ErrorName() string { return "QuietHoursConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuietHoursConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuietHoursConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuietHoursConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuietHoursConfigValidationError{}
+
+// Validate checks the field values on FrequencyCapRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FrequencyCapRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FrequencyCapRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FrequencyCapRuleMultiError, or nil if none found.
+func (m *FrequencyCapRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FrequencyCapRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WindowSeconds
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return FrequencyCapRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// FrequencyCapRuleMultiError is an error wrapping multiple validation errors
+// returned by FrequencyCapRule.ValidateAll() if the designated constraints
+// aren't met.
+type FrequencyCapRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FrequencyCapRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FrequencyCapRuleMultiError) AllErrors() []error { return m }
+
+// FrequencyCapRuleValidationError is the validation error returned by
+// FrequencyCapRule.Validate if the designated constraints aren't met.
+type FrequencyCapRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FrequencyCapRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FrequencyCapRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FrequencyCapRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FrequencyCapRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FrequencyCapRuleValidationError) ErrorName() string { return "FrequencyCapRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FrequencyCapRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFrequencyCapRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FrequencyCapRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FrequencyCapRuleValidationError{}
+
+// Validate checks the field values on FrequencyCapConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FrequencyCapConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FrequencyCapConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FrequencyCapConfigMultiError, or nil if none found.
+func (m *FrequencyCapConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FrequencyCapConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FrequencyCapConfigValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FrequencyCapConfigValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FrequencyCapConfigValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FrequencyCapConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// FrequencyCapConfigMultiError is an error wrapping multiple validation errors
+// returned by FrequencyCapConfig.ValidateAll() if the designated constraints
+// aren't met.
+type FrequencyCapConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FrequencyCapConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FrequencyCapConfigMultiError) AllErrors() []error { return m }
+
+// FrequencyCapConfigValidationError is the validation error returned by
+// FrequencyCapConfig.Validate if the designated constraints aren't met.
+type FrequencyCapConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FrequencyCapConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FrequencyCapConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FrequencyCapConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FrequencyCapConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FrequencyCapConfigValidationError) ErrorName() string {
+	return "FrequencyCapConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FrequencyCapConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFrequencyCapConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FrequencyCapConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FrequencyCapConfigValidationError{}
+
+// Validate checks the field values on BusinessConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BusinessConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BusinessConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BusinessConfigMultiError,
+// or nil if none found.
+func (m *BusinessConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BusinessConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OwnerId
+
+	// no validation rules for OwnerType
+
+	if all {
+		switch v := interface{}(m.GetChannelConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "ChannelConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "ChannelConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChannelConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BusinessConfigValidationError{
+				field:  "ChannelConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTxnConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "TxnConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "TxnConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTxnConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BusinessConfigValidationError{
+				field:  "TxnConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ReteLimit
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BusinessConfigValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCallbackConfig()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
 				errors = append(errors, BusinessConfigValidationError{
@@ -1049,6 +1635,93 @@ func (m *BusinessConfig) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetWebhookConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "WebhookConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "WebhookConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhookConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BusinessConfigValidationError{
+				field:  "WebhookConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuietHours()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuietHours()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BusinessConfigValidationError{
+				field:  "QuietHours",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrequencyCap()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "FrequencyCap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "FrequencyCap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrequencyCap()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BusinessConfigValidationError{
+				field:  "FrequencyCap",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return BusinessConfigMultiError(errors)
 	}
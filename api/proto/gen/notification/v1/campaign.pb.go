@@ -0,0 +1,1259 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification/v1/campaign.proto
+
+package notificationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 活动状态
+type CampaignStatus int32
+
+const (
+	CampaignStatus_CAMPAIGN_STATUS_UNSPECIFIED CampaignStatus = 0
+	// 上传接收者
+	CampaignStatus_CAMPAIGN_DRAFT CampaignStatus = 1
+	// 按发送速率生成通知
+	CampaignStatus_CAMPAIGN_RUNNING CampaignStatus = 2
+	// 已暂停
+	CampaignStatus_CAMPAIGN_PAUSED CampaignStatus = 3
+	// 已终止
+	CampaignStatus_CAMPAIGN_ABORTED CampaignStatus = 4
+	// 所有接收者都已经生成通知
+	CampaignStatus_CAMPAIGN_COMPLETED CampaignStatus = 5
+)
+
+// Enum value maps for CampaignStatus.
+var (
+	CampaignStatus_name = map[int32]string{
+		0: "CAMPAIGN_STATUS_UNSPECIFIED",
+		1: "CAMPAIGN_DRAFT",
+		2: "CAMPAIGN_RUNNING",
+		3: "CAMPAIGN_PAUSED",
+		4: "CAMPAIGN_ABORTED",
+		5: "CAMPAIGN_COMPLETED",
+	}
+	CampaignStatus_value = map[string]int32{
+		"CAMPAIGN_STATUS_UNSPECIFIED": 0,
+		"CAMPAIGN_DRAFT":              1,
+		"CAMPAIGN_RUNNING":            2,
+		"CAMPAIGN_PAUSED":             3,
+		"CAMPAIGN_ABORTED":            4,
+		"CAMPAIGN_COMPLETED":          5,
+	}
+)
+
+func (x CampaignStatus) Enum() *CampaignStatus {
+	p := new(CampaignStatus)
+	*p = x
+	return p
+}
+
+func (x CampaignStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_campaign_proto_enumTypes[0].Descriptor()
+}
+
+func (CampaignStatus) Type() protoreflect.EnumType {
+	return &file_notification_v1_campaign_proto_enumTypes[0]
+}
+
+func (x CampaignStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignStatus.Descriptor instead.
+func (CampaignStatus) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{0}
+}
+
+// 活动
+type Campaign struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Key    string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status CampaignStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=notification.v1.CampaignStatus" json:"status,omitempty"`
+	// 每秒最多生成的通知数量
+	SendRate int32 `protobuf:"varint,4,opt,name=send_rate,json=sendRate,proto3" json:"send_rate,omitempty"`
+	// 已上传的接收者数量
+	Total int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	// 已经处理的接收者数量，包括生成失败的
+	Dispatched int64 `protobuf:"varint,6,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
+	// 生成通知失败的接收者数量，例如参数不合法
+	Failed int64 `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	// 创建时间，毫秒时间戳
+	Ctime int64 `protobuf:"varint,8,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	// 更新时间，毫秒时间戳
+	Utime         int64 `protobuf:"varint,9,opt,name=utime,proto3" json:"utime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Campaign) Reset() {
+	*x = Campaign{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Campaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Campaign) ProtoMessage() {}
+
+func (x *Campaign) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Campaign.ProtoReflect.Descriptor instead.
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Campaign) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Campaign) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Campaign) GetStatus() CampaignStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CampaignStatus_CAMPAIGN_STATUS_UNSPECIFIED
+}
+
+func (x *Campaign) GetSendRate() int32 {
+	if x != nil {
+		return x.SendRate
+	}
+	return 0
+}
+
+func (x *Campaign) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Campaign) GetDispatched() int64 {
+	if x != nil {
+		return x.Dispatched
+	}
+	return 0
+}
+
+func (x *Campaign) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *Campaign) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *Campaign) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+// 活动的接收者
+type CampaignReceiver struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Receiver string                 `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 该接收者的模板参数，覆盖活动共用的参数
+	Params        map[string]string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignReceiver) Reset() {
+	*x = CampaignReceiver{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignReceiver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignReceiver) ProtoMessage() {}
+
+func (x *CampaignReceiver) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignReceiver.ProtoReflect.Descriptor instead.
+func (*CampaignReceiver) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CampaignReceiver) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *CampaignReceiver) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// 某个发送状态的通知数量
+type CampaignStatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        SendStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignStatusCount) Reset() {
+	*x = CampaignStatusCount{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignStatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignStatusCount) ProtoMessage() {}
+
+func (x *CampaignStatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignStatusCount.ProtoReflect.Descriptor instead.
+func (*CampaignStatusCount) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CampaignStatusCount) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *CampaignStatusCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 创建活动请求
+type CreateCampaignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 每个接收者的通知内容，key 为活动的业务内唯一标识，receivers 不填，template_params 为所有接收者共用的参数
+	// 发送策略不支持周期发送，立即发送会改为生成后一分钟内发送
+	Notification *Notification `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
+	// 每秒最多生成的通知数量，不超过 5000
+	SendRate      int32 `protobuf:"varint,3,opt,name=send_rate,json=sendRate,proto3" json:"send_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignRequest) Reset() {
+	*x = CreateCampaignRequest{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignRequest) ProtoMessage() {}
+
+func (x *CreateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateCampaignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *CreateCampaignRequest) GetSendRate() int32 {
+	if x != nil {
+		return x.SendRate
+	}
+	return 0
+}
+
+// 创建活动响应
+type CreateCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignResponse) Reset() {
+	*x = CreateCampaignResponse{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignResponse) ProtoMessage() {}
+
+func (x *CreateCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignResponse.ProtoReflect.Descriptor instead.
+func (*CreateCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// 上传接收者文件请求
+type UploadCampaignReceiversRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// UTF-8 编码的 CSV 文件，第一行为列名，必须包含 receiver 列，其余列为该接收者的模板参数
+	// 单次最多 50000 个接收者，更多的接收者分多次上传或者使用流式上传
+	Csv           []byte `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCampaignReceiversRequest) Reset() {
+	*x = UploadCampaignReceiversRequest{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCampaignReceiversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCampaignReceiversRequest) ProtoMessage() {}
+
+func (x *UploadCampaignReceiversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCampaignReceiversRequest.ProtoReflect.Descriptor instead.
+func (*UploadCampaignReceiversRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UploadCampaignReceiversRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UploadCampaignReceiversRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+// 上传接收者文件响应
+type UploadCampaignReceiversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCampaignReceiversResponse) Reset() {
+	*x = UploadCampaignReceiversResponse{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCampaignReceiversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCampaignReceiversResponse) ProtoMessage() {}
+
+func (x *UploadCampaignReceiversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCampaignReceiversResponse.ProtoReflect.Descriptor instead.
+func (*UploadCampaignReceiversResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UploadCampaignReceiversResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// 流式上传接收者请求
+type StreamCampaignReceiversRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 每条消息都要带上活动的 key
+	Key           string              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Receivers     []*CampaignReceiver `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamCampaignReceiversRequest) Reset() {
+	*x = StreamCampaignReceiversRequest{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamCampaignReceiversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCampaignReceiversRequest) ProtoMessage() {}
+
+func (x *StreamCampaignReceiversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCampaignReceiversRequest.ProtoReflect.Descriptor instead.
+func (*StreamCampaignReceiversRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamCampaignReceiversRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StreamCampaignReceiversRequest) GetReceivers() []*CampaignReceiver {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+// 流式上传接收者响应
+type StreamCampaignReceiversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamCampaignReceiversResponse) Reset() {
+	*x = StreamCampaignReceiversResponse{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamCampaignReceiversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCampaignReceiversResponse) ProtoMessage() {}
+
+func (x *StreamCampaignReceiversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCampaignReceiversResponse.ProtoReflect.Descriptor instead.
+func (*StreamCampaignReceiversResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamCampaignReceiversResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// 开始发送请求
+type StartCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartCampaignRequest) Reset() {
+	*x = StartCampaignRequest{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartCampaignRequest) ProtoMessage() {}
+
+func (x *StartCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartCampaignRequest.ProtoReflect.Descriptor instead.
+func (*StartCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StartCampaignRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 开始发送响应
+type StartCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartCampaignResponse) Reset() {
+	*x = StartCampaignResponse{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartCampaignResponse) ProtoMessage() {}
+
+func (x *StartCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartCampaignResponse.ProtoReflect.Descriptor instead.
+func (*StartCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// 暂停发送请求
+type PauseCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseCampaignRequest) Reset() {
+	*x = PauseCampaignRequest{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseCampaignRequest) ProtoMessage() {}
+
+func (x *PauseCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseCampaignRequest.ProtoReflect.Descriptor instead.
+func (*PauseCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PauseCampaignRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 暂停发送响应
+type PauseCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseCampaignResponse) Reset() {
+	*x = PauseCampaignResponse{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseCampaignResponse) ProtoMessage() {}
+
+func (x *PauseCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseCampaignResponse.ProtoReflect.Descriptor instead.
+func (*PauseCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PauseCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// 恢复发送请求
+type ResumeCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeCampaignRequest) Reset() {
+	*x = ResumeCampaignRequest{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeCampaignRequest) ProtoMessage() {}
+
+func (x *ResumeCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeCampaignRequest.ProtoReflect.Descriptor instead.
+func (*ResumeCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResumeCampaignRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 恢复发送响应
+type ResumeCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeCampaignResponse) Reset() {
+	*x = ResumeCampaignResponse{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeCampaignResponse) ProtoMessage() {}
+
+func (x *ResumeCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeCampaignResponse.ProtoReflect.Descriptor instead.
+func (*ResumeCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ResumeCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// 终止发送请求
+type AbortCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortCampaignRequest) Reset() {
+	*x = AbortCampaignRequest{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortCampaignRequest) ProtoMessage() {}
+
+func (x *AbortCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortCampaignRequest.ProtoReflect.Descriptor instead.
+func (*AbortCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AbortCampaignRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 终止发送响应
+type AbortCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortCampaignResponse) Reset() {
+	*x = AbortCampaignResponse{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortCampaignResponse) ProtoMessage() {}
+
+func (x *AbortCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortCampaignResponse.ProtoReflect.Descriptor instead.
+func (*AbortCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AbortCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// 查询活动请求
+type GetCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignRequest) Reset() {
+	*x = GetCampaignRequest{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignRequest) ProtoMessage() {}
+
+func (x *GetCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignRequest.ProtoReflect.Descriptor instead.
+func (*GetCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCampaignRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 查询活动响应
+type GetCampaignResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Campaign *Campaign              `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	// 已生成的通知按发送状态统计的数量
+	StatusCounts  []*CampaignStatusCount `protobuf:"bytes,2,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignResponse) Reset() {
+	*x = GetCampaignResponse{}
+	mi := &file_notification_v1_campaign_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignResponse) ProtoMessage() {}
+
+func (x *GetCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_campaign_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignResponse.ProtoReflect.Descriptor instead.
+func (*GetCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_campaign_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCampaignResponse) GetCampaign() *Campaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+func (x *GetCampaignResponse) GetStatusCounts() []*CampaignStatusCount {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+var File_notification_v1_campaign_proto protoreflect.FileDescriptor
+
+const file_notification_v1_campaign_proto_rawDesc = "" +
+	"\n" +
+	"\x1enotification/v1/campaign.proto\x12\x0fnotification.v1\x1a\"notification/v1/notification.proto\"\x80\x02\n" +
+	"\bCampaign\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.notification.v1.CampaignStatusR\x06status\x12\x1b\n" +
+	"\tsend_rate\x18\x04 \x01(\x05R\bsendRate\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12\x1e\n" +
+	"\n" +
+	"dispatched\x18\x06 \x01(\x03R\n" +
+	"dispatched\x12\x16\n" +
+	"\x06failed\x18\a \x01(\x03R\x06failed\x12\x14\n" +
+	"\x05ctime\x18\b \x01(\x03R\x05ctime\x12\x14\n" +
+	"\x05utime\x18\t \x01(\x03R\x05utime\"\xb0\x01\n" +
+	"\x10CampaignReceiver\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12E\n" +
+	"\x06params\x18\x02 \x03(\v2-.notification.v1.CampaignReceiver.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"`\n" +
+	"\x13CampaignStatusCount\x123\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x8b\x01\n" +
+	"\x15CreateCampaignRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12A\n" +
+	"\fnotification\x18\x02 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\x12\x1b\n" +
+	"\tsend_rate\x18\x03 \x01(\x05R\bsendRate\"O\n" +
+	"\x16CreateCampaignResponse\x125\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x19.notification.v1.CampaignR\bcampaign\"D\n" +
+	"\x1eUploadCampaignReceiversRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\fR\x03csv\"X\n" +
+	"\x1fUploadCampaignReceiversResponse\x125\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x19.notification.v1.CampaignR\bcampaign\"s\n" +
+	"\x1eStreamCampaignReceiversRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12?\n" +
+	"\treceivers\x18\x02 \x03(\v2!.notification.v1.CampaignReceiverR\treceivers\"X\n" +
+	"\x1fStreamCampaignReceiversResponse\x125\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x19.notification.v1.CampaignR\bcampaign\"(\n" +
+	"\x14StartCampaignRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"N\n" +
+	"\x15StartCampaignResponse\x125\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x19.notification.v1.CampaignR\bcampaign\"(\n" +
+	"\x14PauseCampaignRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"N\n" +
+	"\x15PauseCampaignResponse\x125\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x19.notification.v1.CampaignR\bcampaign\")\n" +
+	"\x15ResumeCampaignRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"O\n" +
+	"\x16ResumeCampaignResponse\x125\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x19.notification.v1.CampaignR\bcampaign\"(\n" +
+	"\x14AbortCampaignRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"N\n" +
+	"\x15AbortCampaignResponse\x125\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x19.notification.v1.CampaignR\bcampaign\"&\n" +
+	"\x12GetCampaignRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x97\x01\n" +
+	"\x13GetCampaignResponse\x125\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x19.notification.v1.CampaignR\bcampaign\x12I\n" +
+	"\rstatus_counts\x18\x02 \x03(\v2$.notification.v1.CampaignStatusCountR\fstatusCounts*\x9e\x01\n" +
+	"\x0eCampaignStatus\x12\x1f\n" +
+	"\x1bCAMPAIGN_STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eCAMPAIGN_DRAFT\x10\x01\x12\x14\n" +
+	"\x10CAMPAIGN_RUNNING\x10\x02\x12\x13\n" +
+	"\x0fCAMPAIGN_PAUSED\x10\x03\x12\x14\n" +
+	"\x10CAMPAIGN_ABORTED\x10\x04\x12\x16\n" +
+	"\x12CAMPAIGN_COMPLETED\x10\x052\xcf\x06\n" +
+	"\x0fCampaignService\x12a\n" +
+	"\x0eCreateCampaign\x12&.notification.v1.CreateCampaignRequest\x1a'.notification.v1.CreateCampaignResponse\x12|\n" +
+	"\x17UploadCampaignReceivers\x12/.notification.v1.UploadCampaignReceiversRequest\x1a0.notification.v1.UploadCampaignReceiversResponse\x12~\n" +
+	"\x17StreamCampaignReceivers\x12/.notification.v1.StreamCampaignReceiversRequest\x1a0.notification.v1.StreamCampaignReceiversResponse(\x01\x12^\n" +
+	"\rStartCampaign\x12%.notification.v1.StartCampaignRequest\x1a&.notification.v1.StartCampaignResponse\x12^\n" +
+	"\rPauseCampaign\x12%.notification.v1.PauseCampaignRequest\x1a&.notification.v1.PauseCampaignResponse\x12a\n" +
+	"\x0eResumeCampaign\x12&.notification.v1.ResumeCampaignRequest\x1a'.notification.v1.ResumeCampaignResponse\x12^\n" +
+	"\rAbortCampaign\x12%.notification.v1.AbortCampaignRequest\x1a&.notification.v1.AbortCampaignResponse\x12X\n" +
+	"\vGetCampaign\x12#.notification.v1.GetCampaignRequest\x1a$.notification.v1.GetCampaignResponseB\xbf\x01\n" +
+	"\x13com.notification.v1B\rCampaignProtoP\x01Z<go-notification/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_campaign_proto_rawDescOnce sync.Once
+	file_notification_v1_campaign_proto_rawDescData []byte
+)
+
+func file_notification_v1_campaign_proto_rawDescGZIP() []byte {
+	file_notification_v1_campaign_proto_rawDescOnce.Do(func() {
+		file_notification_v1_campaign_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_campaign_proto_rawDesc), len(file_notification_v1_campaign_proto_rawDesc)))
+	})
+	return file_notification_v1_campaign_proto_rawDescData
+}
+
+var file_notification_v1_campaign_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notification_v1_campaign_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_notification_v1_campaign_proto_goTypes = []any{
+	(CampaignStatus)(0),                     // 0: notification.v1.CampaignStatus
+	(*Campaign)(nil),                        // 1: notification.v1.Campaign
+	(*CampaignReceiver)(nil),                // 2: notification.v1.CampaignReceiver
+	(*CampaignStatusCount)(nil),             // 3: notification.v1.CampaignStatusCount
+	(*CreateCampaignRequest)(nil),           // 4: notification.v1.CreateCampaignRequest
+	(*CreateCampaignResponse)(nil),          // 5: notification.v1.CreateCampaignResponse
+	(*UploadCampaignReceiversRequest)(nil),  // 6: notification.v1.UploadCampaignReceiversRequest
+	(*UploadCampaignReceiversResponse)(nil), // 7: notification.v1.UploadCampaignReceiversResponse
+	(*StreamCampaignReceiversRequest)(nil),  // 8: notification.v1.StreamCampaignReceiversRequest
+	(*StreamCampaignReceiversResponse)(nil), // 9: notification.v1.StreamCampaignReceiversResponse
+	(*StartCampaignRequest)(nil),            // 10: notification.v1.StartCampaignRequest
+	(*StartCampaignResponse)(nil),           // 11: notification.v1.StartCampaignResponse
+	(*PauseCampaignRequest)(nil),            // 12: notification.v1.PauseCampaignRequest
+	(*PauseCampaignResponse)(nil),           // 13: notification.v1.PauseCampaignResponse
+	(*ResumeCampaignRequest)(nil),           // 14: notification.v1.ResumeCampaignRequest
+	(*ResumeCampaignResponse)(nil),          // 15: notification.v1.ResumeCampaignResponse
+	(*AbortCampaignRequest)(nil),            // 16: notification.v1.AbortCampaignRequest
+	(*AbortCampaignResponse)(nil),           // 17: notification.v1.AbortCampaignResponse
+	(*GetCampaignRequest)(nil),              // 18: notification.v1.GetCampaignRequest
+	(*GetCampaignResponse)(nil),             // 19: notification.v1.GetCampaignResponse
+	nil,                                     // 20: notification.v1.CampaignReceiver.ParamsEntry
+	(SendStatus)(0),                         // 21: notification.v1.SendStatus
+	(*Notification)(nil),                    // 22: notification.v1.Notification
+}
+var file_notification_v1_campaign_proto_depIdxs = []int32{
+	0,  // 0: notification.v1.Campaign.status:type_name -> notification.v1.CampaignStatus
+	20, // 1: notification.v1.CampaignReceiver.params:type_name -> notification.v1.CampaignReceiver.ParamsEntry
+	21, // 2: notification.v1.CampaignStatusCount.status:type_name -> notification.v1.SendStatus
+	22, // 3: notification.v1.CreateCampaignRequest.notification:type_name -> notification.v1.Notification
+	1,  // 4: notification.v1.CreateCampaignResponse.campaign:type_name -> notification.v1.Campaign
+	1,  // 5: notification.v1.UploadCampaignReceiversResponse.campaign:type_name -> notification.v1.Campaign
+	2,  // 6: notification.v1.StreamCampaignReceiversRequest.receivers:type_name -> notification.v1.CampaignReceiver
+	1,  // 7: notification.v1.StreamCampaignReceiversResponse.campaign:type_name -> notification.v1.Campaign
+	1,  // 8: notification.v1.StartCampaignResponse.campaign:type_name -> notification.v1.Campaign
+	1,  // 9: notification.v1.PauseCampaignResponse.campaign:type_name -> notification.v1.Campaign
+	1,  // 10: notification.v1.ResumeCampaignResponse.campaign:type_name -> notification.v1.Campaign
+	1,  // 11: notification.v1.AbortCampaignResponse.campaign:type_name -> notification.v1.Campaign
+	1,  // 12: notification.v1.GetCampaignResponse.campaign:type_name -> notification.v1.Campaign
+	3,  // 13: notification.v1.GetCampaignResponse.status_counts:type_name -> notification.v1.CampaignStatusCount
+	4,  // 14: notification.v1.CampaignService.CreateCampaign:input_type -> notification.v1.CreateCampaignRequest
+	6,  // 15: notification.v1.CampaignService.UploadCampaignReceivers:input_type -> notification.v1.UploadCampaignReceiversRequest
+	8,  // 16: notification.v1.CampaignService.StreamCampaignReceivers:input_type -> notification.v1.StreamCampaignReceiversRequest
+	10, // 17: notification.v1.CampaignService.StartCampaign:input_type -> notification.v1.StartCampaignRequest
+	12, // 18: notification.v1.CampaignService.PauseCampaign:input_type -> notification.v1.PauseCampaignRequest
+	14, // 19: notification.v1.CampaignService.ResumeCampaign:input_type -> notification.v1.ResumeCampaignRequest
+	16, // 20: notification.v1.CampaignService.AbortCampaign:input_type -> notification.v1.AbortCampaignRequest
+	18, // 21: notification.v1.CampaignService.GetCampaign:input_type -> notification.v1.GetCampaignRequest
+	5,  // 22: notification.v1.CampaignService.CreateCampaign:output_type -> notification.v1.CreateCampaignResponse
+	7,  // 23: notification.v1.CampaignService.UploadCampaignReceivers:output_type -> notification.v1.UploadCampaignReceiversResponse
+	9,  // 24: notification.v1.CampaignService.StreamCampaignReceivers:output_type -> notification.v1.StreamCampaignReceiversResponse
+	11, // 25: notification.v1.CampaignService.StartCampaign:output_type -> notification.v1.StartCampaignResponse
+	13, // 26: notification.v1.CampaignService.PauseCampaign:output_type -> notification.v1.PauseCampaignResponse
+	15, // 27: notification.v1.CampaignService.ResumeCampaign:output_type -> notification.v1.ResumeCampaignResponse
+	17, // 28: notification.v1.CampaignService.AbortCampaign:output_type -> notification.v1.AbortCampaignResponse
+	19, // 29: notification.v1.CampaignService.GetCampaign:output_type -> notification.v1.GetCampaignResponse
+	22, // [22:30] is the sub-list for method output_type
+	14, // [14:22] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_campaign_proto_init() }
+func file_notification_v1_campaign_proto_init() {
+	if File_notification_v1_campaign_proto != nil {
+		return
+	}
+	file_notification_v1_notification_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_campaign_proto_rawDesc), len(file_notification_v1_campaign_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_campaign_proto_goTypes,
+		DependencyIndexes: file_notification_v1_campaign_proto_depIdxs,
+		EnumInfos:         file_notification_v1_campaign_proto_enumTypes,
+		MessageInfos:      file_notification_v1_campaign_proto_msgTypes,
+	}.Build()
+	File_notification_v1_campaign_proto = out.File
+	file_notification_v1_campaign_proto_goTypes = nil
+	file_notification_v1_campaign_proto_depIdxs = nil
+}
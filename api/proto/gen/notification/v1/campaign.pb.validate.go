@@ -0,0 +1,2348 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification/v1/campaign.proto
+
+package notificationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Campaign with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Campaign) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Campaign with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CampaignMultiError, or nil
+// if none found.
+func (m *Campaign) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Campaign) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	// no validation rules for SendRate
+
+	// no validation rules for Total
+
+	// no validation rules for Dispatched
+
+	// no validation rules for Failed
+
+	// no validation rules for Ctime
+
+	// no validation rules for Utime
+
+	if len(errors) > 0 {
+		return CampaignMultiError(errors)
+	}
+
+	return nil
+}
+
+// CampaignMultiError is an error wrapping multiple validation errors returned
+// by Campaign.ValidateAll() if the designated constraints aren't met.
+type CampaignMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CampaignMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CampaignMultiError) AllErrors() []error { return m }
+
+// CampaignValidationError is the validation error returned by
+// Campaign.Validate if the designated constraints aren't met.
+type CampaignValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CampaignValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CampaignValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CampaignValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CampaignValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CampaignValidationError) ErrorName() string { return "CampaignValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CampaignValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCampaign.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CampaignValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CampaignValidationError{}
+
+// Validate checks the field values on CampaignReceiver with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CampaignReceiver) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CampaignReceiver with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CampaignReceiverMultiError, or nil if none found.
+func (m *CampaignReceiver) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CampaignReceiver) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	// no validation rules for Params
+
+	if len(errors) > 0 {
+		return CampaignReceiverMultiError(errors)
+	}
+
+	return nil
+}
+
+// CampaignReceiverMultiError is an error wrapping multiple validation errors
+// returned by CampaignReceiver.ValidateAll() if the designated constraints
+// aren't met.
+type CampaignReceiverMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CampaignReceiverMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CampaignReceiverMultiError) AllErrors() []error { return m }
+
+// CampaignReceiverValidationError is the validation error returned by
+// CampaignReceiver.Validate if the designated constraints aren't met.
+type CampaignReceiverValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CampaignReceiverValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CampaignReceiverValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CampaignReceiverValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CampaignReceiverValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CampaignReceiverValidationError) ErrorName() string { return "CampaignReceiverValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CampaignReceiverValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCampaignReceiver.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CampaignReceiverValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CampaignReceiverValidationError{}
+
+// Validate checks the field values on CampaignStatusCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CampaignStatusCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CampaignStatusCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CampaignStatusCountMultiError, or nil if none found.
+func (m *CampaignStatusCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CampaignStatusCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return CampaignStatusCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// CampaignStatusCountMultiError is an error wrapping multiple validation
+// errors returned by CampaignStatusCount.ValidateAll() if the designated
+// constraints aren't met.
+type CampaignStatusCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CampaignStatusCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CampaignStatusCountMultiError) AllErrors() []error { return m }
+
+// CampaignStatusCountValidationError is the validation error returned by
+// CampaignStatusCount.Validate if the designated constraints aren't met.
+type CampaignStatusCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CampaignStatusCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CampaignStatusCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CampaignStatusCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CampaignStatusCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CampaignStatusCountValidationError) ErrorName() string {
+	return "CampaignStatusCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CampaignStatusCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCampaignStatusCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CampaignStatusCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CampaignStatusCountValidationError{}
+
+// Validate checks the field values on CreateCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCampaignRequestMultiError, or nil if none found.
+func (m *CreateCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCampaignRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCampaignRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCampaignRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SendRate
+
+	if len(errors) > 0 {
+		return CreateCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCampaignRequestMultiError) AllErrors() []error { return m }
+
+// CreateCampaignRequestValidationError is the validation error returned by
+// CreateCampaignRequest.Validate if the designated constraints aren't met.
+type CreateCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCampaignRequestValidationError) ErrorName() string {
+	return "CreateCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCampaignRequestValidationError{}
+
+// Validate checks the field values on CreateCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCampaignResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCampaignResponseMultiError, or nil if none found.
+func (m *CreateCampaignResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCampaignResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCampaignResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCampaignResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCampaignResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateCampaignResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCampaignResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCampaignResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCampaignResponseMultiError) AllErrors() []error { return m }
+
+// CreateCampaignResponseValidationError is the validation error returned by
+// CreateCampaignResponse.Validate if the designated constraints aren't met.
+type CreateCampaignResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCampaignResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCampaignResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCampaignResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCampaignResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCampaignResponseValidationError) ErrorName() string {
+	return "CreateCampaignResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCampaignResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCampaignResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCampaignResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCampaignResponseValidationError{}
+
+// Validate checks the field values on UploadCampaignReceiversRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadCampaignReceiversRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadCampaignReceiversRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UploadCampaignReceiversRequestMultiError, or nil if none found.
+func (m *UploadCampaignReceiversRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadCampaignReceiversRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Csv
+
+	if len(errors) > 0 {
+		return UploadCampaignReceiversRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadCampaignReceiversRequestMultiError is an error wrapping multiple
+// validation errors returned by UploadCampaignReceiversRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UploadCampaignReceiversRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadCampaignReceiversRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadCampaignReceiversRequestMultiError) AllErrors() []error { return m }
+
+// UploadCampaignReceiversRequestValidationError is the validation error
+// returned by UploadCampaignReceiversRequest.Validate if the designated
+// constraints aren't met.
+type UploadCampaignReceiversRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadCampaignReceiversRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadCampaignReceiversRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadCampaignReceiversRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadCampaignReceiversRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadCampaignReceiversRequestValidationError) ErrorName() string {
+	return "UploadCampaignReceiversRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadCampaignReceiversRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadCampaignReceiversRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadCampaignReceiversRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadCampaignReceiversRequestValidationError{}
+
+// Validate checks the field values on UploadCampaignReceiversResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadCampaignReceiversResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadCampaignReceiversResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UploadCampaignReceiversResponseMultiError, or nil if none found.
+func (m *UploadCampaignReceiversResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadCampaignReceiversResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadCampaignReceiversResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadCampaignReceiversResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadCampaignReceiversResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadCampaignReceiversResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadCampaignReceiversResponseMultiError is an error wrapping multiple
+// validation errors returned by UploadCampaignReceiversResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UploadCampaignReceiversResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadCampaignReceiversResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadCampaignReceiversResponseMultiError) AllErrors() []error { return m }
+
+// UploadCampaignReceiversResponseValidationError is the validation error
+// returned by UploadCampaignReceiversResponse.Validate if the designated
+// constraints aren't met.
+type UploadCampaignReceiversResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadCampaignReceiversResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadCampaignReceiversResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadCampaignReceiversResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadCampaignReceiversResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadCampaignReceiversResponseValidationError) ErrorName() string {
+	return "UploadCampaignReceiversResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadCampaignReceiversResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadCampaignReceiversResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadCampaignReceiversResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadCampaignReceiversResponseValidationError{}
+
+// Validate checks the field values on StreamCampaignReceiversRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamCampaignReceiversRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamCampaignReceiversRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StreamCampaignReceiversRequestMultiError, or nil if none found.
+func (m *StreamCampaignReceiversRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamCampaignReceiversRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	for idx, item := range m.GetReceivers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamCampaignReceiversRequestValidationError{
+						field:  fmt.Sprintf("Receivers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamCampaignReceiversRequestValidationError{
+						field:  fmt.Sprintf("Receivers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamCampaignReceiversRequestValidationError{
+					field:  fmt.Sprintf("Receivers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StreamCampaignReceiversRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamCampaignReceiversRequestMultiError is an error wrapping multiple
+// validation errors returned by StreamCampaignReceiversRequest.ValidateAll()
+// if the designated constraints aren't met.
+type StreamCampaignReceiversRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamCampaignReceiversRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamCampaignReceiversRequestMultiError) AllErrors() []error { return m }
+
+// StreamCampaignReceiversRequestValidationError is the validation error
+// returned by StreamCampaignReceiversRequest.Validate if the designated
+// constraints aren't met.
+type StreamCampaignReceiversRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamCampaignReceiversRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamCampaignReceiversRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamCampaignReceiversRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamCampaignReceiversRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamCampaignReceiversRequestValidationError) ErrorName() string {
+	return "StreamCampaignReceiversRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamCampaignReceiversRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamCampaignReceiversRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamCampaignReceiversRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamCampaignReceiversRequestValidationError{}
+
+// Validate checks the field values on StreamCampaignReceiversResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamCampaignReceiversResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamCampaignReceiversResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StreamCampaignReceiversResponseMultiError, or nil if none found.
+func (m *StreamCampaignReceiversResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamCampaignReceiversResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamCampaignReceiversResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamCampaignReceiversResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamCampaignReceiversResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StreamCampaignReceiversResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamCampaignReceiversResponseMultiError is an error wrapping multiple
+// validation errors returned by StreamCampaignReceiversResponse.ValidateAll()
+// if the designated constraints aren't met.
+type StreamCampaignReceiversResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamCampaignReceiversResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamCampaignReceiversResponseMultiError) AllErrors() []error { return m }
+
+// StreamCampaignReceiversResponseValidationError is the validation error
+// returned by StreamCampaignReceiversResponse.Validate if the designated
+// constraints aren't met.
+type StreamCampaignReceiversResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamCampaignReceiversResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamCampaignReceiversResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamCampaignReceiversResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamCampaignReceiversResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamCampaignReceiversResponseValidationError) ErrorName() string {
+	return "StreamCampaignReceiversResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamCampaignReceiversResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamCampaignReceiversResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamCampaignReceiversResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamCampaignReceiversResponseValidationError{}
+
+// Validate checks the field values on StartCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartCampaignRequestMultiError, or nil if none found.
+func (m *StartCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return StartCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by StartCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartCampaignRequestMultiError) AllErrors() []error { return m }
+
+// StartCampaignRequestValidationError is the validation error returned by
+// StartCampaignRequest.Validate if the designated constraints aren't met.
+type StartCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartCampaignRequestValidationError) ErrorName() string {
+	return "StartCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartCampaignRequestValidationError{}
+
+// Validate checks the field values on StartCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartCampaignResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartCampaignResponseMultiError, or nil if none found.
+func (m *StartCampaignResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartCampaignResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartCampaignResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartCampaignResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartCampaignResponseMultiError is an error wrapping multiple validation
+// errors returned by StartCampaignResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartCampaignResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartCampaignResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartCampaignResponseMultiError) AllErrors() []error { return m }
+
+// StartCampaignResponseValidationError is the validation error returned by
+// StartCampaignResponse.Validate if the designated constraints aren't met.
+type StartCampaignResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartCampaignResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartCampaignResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartCampaignResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartCampaignResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartCampaignResponseValidationError) ErrorName() string {
+	return "StartCampaignResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartCampaignResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartCampaignResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartCampaignResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartCampaignResponseValidationError{}
+
+// Validate checks the field values on PauseCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseCampaignRequestMultiError, or nil if none found.
+func (m *PauseCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return PauseCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by PauseCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PauseCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseCampaignRequestMultiError) AllErrors() []error { return m }
+
+// PauseCampaignRequestValidationError is the validation error returned by
+// PauseCampaignRequest.Validate if the designated constraints aren't met.
+type PauseCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseCampaignRequestValidationError) ErrorName() string {
+	return "PauseCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseCampaignRequestValidationError{}
+
+// Validate checks the field values on PauseCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseCampaignResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseCampaignResponseMultiError, or nil if none found.
+func (m *PauseCampaignResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseCampaignResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PauseCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PauseCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PauseCampaignResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PauseCampaignResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseCampaignResponseMultiError is an error wrapping multiple validation
+// errors returned by PauseCampaignResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PauseCampaignResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseCampaignResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseCampaignResponseMultiError) AllErrors() []error { return m }
+
+// PauseCampaignResponseValidationError is the validation error returned by
+// PauseCampaignResponse.Validate if the designated constraints aren't met.
+type PauseCampaignResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseCampaignResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseCampaignResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseCampaignResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseCampaignResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseCampaignResponseValidationError) ErrorName() string {
+	return "PauseCampaignResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseCampaignResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseCampaignResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseCampaignResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseCampaignResponseValidationError{}
+
+// Validate checks the field values on ResumeCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeCampaignRequestMultiError, or nil if none found.
+func (m *ResumeCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return ResumeCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeCampaignRequestMultiError) AllErrors() []error { return m }
+
+// ResumeCampaignRequestValidationError is the validation error returned by
+// ResumeCampaignRequest.Validate if the designated constraints aren't met.
+type ResumeCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeCampaignRequestValidationError) ErrorName() string {
+	return "ResumeCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeCampaignRequestValidationError{}
+
+// Validate checks the field values on ResumeCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeCampaignResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeCampaignResponseMultiError, or nil if none found.
+func (m *ResumeCampaignResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeCampaignResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResumeCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResumeCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResumeCampaignResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResumeCampaignResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeCampaignResponseMultiError is an error wrapping multiple validation
+// errors returned by ResumeCampaignResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeCampaignResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeCampaignResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeCampaignResponseMultiError) AllErrors() []error { return m }
+
+// ResumeCampaignResponseValidationError is the validation error returned by
+// ResumeCampaignResponse.Validate if the designated constraints aren't met.
+type ResumeCampaignResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeCampaignResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeCampaignResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeCampaignResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeCampaignResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeCampaignResponseValidationError) ErrorName() string {
+	return "ResumeCampaignResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeCampaignResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeCampaignResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeCampaignResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeCampaignResponseValidationError{}
+
+// Validate checks the field values on AbortCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AbortCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AbortCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AbortCampaignRequestMultiError, or nil if none found.
+func (m *AbortCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AbortCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return AbortCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AbortCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by AbortCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AbortCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AbortCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AbortCampaignRequestMultiError) AllErrors() []error { return m }
+
+// AbortCampaignRequestValidationError is the validation error returned by
+// AbortCampaignRequest.Validate if the designated constraints aren't met.
+type AbortCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AbortCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AbortCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AbortCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AbortCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AbortCampaignRequestValidationError) ErrorName() string {
+	return "AbortCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AbortCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAbortCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AbortCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AbortCampaignRequestValidationError{}
+
+// Validate checks the field values on AbortCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AbortCampaignResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AbortCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AbortCampaignResponseMultiError, or nil if none found.
+func (m *AbortCampaignResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AbortCampaignResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AbortCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AbortCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AbortCampaignResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AbortCampaignResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AbortCampaignResponseMultiError is an error wrapping multiple validation
+// errors returned by AbortCampaignResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AbortCampaignResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AbortCampaignResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AbortCampaignResponseMultiError) AllErrors() []error { return m }
+
+// AbortCampaignResponseValidationError is the validation error returned by
+// AbortCampaignResponse.Validate if the designated constraints aren't met.
+type AbortCampaignResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AbortCampaignResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AbortCampaignResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AbortCampaignResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AbortCampaignResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AbortCampaignResponseValidationError) ErrorName() string {
+	return "AbortCampaignResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AbortCampaignResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAbortCampaignResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AbortCampaignResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AbortCampaignResponseValidationError{}
+
+// Validate checks the field values on GetCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCampaignRequestMultiError, or nil if none found.
+func (m *GetCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return GetCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCampaignRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCampaignRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCampaignRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCampaignRequestMultiError) AllErrors() []error { return m }
+
+// GetCampaignRequestValidationError is the validation error returned by
+// GetCampaignRequest.Validate if the designated constraints aren't met.
+type GetCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCampaignRequestValidationError) ErrorName() string {
+	return "GetCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCampaignRequestValidationError{}
+
+// Validate checks the field values on GetCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCampaignResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCampaignResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCampaignResponseMultiError, or nil if none found.
+func (m *GetCampaignResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCampaignResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCampaignResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCampaignResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetStatusCounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCampaignResponseValidationError{
+						field:  fmt.Sprintf("StatusCounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCampaignResponseValidationError{
+						field:  fmt.Sprintf("StatusCounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCampaignResponseValidationError{
+					field:  fmt.Sprintf("StatusCounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCampaignResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCampaignResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCampaignResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCampaignResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCampaignResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCampaignResponseMultiError) AllErrors() []error { return m }
+
+// GetCampaignResponseValidationError is the validation error returned by
+// GetCampaignResponse.Validate if the designated constraints aren't met.
+type GetCampaignResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCampaignResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCampaignResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCampaignResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCampaignResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCampaignResponseValidationError) ErrorName() string {
+	return "GetCampaignResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCampaignResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCampaignResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCampaignResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCampaignResponseValidationError{}
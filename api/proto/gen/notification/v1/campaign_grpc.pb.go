@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: notification/v1/campaign.proto
+
+package notificationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CampaignService_CreateCampaign_FullMethodName          = "/notification.v1.CampaignService/CreateCampaign"
+	CampaignService_UploadCampaignReceivers_FullMethodName = "/notification.v1.CampaignService/UploadCampaignReceivers"
+	CampaignService_StreamCampaignReceivers_FullMethodName = "/notification.v1.CampaignService/StreamCampaignReceivers"
+	CampaignService_StartCampaign_FullMethodName           = "/notification.v1.CampaignService/StartCampaign"
+	CampaignService_PauseCampaign_FullMethodName           = "/notification.v1.CampaignService/PauseCampaign"
+	CampaignService_ResumeCampaign_FullMethodName          = "/notification.v1.CampaignService/ResumeCampaign"
+	CampaignService_AbortCampaign_FullMethodName           = "/notification.v1.CampaignService/AbortCampaign"
+	CampaignService_GetCampaign_FullMethodName             = "/notification.v1.CampaignService/GetCampaign"
+)
+
+// CampaignServiceClient is the client API for CampaignService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 活动服务，面向大量接收者发送同一通知，所有操作都限定在 JWT 中的 biz_id 之下
+// 先创建活动并上传接收者，开始后平台在后台按发送速率为每个接收者生成一条通知，
+// 通知的 key 为 "{活动 key}#{接收者序号}"，可以按通知单独查询、取消和回调
+type CampaignServiceClient interface {
+	// 创建草稿状态的活动
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error)
+	// 上传 CSV 格式的接收者文件，追加到草稿状态的活动，可以多次上传
+	UploadCampaignReceivers(ctx context.Context, in *UploadCampaignReceiversRequest, opts ...grpc.CallOption) (*UploadCampaignReceiversResponse, error)
+	// 流式上传接收者，每条消息单独追加，中途出错时已经追加的接收者保留
+	StreamCampaignReceivers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamCampaignReceiversRequest, StreamCampaignReceiversResponse], error)
+	// 开始发送，开始后不能再追加接收者
+	StartCampaign(ctx context.Context, in *StartCampaignRequest, opts ...grpc.CallOption) (*StartCampaignResponse, error)
+	// 暂停发送
+	PauseCampaign(ctx context.Context, in *PauseCampaignRequest, opts ...grpc.CallOption) (*PauseCampaignResponse, error)
+	// 从暂停的位置继续发送
+	ResumeCampaign(ctx context.Context, in *ResumeCampaignRequest, opts ...grpc.CallOption) (*ResumeCampaignResponse, error)
+	// 终止发送，已经生成的通知不受影响，终止后不能恢复
+	AbortCampaign(ctx context.Context, in *AbortCampaignRequest, opts ...grpc.CallOption) (*AbortCampaignResponse, error)
+	// 查询进度和已生成通知的发送结果，活动结束后即为最终汇总
+	GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error)
+}
+
+type campaignServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCampaignServiceClient(cc grpc.ClientConnInterface) CampaignServiceClient {
+	return &campaignServiceClient{cc}
+}
+
+func (c *campaignServiceClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_CreateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) UploadCampaignReceivers(ctx context.Context, in *UploadCampaignReceiversRequest, opts ...grpc.CallOption) (*UploadCampaignReceiversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadCampaignReceiversResponse)
+	err := c.cc.Invoke(ctx, CampaignService_UploadCampaignReceivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) StreamCampaignReceivers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamCampaignReceiversRequest, StreamCampaignReceiversResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CampaignService_ServiceDesc.Streams[0], CampaignService_StreamCampaignReceivers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamCampaignReceiversRequest, StreamCampaignReceiversResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CampaignService_StreamCampaignReceiversClient = grpc.ClientStreamingClient[StreamCampaignReceiversRequest, StreamCampaignReceiversResponse]
+
+func (c *campaignServiceClient) StartCampaign(ctx context.Context, in *StartCampaignRequest, opts ...grpc.CallOption) (*StartCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_StartCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) PauseCampaign(ctx context.Context, in *PauseCampaignRequest, opts ...grpc.CallOption) (*PauseCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_PauseCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) ResumeCampaign(ctx context.Context, in *ResumeCampaignRequest, opts ...grpc.CallOption) (*ResumeCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_ResumeCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) AbortCampaign(ctx context.Context, in *AbortCampaignRequest, opts ...grpc.CallOption) (*AbortCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_AbortCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *campaignServiceClient) GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampaignResponse)
+	err := c.cc.Invoke(ctx, CampaignService_GetCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CampaignServiceServer is the server API for CampaignService service.
+// All implementations should embed UnimplementedCampaignServiceServer
+// for forward compatibility.
+//
+// 活动服务，面向大量接收者发送同一通知，所有操作都限定在 JWT 中的 biz_id 之下
+// 先创建活动并上传接收者，开始后平台在后台按发送速率为每个接收者生成一条通知，
+// 通知的 key 为 "{活动 key}#{接收者序号}"，可以按通知单独查询、取消和回调
+type CampaignServiceServer interface {
+	// 创建草稿状态的活动
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error)
+	// 上传 CSV 格式的接收者文件，追加到草稿状态的活动，可以多次上传
+	UploadCampaignReceivers(context.Context, *UploadCampaignReceiversRequest) (*UploadCampaignReceiversResponse, error)
+	// 流式上传接收者，每条消息单独追加，中途出错时已经追加的接收者保留
+	StreamCampaignReceivers(grpc.ClientStreamingServer[StreamCampaignReceiversRequest, StreamCampaignReceiversResponse]) error
+	// 开始发送，开始后不能再追加接收者
+	StartCampaign(context.Context, *StartCampaignRequest) (*StartCampaignResponse, error)
+	// 暂停发送
+	PauseCampaign(context.Context, *PauseCampaignRequest) (*PauseCampaignResponse, error)
+	// 从暂停的位置继续发送
+	ResumeCampaign(context.Context, *ResumeCampaignRequest) (*ResumeCampaignResponse, error)
+	// 终止发送，已经生成的通知不受影响，终止后不能恢复
+	AbortCampaign(context.Context, *AbortCampaignRequest) (*AbortCampaignResponse, error)
+	// 查询进度和已生成通知的发送结果，活动结束后即为最终汇总
+	GetCampaign(context.Context, *GetCampaignRequest) (*GetCampaignResponse, error)
+}
+
+// UnimplementedCampaignServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCampaignServiceServer struct{}
+
+func (UnimplementedCampaignServiceServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) UploadCampaignReceivers(context.Context, *UploadCampaignReceiversRequest) (*UploadCampaignReceiversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadCampaignReceivers not implemented")
+}
+func (UnimplementedCampaignServiceServer) StreamCampaignReceivers(grpc.ClientStreamingServer[StreamCampaignReceiversRequest, StreamCampaignReceiversResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCampaignReceivers not implemented")
+}
+func (UnimplementedCampaignServiceServer) StartCampaign(context.Context, *StartCampaignRequest) (*StartCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) PauseCampaign(context.Context, *PauseCampaignRequest) (*PauseCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) ResumeCampaign(context.Context, *ResumeCampaignRequest) (*ResumeCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) AbortCampaign(context.Context, *AbortCampaignRequest) (*AbortCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) GetCampaign(context.Context, *GetCampaignRequest) (*GetCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCampaign not implemented")
+}
+func (UnimplementedCampaignServiceServer) testEmbeddedByValue() {}
+
+// UnsafeCampaignServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CampaignServiceServer will
+// result in compilation errors.
+type UnsafeCampaignServiceServer interface {
+	mustEmbedUnimplementedCampaignServiceServer()
+}
+
+func RegisterCampaignServiceServer(s grpc.ServiceRegistrar, srv CampaignServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCampaignServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CampaignService_ServiceDesc, srv)
+}
+
+func _CampaignService_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_UploadCampaignReceivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadCampaignReceiversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).UploadCampaignReceivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_UploadCampaignReceivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).UploadCampaignReceivers(ctx, req.(*UploadCampaignReceiversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_StreamCampaignReceivers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CampaignServiceServer).StreamCampaignReceivers(&grpc.GenericServerStream[StreamCampaignReceiversRequest, StreamCampaignReceiversResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CampaignService_StreamCampaignReceiversServer = grpc.ClientStreamingServer[StreamCampaignReceiversRequest, StreamCampaignReceiversResponse]
+
+func _CampaignService_StartCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).StartCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_StartCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).StartCampaign(ctx, req.(*StartCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_PauseCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).PauseCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_PauseCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).PauseCampaign(ctx, req.(*PauseCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_ResumeCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).ResumeCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_ResumeCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).ResumeCampaign(ctx, req.(*ResumeCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_AbortCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).AbortCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_AbortCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).AbortCampaign(ctx, req.(*AbortCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CampaignService_GetCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CampaignServiceServer).GetCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CampaignService_GetCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CampaignServiceServer).GetCampaign(ctx, req.(*GetCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CampaignService_ServiceDesc is the grpc.ServiceDesc for CampaignService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CampaignService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notification.v1.CampaignService",
+	HandlerType: (*CampaignServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _CampaignService_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "UploadCampaignReceivers",
+			Handler:    _CampaignService_UploadCampaignReceivers_Handler,
+		},
+		{
+			MethodName: "StartCampaign",
+			Handler:    _CampaignService_StartCampaign_Handler,
+		},
+		{
+			MethodName: "PauseCampaign",
+			Handler:    _CampaignService_PauseCampaign_Handler,
+		},
+		{
+			MethodName: "ResumeCampaign",
+			Handler:    _CampaignService_ResumeCampaign_Handler,
+		},
+		{
+			MethodName: "AbortCampaign",
+			Handler:    _CampaignService_AbortCampaign_Handler,
+		},
+		{
+			MethodName: "GetCampaign",
+			Handler:    _CampaignService_GetCampaign_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCampaignReceivers",
+			Handler:       _CampaignService_StreamCampaignReceivers_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "notification/v1/campaign.proto",
+}
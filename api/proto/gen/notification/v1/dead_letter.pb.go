@@ -0,0 +1,815 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification/v1/dead_letter.proto
+
+package notificationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 死信状态
+type DeadLetterStatus int32
+
+const (
+	DeadLetterStatus_DEAD_LETTER_STATUS_UNSPECIFIED DeadLetterStatus = 0
+	// 待处理
+	DeadLetterStatus_DEAD_LETTER_PENDING DeadLetterStatus = 1
+	// 已重放，重放后再次失败会改回待处理
+	DeadLetterStatus_DEAD_LETTER_REPLAYED DeadLetterStatus = 2
+)
+
+// Enum value maps for DeadLetterStatus.
+var (
+	DeadLetterStatus_name = map[int32]string{
+		0: "DEAD_LETTER_STATUS_UNSPECIFIED",
+		1: "DEAD_LETTER_PENDING",
+		2: "DEAD_LETTER_REPLAYED",
+	}
+	DeadLetterStatus_value = map[string]int32{
+		"DEAD_LETTER_STATUS_UNSPECIFIED": 0,
+		"DEAD_LETTER_PENDING":            1,
+		"DEAD_LETTER_REPLAYED":           2,
+	}
+)
+
+func (x DeadLetterStatus) Enum() *DeadLetterStatus {
+	p := new(DeadLetterStatus)
+	*p = x
+	return p
+}
+
+func (x DeadLetterStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeadLetterStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_dead_letter_proto_enumTypes[0].Descriptor()
+}
+
+func (DeadLetterStatus) Type() protoreflect.EnumType {
+	return &file_notification_v1_dead_letter_proto_enumTypes[0]
+}
+
+func (x DeadLetterStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeadLetterStatus.Descriptor instead.
+func (DeadLetterStatus) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_dead_letter_proto_rawDescGZIP(), []int{0}
+}
+
+// 失败时单个接收者的提交记录
+type DeadLetterAttempt struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Receiver string                 `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 提交的供应商
+	Provider string         `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Status   DeliveryStatus `protobuf:"varint,3,opt,name=status,proto3,enum=notification.v1.DeliveryStatus" json:"status,omitempty"`
+	// 提交供应商的次数
+	Attempts int32 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// 供应商返回的错误码
+	ErrCode string `protobuf:"bytes,5,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	// 供应商返回的错误描述
+	ErrMsg        string `protobuf:"bytes,6,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterAttempt) Reset() {
+	*x = DeadLetterAttempt{}
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterAttempt) ProtoMessage() {}
+
+func (x *DeadLetterAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterAttempt.ProtoReflect.Descriptor instead.
+func (*DeadLetterAttempt) Descriptor() ([]byte, []int) {
+	return file_notification_v1_dead_letter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeadLetterAttempt) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *DeadLetterAttempt) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DeadLetterAttempt) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *DeadLetterAttempt) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetterAttempt) GetErrCode() string {
+	if x != nil {
+		return x.ErrCode
+	}
+	return ""
+}
+
+func (x *DeadLetterAttempt) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+// 最终发送失败的通知
+type DeadLetter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 死信ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 失败的通知ID，重放沿用该ID
+	NotificationId int64 `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 业务方某个业务内部的唯一标识
+	Key               string  `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Channel           Channel `protobuf:"varint,4,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	TemplateId        string  `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	TemplateVersionId string  `protobuf:"bytes,6,opt,name=template_version_id,json=templateVersionId,proto3" json:"template_version_id,omitempty"`
+	// 最后一次提交的供应商
+	Provider string `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 失败原因分类，如 INVALID_RECEIVER、TEMPLATE、NO_PROVIDER、RATE_LIMITED、PROVIDER_ERROR
+	ErrCategory string `protobuf:"bytes,8,opt,name=err_category,json=errCategory,proto3" json:"err_category,omitempty"`
+	// 最后一次的错误描述
+	ErrMsg string `protobuf:"bytes,9,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	// 自动重试次数
+	RetryCount int32                `protobuf:"varint,10,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	Attempts   []*DeadLetterAttempt `protobuf:"bytes,11,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	Status     DeadLetterStatus     `protobuf:"varint,12,opt,name=status,proto3,enum=notification.v1.DeadLetterStatus" json:"status,omitempty"`
+	// 已重放次数
+	ReplayCount int32 `protobuf:"varint,13,opt,name=replay_count,json=replayCount,proto3" json:"replay_count,omitempty"`
+	// 进入死信的时间，毫秒时间戳
+	Ctime int64 `protobuf:"varint,14,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	// 更新时间，毫秒时间戳
+	Utime         int64 `protobuf:"varint,15,opt,name=utime,proto3" json:"utime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_notification_v1_dead_letter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeadLetter) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *DeadLetter) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetTemplateVersionId() string {
+	if x != nil {
+		return x.TemplateVersionId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetErrCategory() string {
+	if x != nil {
+		return x.ErrCategory
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetAttempts() []*DeadLetterAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetStatus() DeadLetterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeadLetterStatus_DEAD_LETTER_STATUS_UNSPECIFIED
+}
+
+func (x *DeadLetter) GetReplayCount() int32 {
+	if x != nil {
+		return x.ReplayCount
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+// 死信分页查询请求
+type ListDeadLettersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 渠道，不传时不限
+	Channel Channel `protobuf:"varint,1,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 进入死信的时间下限，毫秒时间戳，0表示不限
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// 进入死信的时间上限，毫秒时间戳，0表示不限
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 状态，不传时不限
+	Status DeadLetterStatus `protobuf:"varint,4,opt,name=status,proto3,enum=notification.v1.DeadLetterStatus" json:"status,omitempty"`
+	// 游标，即上一页最后一条死信的ID，首页传0
+	Cursor int64 `protobuf:"varint,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 每页条数
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_dead_letter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDeadLettersRequest) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *ListDeadLettersRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetStatus() DeadLetterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeadLetterStatus_DEAD_LETTER_STATUS_UNSPECIFIED
+}
+
+func (x *ListDeadLettersRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 死信分页查询响应
+type ListDeadLettersResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetters []*DeadLetter          `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	// 下一页游标
+	NextCursor int64 `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// 是否还有更多数据
+	HasMore       bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_dead_letter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *ListDeadLettersResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 重放请求
+type ReplayDeadLettersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 死信ID列表，单条重放时只传一个
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// 改用的渠道，必须是原渠道或者通知配置过的降级渠道，不传时不修改
+	Channel Channel `protobuf:"varint,2,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 指定发送的模板版本，不传时使用模板当前的发布版本
+	TemplateVersionId string `protobuf:"bytes,3,opt,name=template_version_id,json=templateVersionId,proto3" json:"template_version_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_dead_letter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReplayDeadLettersRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ReplayDeadLettersRequest) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *ReplayDeadLettersRequest) GetTemplateVersionId() string {
+	if x != nil {
+		return x.TemplateVersionId
+	}
+	return ""
+}
+
+// 单条死信的重放结果
+type ReplayDeadLetterResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 死信ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 重放的通知ID
+	NotificationId int64 `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 是否已经重新进入待发送
+	Success       bool      `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorCode     ErrorCode `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
+	ErrorMessage  string    `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLetterResult) Reset() {
+	*x = ReplayDeadLetterResult{}
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLetterResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterResult) ProtoMessage() {}
+
+func (x *ReplayDeadLetterResult) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterResult.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterResult) Descriptor() ([]byte, []int) {
+	return file_notification_v1_dead_letter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReplayDeadLetterResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReplayDeadLetterResult) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *ReplayDeadLetterResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReplayDeadLetterResult) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *ReplayDeadLetterResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// 重放响应
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*ReplayDeadLetterResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_dead_letter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_dead_letter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReplayDeadLettersResponse) GetResults() []*ReplayDeadLetterResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_notification_v1_dead_letter_proto protoreflect.FileDescriptor
+
+const file_notification_v1_dead_letter_proto_rawDesc = "" +
+	"\n" +
+	"!notification/v1/dead_letter.proto\x12\x0fnotification.v1\x1a\"notification/v1/notification.proto\"\xd4\x01\n" +
+	"\x11DeadLetterAttempt\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.notification.v1.DeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12\x19\n" +
+	"\berr_code\x18\x05 \x01(\tR\aerrCode\x12\x17\n" +
+	"\aerr_msg\x18\x06 \x01(\tR\x06errMsg\"\x9f\x04\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x03R\x0enotificationId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x122\n" +
+	"\achannel\x18\x04 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1f\n" +
+	"\vtemplate_id\x18\x05 \x01(\tR\n" +
+	"templateId\x12.\n" +
+	"\x13template_version_id\x18\x06 \x01(\tR\x11templateVersionId\x12\x1a\n" +
+	"\bprovider\x18\a \x01(\tR\bprovider\x12!\n" +
+	"\ferr_category\x18\b \x01(\tR\verrCategory\x12\x17\n" +
+	"\aerr_msg\x18\t \x01(\tR\x06errMsg\x12\x1f\n" +
+	"\vretry_count\x18\n" +
+	" \x01(\x05R\n" +
+	"retryCount\x12>\n" +
+	"\battempts\x18\v \x03(\v2\".notification.v1.DeadLetterAttemptR\battempts\x129\n" +
+	"\x06status\x18\f \x01(\x0e2!.notification.v1.DeadLetterStatusR\x06status\x12!\n" +
+	"\freplay_count\x18\r \x01(\x05R\vreplayCount\x12\x14\n" +
+	"\x05ctime\x18\x0e \x01(\x03R\x05ctime\x12\x14\n" +
+	"\x05utime\x18\x0f \x01(\x03R\x05utime\"\xef\x01\n" +
+	"\x16ListDeadLettersRequest\x122\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\x03R\aendTime\x129\n" +
+	"\x06status\x18\x04 \x01(\x0e2!.notification.v1.DeadLetterStatusR\x06status\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\x95\x01\n" +
+	"\x17ListDeadLettersResponse\x12>\n" +
+	"\fdead_letters\x18\x01 \x03(\v2\x1b.notification.v1.DeadLetterR\vdeadLetters\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x90\x01\n" +
+	"\x18ReplayDeadLettersRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x122\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12.\n" +
+	"\x13template_version_id\x18\x03 \x01(\tR\x11templateVersionId\"\xcb\x01\n" +
+	"\x16ReplayDeadLetterResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x03R\x0enotificationId\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x129\n" +
+	"\n" +
+	"error_code\x18\x04 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"^\n" +
+	"\x19ReplayDeadLettersResponse\x12A\n" +
+	"\aresults\x18\x01 \x03(\v2'.notification.v1.ReplayDeadLetterResultR\aresults*i\n" +
+	"\x10DeadLetterStatus\x12\"\n" +
+	"\x1eDEAD_LETTER_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13DEAD_LETTER_PENDING\x10\x01\x12\x18\n" +
+	"\x14DEAD_LETTER_REPLAYED\x10\x022\xe5\x01\n" +
+	"\x11DeadLetterService\x12d\n" +
+	"\x0fListDeadLetters\x12'.notification.v1.ListDeadLettersRequest\x1a(.notification.v1.ListDeadLettersResponse\x12j\n" +
+	"\x11ReplayDeadLetters\x12).notification.v1.ReplayDeadLettersRequest\x1a*.notification.v1.ReplayDeadLettersResponseB\xc1\x01\n" +
+	"\x13com.notification.v1B\x0fDeadLetterProtoP\x01Z<go-notification/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_dead_letter_proto_rawDescOnce sync.Once
+	file_notification_v1_dead_letter_proto_rawDescData []byte
+)
+
+func file_notification_v1_dead_letter_proto_rawDescGZIP() []byte {
+	file_notification_v1_dead_letter_proto_rawDescOnce.Do(func() {
+		file_notification_v1_dead_letter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_dead_letter_proto_rawDesc), len(file_notification_v1_dead_letter_proto_rawDesc)))
+	})
+	return file_notification_v1_dead_letter_proto_rawDescData
+}
+
+var file_notification_v1_dead_letter_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notification_v1_dead_letter_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_notification_v1_dead_letter_proto_goTypes = []any{
+	(DeadLetterStatus)(0),             // 0: notification.v1.DeadLetterStatus
+	(*DeadLetterAttempt)(nil),         // 1: notification.v1.DeadLetterAttempt
+	(*DeadLetter)(nil),                // 2: notification.v1.DeadLetter
+	(*ListDeadLettersRequest)(nil),    // 3: notification.v1.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),   // 4: notification.v1.ListDeadLettersResponse
+	(*ReplayDeadLettersRequest)(nil),  // 5: notification.v1.ReplayDeadLettersRequest
+	(*ReplayDeadLetterResult)(nil),    // 6: notification.v1.ReplayDeadLetterResult
+	(*ReplayDeadLettersResponse)(nil), // 7: notification.v1.ReplayDeadLettersResponse
+	(DeliveryStatus)(0),               // 8: notification.v1.DeliveryStatus
+	(Channel)(0),                      // 9: notification.v1.Channel
+	(ErrorCode)(0),                    // 10: notification.v1.ErrorCode
+}
+var file_notification_v1_dead_letter_proto_depIdxs = []int32{
+	8,  // 0: notification.v1.DeadLetterAttempt.status:type_name -> notification.v1.DeliveryStatus
+	9,  // 1: notification.v1.DeadLetter.channel:type_name -> notification.v1.Channel
+	1,  // 2: notification.v1.DeadLetter.attempts:type_name -> notification.v1.DeadLetterAttempt
+	0,  // 3: notification.v1.DeadLetter.status:type_name -> notification.v1.DeadLetterStatus
+	9,  // 4: notification.v1.ListDeadLettersRequest.channel:type_name -> notification.v1.Channel
+	0,  // 5: notification.v1.ListDeadLettersRequest.status:type_name -> notification.v1.DeadLetterStatus
+	2,  // 6: notification.v1.ListDeadLettersResponse.dead_letters:type_name -> notification.v1.DeadLetter
+	9,  // 7: notification.v1.ReplayDeadLettersRequest.channel:type_name -> notification.v1.Channel
+	10, // 8: notification.v1.ReplayDeadLetterResult.error_code:type_name -> notification.v1.ErrorCode
+	6,  // 9: notification.v1.ReplayDeadLettersResponse.results:type_name -> notification.v1.ReplayDeadLetterResult
+	3,  // 10: notification.v1.DeadLetterService.ListDeadLetters:input_type -> notification.v1.ListDeadLettersRequest
+	5,  // 11: notification.v1.DeadLetterService.ReplayDeadLetters:input_type -> notification.v1.ReplayDeadLettersRequest
+	4,  // 12: notification.v1.DeadLetterService.ListDeadLetters:output_type -> notification.v1.ListDeadLettersResponse
+	7,  // 13: notification.v1.DeadLetterService.ReplayDeadLetters:output_type -> notification.v1.ReplayDeadLettersResponse
+	12, // [12:14] is the sub-list for method output_type
+	10, // [10:12] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_dead_letter_proto_init() }
+func file_notification_v1_dead_letter_proto_init() {
+	if File_notification_v1_dead_letter_proto != nil {
+		return
+	}
+	file_notification_v1_notification_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_dead_letter_proto_rawDesc), len(file_notification_v1_dead_letter_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_dead_letter_proto_goTypes,
+		DependencyIndexes: file_notification_v1_dead_letter_proto_depIdxs,
+		EnumInfos:         file_notification_v1_dead_letter_proto_enumTypes,
+		MessageInfos:      file_notification_v1_dead_letter_proto_msgTypes,
+	}.Build()
+	File_notification_v1_dead_letter_proto = out.File
+	file_notification_v1_dead_letter_proto_goTypes = nil
+	file_notification_v1_dead_letter_proto_depIdxs = nil
+}
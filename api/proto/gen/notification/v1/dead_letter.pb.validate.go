@@ -0,0 +1,919 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification/v1/dead_letter.proto
+
+package notificationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DeadLetterAttempt with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeadLetterAttempt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeadLetterAttempt with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeadLetterAttemptMultiError, or nil if none found.
+func (m *DeadLetterAttempt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeadLetterAttempt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	// no validation rules for Provider
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for ErrCode
+
+	// no validation rules for ErrMsg
+
+	if len(errors) > 0 {
+		return DeadLetterAttemptMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeadLetterAttemptMultiError is an error wrapping multiple validation errors
+// returned by DeadLetterAttempt.ValidateAll() if the designated constraints
+// aren't met.
+type DeadLetterAttemptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeadLetterAttemptMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeadLetterAttemptMultiError) AllErrors() []error { return m }
+
+// DeadLetterAttemptValidationError is the validation error returned by
+// DeadLetterAttempt.Validate if the designated constraints aren't met.
+type DeadLetterAttemptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterAttemptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterAttemptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterAttemptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterAttemptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterAttemptValidationError) ErrorName() string {
+	return "DeadLetterAttemptValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeadLetterAttemptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetterAttempt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterAttemptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterAttemptValidationError{}
+
+// Validate checks the field values on DeadLetter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeadLetter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeadLetter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeadLetterMultiError, or
+// nil if none found.
+func (m *DeadLetter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeadLetter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Key
+
+	// no validation rules for Channel
+
+	// no validation rules for TemplateId
+
+	// no validation rules for TemplateVersionId
+
+	// no validation rules for Provider
+
+	// no validation rules for ErrCategory
+
+	// no validation rules for ErrMsg
+
+	// no validation rules for RetryCount
+
+	for idx, item := range m.GetAttempts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeadLetterValidationError{
+						field:  fmt.Sprintf("Attempts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeadLetterValidationError{
+						field:  fmt.Sprintf("Attempts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeadLetterValidationError{
+					field:  fmt.Sprintf("Attempts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for ReplayCount
+
+	// no validation rules for Ctime
+
+	// no validation rules for Utime
+
+	if len(errors) > 0 {
+		return DeadLetterMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeadLetterMultiError is an error wrapping multiple validation errors
+// returned by DeadLetter.ValidateAll() if the designated constraints aren't met.
+type DeadLetterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeadLetterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeadLetterMultiError) AllErrors() []error { return m }
+
+// DeadLetterValidationError is the validation error returned by
+// DeadLetter.Validate if the designated constraints aren't met.
+type DeadLetterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterValidationError) ErrorName() string { return "DeadLetterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterValidationError{}
+
+// Validate checks the field values on ListDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeadLettersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeadLettersRequestMultiError, or nil if none found.
+func (m *ListDeadLettersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeadLettersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Status
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListDeadLettersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeadLettersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeadLettersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeadLettersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeadLettersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeadLettersRequestMultiError) AllErrors() []error { return m }
+
+// ListDeadLettersRequestValidationError is the validation error returned by
+// ListDeadLettersRequest.Validate if the designated constraints aren't met.
+type ListDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersRequestValidationError) ErrorName() string {
+	return "ListDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersRequestValidationError{}
+
+// Validate checks the field values on ListDeadLettersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeadLettersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeadLettersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeadLettersResponseMultiError, or nil if none found.
+func (m *ListDeadLettersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeadLettersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeadLetters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("DeadLetters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("DeadLetters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeadLettersResponseValidationError{
+					field:  fmt.Sprintf("DeadLetters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	// no validation rules for HasMore
+
+	if len(errors) > 0 {
+		return ListDeadLettersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeadLettersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeadLettersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeadLettersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeadLettersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeadLettersResponseMultiError) AllErrors() []error { return m }
+
+// ListDeadLettersResponseValidationError is the validation error returned by
+// ListDeadLettersResponse.Validate if the designated constraints aren't met.
+type ListDeadLettersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersResponseValidationError) ErrorName() string {
+	return "ListDeadLettersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersResponseValidationError{}
+
+// Validate checks the field values on ReplayDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLettersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLettersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayDeadLettersRequestMultiError, or nil if none found.
+func (m *ReplayDeadLettersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLettersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	// no validation rules for TemplateVersionId
+
+	if len(errors) > 0 {
+		return ReplayDeadLettersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLettersRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplayDeadLettersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayDeadLettersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLettersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLettersRequestMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLettersRequestValidationError is the validation error returned by
+// ReplayDeadLettersRequest.Validate if the designated constraints aren't met.
+type ReplayDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLettersRequestValidationError) ErrorName() string {
+	return "ReplayDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLettersRequestValidationError{}
+
+// Validate checks the field values on ReplayDeadLetterResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLetterResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLetterResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayDeadLetterResultMultiError, or nil if none found.
+func (m *ReplayDeadLetterResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLetterResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Success
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return ReplayDeadLetterResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLetterResultMultiError is an error wrapping multiple validation
+// errors returned by ReplayDeadLetterResult.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayDeadLetterResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLetterResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLetterResultMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLetterResultValidationError is the validation error returned by
+// ReplayDeadLetterResult.Validate if the designated constraints aren't met.
+type ReplayDeadLetterResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLetterResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLetterResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLetterResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLetterResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLetterResultValidationError) ErrorName() string {
+	return "ReplayDeadLetterResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLetterResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLetterResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLetterResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLetterResultValidationError{}
+
+// Validate checks the field values on ReplayDeadLettersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLettersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLettersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayDeadLettersResponseMultiError, or nil if none found.
+func (m *ReplayDeadLettersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLettersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplayDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplayDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplayDeadLettersResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReplayDeadLettersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLettersResponseMultiError is an error wrapping multiple validation
+// errors returned by ReplayDeadLettersResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReplayDeadLettersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLettersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLettersResponseMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLettersResponseValidationError is the validation error returned by
+// ReplayDeadLettersResponse.Validate if the designated constraints aren't met.
+type ReplayDeadLettersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLettersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLettersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLettersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLettersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLettersResponseValidationError) ErrorName() string {
+	return "ReplayDeadLettersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLettersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLettersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLettersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLettersResponseValidationError{}
@@ -0,0 +1,885 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification/v1/inbox.proto
+
+package notificationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 站内信
+type InboxMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 关联的通知ID
+	NotificationId int64 `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 接收者
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 标题
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// 渲染后的内容
+	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// 是否已读
+	Read bool `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
+	// 阅读时间，毫秒时间戳
+	ReadTime int64 `protobuf:"varint,7,opt,name=read_time,json=readTime,proto3" json:"read_time,omitempty"`
+	// 创建时间，毫秒时间戳
+	Ctime         int64 `protobuf:"varint,8,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxMessage) Reset() {
+	*x = InboxMessage{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxMessage) ProtoMessage() {}
+
+func (x *InboxMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxMessage.ProtoReflect.Descriptor instead.
+func (*InboxMessage) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InboxMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *InboxMessage) GetReadTime() int64 {
+	if x != nil {
+		return x.ReadTime
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+// 收件箱分页查询请求
+type ListInboxMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 游标，即上一页最后一条消息的ID，首页传0
+	Cursor int64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 每页条数
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 是否只查询未读消息
+	UnreadOnly    bool `protobuf:"varint,4,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxMessagesRequest) Reset() {
+	*x = ListInboxMessagesRequest{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxMessagesRequest) ProtoMessage() {}
+
+func (x *ListInboxMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListInboxMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListInboxMessagesRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ListInboxMessagesRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListInboxMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListInboxMessagesRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+// 收件箱分页查询响应
+type ListInboxMessagesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Messages []*InboxMessage        `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// 下一页游标
+	NextCursor int64 `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// 是否还有更多数据
+	HasMore       bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxMessagesResponse) Reset() {
+	*x = ListInboxMessagesResponse{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxMessagesResponse) ProtoMessage() {}
+
+func (x *ListInboxMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListInboxMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListInboxMessagesResponse) GetMessages() []*InboxMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListInboxMessagesResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *ListInboxMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 未读数查询请求
+type GetUnreadCountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者
+	Receiver      string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountRequest) Reset() {
+	*x = GetUnreadCountRequest{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUnreadCountRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+// 未读数查询响应
+type GetUnreadCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountResponse) Reset() {
+	*x = GetUnreadCountResponse{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUnreadCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 标记已读请求
+type MarkReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 消息ID列表
+	Ids           []int64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReadRequest) Reset() {
+	*x = MarkReadRequest{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadRequest) ProtoMessage() {}
+
+func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MarkReadRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *MarkReadRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// 标记已读响应
+type MarkReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 受影响的消息数
+	Affected      int64 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReadResponse) Reset() {
+	*x = MarkReadResponse{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadResponse) ProtoMessage() {}
+
+func (x *MarkReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkReadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarkReadResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// 标记未读请求
+type MarkUnreadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 消息ID列表
+	Ids           []int64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkUnreadRequest) Reset() {
+	*x = MarkUnreadRequest{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkUnreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkUnreadRequest) ProtoMessage() {}
+
+func (x *MarkUnreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkUnreadRequest.ProtoReflect.Descriptor instead.
+func (*MarkUnreadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarkUnreadRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *MarkUnreadRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// 标记未读响应
+type MarkUnreadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 受影响的消息数
+	Affected      int64 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkUnreadResponse) Reset() {
+	*x = MarkUnreadResponse{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkUnreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkUnreadResponse) ProtoMessage() {}
+
+func (x *MarkUnreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkUnreadResponse.ProtoReflect.Descriptor instead.
+func (*MarkUnreadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MarkUnreadResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// 全部已读请求
+type MarkAllReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者
+	Receiver      string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllReadRequest) Reset() {
+	*x = MarkAllReadRequest{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllReadRequest) ProtoMessage() {}
+
+func (x *MarkAllReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllReadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MarkAllReadRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+// 全部已读响应
+type MarkAllReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 受影响的消息数
+	Affected      int64 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllReadResponse) Reset() {
+	*x = MarkAllReadResponse{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllReadResponse) ProtoMessage() {}
+
+func (x *MarkAllReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAllReadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MarkAllReadResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// 删除消息请求
+type DeleteInboxMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 消息ID列表
+	Ids           []int64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInboxMessagesRequest) Reset() {
+	*x = DeleteInboxMessagesRequest{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInboxMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInboxMessagesRequest) ProtoMessage() {}
+
+func (x *DeleteInboxMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInboxMessagesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInboxMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteInboxMessagesRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *DeleteInboxMessagesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// 删除消息响应
+type DeleteInboxMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 受影响的消息数
+	Affected      int64 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInboxMessagesResponse) Reset() {
+	*x = DeleteInboxMessagesResponse{}
+	mi := &file_notification_v1_inbox_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInboxMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInboxMessagesResponse) ProtoMessage() {}
+
+func (x *DeleteInboxMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_inbox_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInboxMessagesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInboxMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_inbox_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteInboxMessagesResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+var File_notification_v1_inbox_proto protoreflect.FileDescriptor
+
+const file_notification_v1_inbox_proto_rawDesc = "" +
+	"\n" +
+	"\x1bnotification/v1/inbox.proto\x12\x0fnotification.v1\"\xda\x01\n" +
+	"\fInboxMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x03R\x0enotificationId\x12\x1a\n" +
+	"\breceiver\x18\x03 \x01(\tR\breceiver\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x12\n" +
+	"\x04read\x18\x06 \x01(\bR\x04read\x12\x1b\n" +
+	"\tread_time\x18\a \x01(\x03R\breadTime\x12\x14\n" +
+	"\x05ctime\x18\b \x01(\x03R\x05ctime\"\x85\x01\n" +
+	"\x18ListInboxMessagesRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vunread_only\x18\x04 \x01(\bR\n" +
+	"unreadOnly\"\x92\x01\n" +
+	"\x19ListInboxMessagesResponse\x129\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1d.notification.v1.InboxMessageR\bmessages\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"3\n" +
+	"\x15GetUnreadCountRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\".\n" +
+	"\x16GetUnreadCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"?\n" +
+	"\x0fMarkReadRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\".\n" +
+	"\x10MarkReadResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x03R\baffected\"A\n" +
+	"\x11MarkUnreadRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\"0\n" +
+	"\x12MarkUnreadResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x03R\baffected\"0\n" +
+	"\x12MarkAllReadRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\"1\n" +
+	"\x13MarkAllReadResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x03R\baffected\"J\n" +
+	"\x1aDeleteInboxMessagesRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\"9\n" +
+	"\x1bDeleteInboxMessagesResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x03R\baffected2\xd1\x04\n" +
+	"\fInboxService\x12j\n" +
+	"\x11ListInboxMessages\x12).notification.v1.ListInboxMessagesRequest\x1a*.notification.v1.ListInboxMessagesResponse\x12a\n" +
+	"\x0eGetUnreadCount\x12&.notification.v1.GetUnreadCountRequest\x1a'.notification.v1.GetUnreadCountResponse\x12O\n" +
+	"\bMarkRead\x12 .notification.v1.MarkReadRequest\x1a!.notification.v1.MarkReadResponse\x12U\n" +
+	"\n" +
+	"MarkUnread\x12\".notification.v1.MarkUnreadRequest\x1a#.notification.v1.MarkUnreadResponse\x12X\n" +
+	"\vMarkAllRead\x12#.notification.v1.MarkAllReadRequest\x1a$.notification.v1.MarkAllReadResponse\x12p\n" +
+	"\x13DeleteInboxMessages\x12+.notification.v1.DeleteInboxMessagesRequest\x1a,.notification.v1.DeleteInboxMessagesResponseB\xbc\x01\n" +
+	"\x13com.notification.v1B\n" +
+	"InboxProtoP\x01Z<go-notification/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_inbox_proto_rawDescOnce sync.Once
+	file_notification_v1_inbox_proto_rawDescData []byte
+)
+
+func file_notification_v1_inbox_proto_rawDescGZIP() []byte {
+	file_notification_v1_inbox_proto_rawDescOnce.Do(func() {
+		file_notification_v1_inbox_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_inbox_proto_rawDesc), len(file_notification_v1_inbox_proto_rawDesc)))
+	})
+	return file_notification_v1_inbox_proto_rawDescData
+}
+
+var file_notification_v1_inbox_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_notification_v1_inbox_proto_goTypes = []any{
+	(*InboxMessage)(nil),                // 0: notification.v1.InboxMessage
+	(*ListInboxMessagesRequest)(nil),    // 1: notification.v1.ListInboxMessagesRequest
+	(*ListInboxMessagesResponse)(nil),   // 2: notification.v1.ListInboxMessagesResponse
+	(*GetUnreadCountRequest)(nil),       // 3: notification.v1.GetUnreadCountRequest
+	(*GetUnreadCountResponse)(nil),      // 4: notification.v1.GetUnreadCountResponse
+	(*MarkReadRequest)(nil),             // 5: notification.v1.MarkReadRequest
+	(*MarkReadResponse)(nil),            // 6: notification.v1.MarkReadResponse
+	(*MarkUnreadRequest)(nil),           // 7: notification.v1.MarkUnreadRequest
+	(*MarkUnreadResponse)(nil),          // 8: notification.v1.MarkUnreadResponse
+	(*MarkAllReadRequest)(nil),          // 9: notification.v1.MarkAllReadRequest
+	(*MarkAllReadResponse)(nil),         // 10: notification.v1.MarkAllReadResponse
+	(*DeleteInboxMessagesRequest)(nil),  // 11: notification.v1.DeleteInboxMessagesRequest
+	(*DeleteInboxMessagesResponse)(nil), // 12: notification.v1.DeleteInboxMessagesResponse
+}
+var file_notification_v1_inbox_proto_depIdxs = []int32{
+	0,  // 0: notification.v1.ListInboxMessagesResponse.messages:type_name -> notification.v1.InboxMessage
+	1,  // 1: notification.v1.InboxService.ListInboxMessages:input_type -> notification.v1.ListInboxMessagesRequest
+	3,  // 2: notification.v1.InboxService.GetUnreadCount:input_type -> notification.v1.GetUnreadCountRequest
+	5,  // 3: notification.v1.InboxService.MarkRead:input_type -> notification.v1.MarkReadRequest
+	7,  // 4: notification.v1.InboxService.MarkUnread:input_type -> notification.v1.MarkUnreadRequest
+	9,  // 5: notification.v1.InboxService.MarkAllRead:input_type -> notification.v1.MarkAllReadRequest
+	11, // 6: notification.v1.InboxService.DeleteInboxMessages:input_type -> notification.v1.DeleteInboxMessagesRequest
+	2,  // 7: notification.v1.InboxService.ListInboxMessages:output_type -> notification.v1.ListInboxMessagesResponse
+	4,  // 8: notification.v1.InboxService.GetUnreadCount:output_type -> notification.v1.GetUnreadCountResponse
+	6,  // 9: notification.v1.InboxService.MarkRead:output_type -> notification.v1.MarkReadResponse
+	8,  // 10: notification.v1.InboxService.MarkUnread:output_type -> notification.v1.MarkUnreadResponse
+	10, // 11: notification.v1.InboxService.MarkAllRead:output_type -> notification.v1.MarkAllReadResponse
+	12, // 12: notification.v1.InboxService.DeleteInboxMessages:output_type -> notification.v1.DeleteInboxMessagesResponse
+	7,  // [7:13] is the sub-list for method output_type
+	1,  // [1:7] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_inbox_proto_init() }
+func file_notification_v1_inbox_proto_init() {
+	if File_notification_v1_inbox_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_inbox_proto_rawDesc), len(file_notification_v1_inbox_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_inbox_proto_goTypes,
+		DependencyIndexes: file_notification_v1_inbox_proto_depIdxs,
+		MessageInfos:      file_notification_v1_inbox_proto_msgTypes,
+	}.Build()
+	File_notification_v1_inbox_proto = out.File
+	file_notification_v1_inbox_proto_goTypes = nil
+	file_notification_v1_inbox_proto_depIdxs = nil
+}
@@ -34,6 +34,12 @@ const (
 	Channel_EMAIL Channel = 2
 	// 站内信
 	Channel_IN_APP Channel = 3
+	// 回调业务方HTTP接口
+	Channel_WEBHOOK Channel = 4
+	// 企业IM群机器人（钉钉/飞书/企业微信）
+	Channel_IM Channel = 5
+	// 移动端推送，接收者为设备令牌
+	Channel_PUSH Channel = 6
 )
 
 // Enum value maps for Channel.
@@ -43,12 +49,18 @@ var (
 		1: "SMS",
 		2: "EMAIL",
 		3: "IN_APP",
+		4: "WEBHOOK",
+		5: "IM",
+		6: "PUSH",
 	}
 	Channel_value = map[string]int32{
 		"CHANNEL_UNSPECIFIED": 0,
 		"SMS":                 1,
 		"EMAIL":               2,
 		"IN_APP":              3,
+		"WEBHOOK":             4,
+		"IM":                  5,
+		"PUSH":                6,
 	}
 )
 
@@ -95,6 +107,10 @@ const (
 	SendStatus_SUCCEEDED SendStatus = 4
 	// 发送失败
 	SendStatus_FAILED SendStatus = 5
+	// 部分接收者发送成功，重试时只发送失败的接收者
+	SendStatus_PARTIALLY_SUCCEEDED SendStatus = 6
+	// 接收者的发送频率超过业务方配置的上限，没有发送
+	SendStatus_FREQUENCY_CAPPED SendStatus = 7
 )
 
 // Enum value maps for SendStatus.
@@ -106,6 +122,8 @@ var (
 		3: "PENDING",
 		4: "SUCCEEDED",
 		5: "FAILED",
+		6: "PARTIALLY_SUCCEEDED",
+		7: "FREQUENCY_CAPPED",
 	}
 	SendStatus_value = map[string]int32{
 		"SEND_STATUS_UNSPECIFIED": 0,
@@ -114,6 +132,8 @@ var (
 		"PENDING":                 3,
 		"SUCCEEDED":               4,
 		"FAILED":                  5,
+		"PARTIALLY_SUCCEEDED":     6,
+		"FREQUENCY_CAPPED":        7,
 	}
 )
 
@@ -144,6 +164,74 @@ func (SendStatus) EnumDescriptor() ([]byte, []int) {
 	return file_notification_v1_notification_proto_rawDescGZIP(), []int{1}
 }
 
+// 单个接收者的发送和送达状态
+type DeliveryStatus int32
+
+const (
+	DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED DeliveryStatus = 0
+	// 已提交供应商，等待回执
+	DeliveryStatus_WAITING DeliveryStatus = 1
+	// 用户已接收
+	DeliveryStatus_DELIVERED DeliveryStatus = 2
+	// 用户未接收或回执超时
+	DeliveryStatus_UNDELIVERED DeliveryStatus = 3
+	// 尚未提交供应商
+	DeliveryStatus_DELIVERY_PENDING DeliveryStatus = 4
+	// 提交供应商失败，重试时会重新发送
+	DeliveryStatus_SUBMIT_FAILED DeliveryStatus = 5
+	// 已提交供应商，该渠道没有回执
+	DeliveryStatus_SUBMITTED DeliveryStatus = 6
+)
+
+// Enum value maps for DeliveryStatus.
+var (
+	DeliveryStatus_name = map[int32]string{
+		0: "DELIVERY_STATUS_UNSPECIFIED",
+		1: "WAITING",
+		2: "DELIVERED",
+		3: "UNDELIVERED",
+		4: "DELIVERY_PENDING",
+		5: "SUBMIT_FAILED",
+		6: "SUBMITTED",
+	}
+	DeliveryStatus_value = map[string]int32{
+		"DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WAITING":                     1,
+		"DELIVERED":                   2,
+		"UNDELIVERED":                 3,
+		"DELIVERY_PENDING":            4,
+		"SUBMIT_FAILED":               5,
+		"SUBMITTED":                   6,
+	}
+)
+
+func (x DeliveryStatus) Enum() *DeliveryStatus {
+	p := new(DeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x DeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_proto_enumTypes[2].Descriptor()
+}
+
+func (DeliveryStatus) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_proto_enumTypes[2]
+}
+
+func (x DeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryStatus.Descriptor instead.
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
 // 错误代码枚举
 type ErrorCode int32
 
@@ -182,6 +270,8 @@ const (
 	ErrorCode_PROVIDER_NOT_FOUND ErrorCode = 15
 	// 未知渠道类型
 	ErrorCode_UNKNOWN_CHANNEL ErrorCode = 16
+	// 接收者的发送频率超过上限
+	ErrorCode_FREQUENCY_CAP_EXCEEDED ErrorCode = 17
 )
 
 // Enum value maps for ErrorCode.
@@ -204,6 +294,7 @@ var (
 		14: "QUOTA_NOT_FOUND",
 		15: "PROVIDER_NOT_FOUND",
 		16: "UNKNOWN_CHANNEL",
+		17: "FREQUENCY_CAP_EXCEEDED",
 	}
 	ErrorCode_value = map[string]int32{
 		"ERROR_CODE_UNSPECIFIED":     0,
@@ -223,6 +314,7 @@ var (
 		"QUOTA_NOT_FOUND":            14,
 		"PROVIDER_NOT_FOUND":         15,
 		"UNKNOWN_CHANNEL":            16,
+		"FREQUENCY_CAP_EXCEEDED":     17,
 	}
 )
 
@@ -237,11 +329,11 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_notification_v1_notification_proto_enumTypes[2].Descriptor()
+	return file_notification_v1_notification_proto_enumTypes[3].Descriptor()
 }
 
 func (ErrorCode) Type() protoreflect.EnumType {
-	return &file_notification_v1_notification_proto_enumTypes[2]
+	return &file_notification_v1_notification_proto_enumTypes[3]
 }
 
 func (x ErrorCode) Number() protoreflect.EnumNumber {
@@ -250,7 +342,67 @@ func (x ErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ErrorCode.Descriptor instead.
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+// 周期发送状态
+type SeriesStatus int32
+
+const (
+	SeriesStatus_SERIES_STATUS_UNSPECIFIED SeriesStatus = 0
+	// 按计划发送
+	SeriesStatus_SERIES_STATUS_ACTIVE SeriesStatus = 1
+	// 已暂停
+	SeriesStatus_SERIES_STATUS_PAUSED SeriesStatus = 2
+	// 已停止
+	SeriesStatus_SERIES_STATUS_STOPPED SeriesStatus = 3
+	// 达到截止时间或最多发送次数
+	SeriesStatus_SERIES_STATUS_COMPLETED SeriesStatus = 4
+)
+
+// Enum value maps for SeriesStatus.
+var (
+	SeriesStatus_name = map[int32]string{
+		0: "SERIES_STATUS_UNSPECIFIED",
+		1: "SERIES_STATUS_ACTIVE",
+		2: "SERIES_STATUS_PAUSED",
+		3: "SERIES_STATUS_STOPPED",
+		4: "SERIES_STATUS_COMPLETED",
+	}
+	SeriesStatus_value = map[string]int32{
+		"SERIES_STATUS_UNSPECIFIED": 0,
+		"SERIES_STATUS_ACTIVE":      1,
+		"SERIES_STATUS_PAUSED":      2,
+		"SERIES_STATUS_STOPPED":     3,
+		"SERIES_STATUS_COMPLETED":   4,
+	}
+)
+
+func (x SeriesStatus) Enum() *SeriesStatus {
+	p := new(SeriesStatus)
+	*p = x
+	return p
+}
+
+func (x SeriesStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeriesStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_proto_enumTypes[4].Descriptor()
+}
+
+func (SeriesStatus) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_proto_enumTypes[4]
+}
+
+func (x SeriesStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeriesStatus.Descriptor instead.
+func (SeriesStatus) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
 }
 
 // 通知发送策略定义
@@ -263,6 +415,8 @@ type SendStrategy struct {
 	//	*SendStrategy_Scheduled
 	//	*SendStrategy_TimeWindow
 	//	*SendStrategy_Deadline
+	//	*SendStrategy_Recurring
+	//	*SendStrategy_Digest
 	StrategyType  isSendStrategy_StrategyType `protobuf_oneof:"strategy_type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -350,6 +504,24 @@ func (x *SendStrategy) GetDeadline() *SendStrategy_DeadlineStrategy {
 	return nil
 }
 
+func (x *SendStrategy) GetRecurring() *SendStrategy_RecurringStrategy {
+	if x != nil {
+		if x, ok := x.StrategyType.(*SendStrategy_Recurring); ok {
+			return x.Recurring
+		}
+	}
+	return nil
+}
+
+func (x *SendStrategy) GetDigest() *SendStrategy_DigestStrategy {
+	if x != nil {
+		if x, ok := x.StrategyType.(*SendStrategy_Digest); ok {
+			return x.Digest
+		}
+	}
+	return nil
+}
+
 type isSendStrategy_StrategyType interface {
 	isSendStrategy_StrategyType()
 }
@@ -379,6 +551,16 @@ type SendStrategy_Deadline struct {
 	Deadline *SendStrategy_DeadlineStrategy `protobuf:"bytes,5,opt,name=deadline,proto3,oneof"`
 }
 
+type SendStrategy_Recurring struct {
+	// 按 cron 表达式周期发送
+	Recurring *SendStrategy_RecurringStrategy `protobuf:"bytes,6,opt,name=recurring,proto3,oneof"`
+}
+
+type SendStrategy_Digest struct {
+	// 合并同一接收者的多条通知汇总发送
+	Digest *SendStrategy_DigestStrategy `protobuf:"bytes,7,opt,name=digest,proto3,oneof"`
+}
+
 func (*SendStrategy_Immediate) isSendStrategy_StrategyType() {}
 
 func (*SendStrategy_Delayed) isSendStrategy_StrategyType() {}
@@ -389,6 +571,10 @@ func (*SendStrategy_TimeWindow) isSendStrategy_StrategyType() {}
 
 func (*SendStrategy_Deadline) isSendStrategy_StrategyType() {}
 
+func (*SendStrategy_Recurring) isSendStrategy_StrategyType() {}
+
+func (*SendStrategy_Digest) isSendStrategy_StrategyType() {}
+
 // 通知
 type Notification struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -403,10 +589,14 @@ type Notification struct {
 	// 模板参数
 	TemplateParams map[string]string `protobuf:"bytes,5,rep,name=template_params,json=templateParams,proto3" json:"template_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// 发送策略
-	SendStrategy  *SendStrategy `protobuf:"bytes,6,opt,name=send_strategy,json=sendStrategy,proto3" json:"send_strategy,omitempty"`
-	Receiver      string        `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SendStrategy *SendStrategy `protobuf:"bytes,6,opt,name=send_strategy,json=sendStrategy,proto3" json:"send_strategy,omitempty"`
+	Receiver     string        `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 降级渠道使用的模板，业务方开启渠道降级后，主渠道发送失败时按渠道优先级依次尝试
+	FallbackTemplates []*FallbackTemplate `protobuf:"bytes,8,rep,name=fallback_templates,json=fallbackTemplates,proto3" json:"fallback_templates,omitempty"`
+	// 接收者所在时区，IANA 时区名，例如 Asia/Shanghai，免打扰时段优先按接收者时区计算
+	ReceiverTimezone string `protobuf:"bytes,9,opt,name=receiver_timezone,json=receiverTimezone,proto3" json:"receiver_timezone,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Notification) Reset() {
@@ -488,6 +678,73 @@ func (x *Notification) GetReceiver() string {
 	return ""
 }
 
+func (x *Notification) GetFallbackTemplates() []*FallbackTemplate {
+	if x != nil {
+		return x.FallbackTemplates
+	}
+	return nil
+}
+
+func (x *Notification) GetReceiverTimezone() string {
+	if x != nil {
+		return x.ReceiverTimezone
+	}
+	return ""
+}
+
+// 降级渠道及其模板
+type FallbackTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       Channel                `protobuf:"varint,1,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FallbackTemplate) Reset() {
+	*x = FallbackTemplate{}
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FallbackTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FallbackTemplate) ProtoMessage() {}
+
+func (x *FallbackTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FallbackTemplate.ProtoReflect.Descriptor instead.
+func (*FallbackTemplate) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FallbackTemplate) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *FallbackTemplate) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
 // 同步单条通知发送请求
 type SendNotificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -498,7 +755,7 @@ type SendNotificationRequest struct {
 
 func (x *SendNotificationRequest) Reset() {
 	*x = SendNotificationRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -510,7 +767,7 @@ func (x *SendNotificationRequest) String() string {
 func (*SendNotificationRequest) ProtoMessage() {}
 
 func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +780,7 @@ func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SendNotificationRequest) GetNotification() *Notification {
@@ -543,14 +800,20 @@ type SendNotificationResponse struct {
 	// 发送时的错误代码
 	ErrorCode ErrorCode `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
 	// 错误信息
-	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 实际送达的渠道，发生渠道降级时与请求的渠道不同
+	DeliveredChannel Channel `protobuf:"varint,5,opt,name=delivered_channel,json=deliveredChannel,proto3,enum=notification.v1.Channel" json:"delivered_channel,omitempty"`
+	// 各接收者的发送和送达情况
+	Deliveries []*ReceiverDelivery `protobuf:"bytes,6,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// 合并发送的汇总通知ID，仅汇总发送的通知有
+	DigestNotificationId int64 `protobuf:"varint,7,opt,name=digest_notification_id,json=digestNotificationId,proto3" json:"digest_notification_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *SendNotificationResponse) Reset() {
 	*x = SendNotificationResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -562,7 +825,7 @@ func (x *SendNotificationResponse) String() string {
 func (*SendNotificationResponse) ProtoMessage() {}
 
 func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,7 +838,7 @@ func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SendNotificationResponse) GetNotificationId() int64 {
@@ -606,6 +869,107 @@ func (x *SendNotificationResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *SendNotificationResponse) GetDeliveredChannel() Channel {
+	if x != nil {
+		return x.DeliveredChannel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *SendNotificationResponse) GetDeliveries() []*ReceiverDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *SendNotificationResponse) GetDigestNotificationId() int64 {
+	if x != nil {
+		return x.DigestNotificationId
+	}
+	return 0
+}
+
+// 单个接收者的发送和送达情况
+type ReceiverDelivery struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Receiver string                 `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Status   DeliveryStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.DeliveryStatus" json:"status,omitempty"`
+	// 供应商返回的错误码
+	ErrCode string `protobuf:"bytes,3,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	// 供应商返回的错误描述
+	ErrMsg string `protobuf:"bytes,4,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	// 回执时间，毫秒时间戳
+	ReportTime    int64 `protobuf:"varint,5,opt,name=report_time,json=reportTime,proto3" json:"report_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiverDelivery) Reset() {
+	*x = ReceiverDelivery{}
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiverDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiverDelivery) ProtoMessage() {}
+
+func (x *ReceiverDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiverDelivery.ProtoReflect.Descriptor instead.
+func (*ReceiverDelivery) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReceiverDelivery) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ReceiverDelivery) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *ReceiverDelivery) GetErrCode() string {
+	if x != nil {
+		return x.ErrCode
+	}
+	return ""
+}
+
+func (x *ReceiverDelivery) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *ReceiverDelivery) GetReportTime() int64 {
+	if x != nil {
+		return x.ReportTime
+	}
+	return 0
+}
+
 // 异步单条通知发送请求
 type SendNotificationAsyncRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -616,7 +980,7 @@ type SendNotificationAsyncRequest struct {
 
 func (x *SendNotificationAsyncRequest) Reset() {
 	*x = SendNotificationAsyncRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -628,7 +992,7 @@ func (x *SendNotificationAsyncRequest) String() string {
 func (*SendNotificationAsyncRequest) ProtoMessage() {}
 
 func (x *SendNotificationAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +1005,7 @@ func (x *SendNotificationAsyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationAsyncRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SendNotificationAsyncRequest) GetNotification() *Notification {
@@ -666,7 +1030,7 @@ type SendNotificationAsyncResponse struct {
 
 func (x *SendNotificationAsyncResponse) Reset() {
 	*x = SendNotificationAsyncResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -678,7 +1042,7 @@ func (x *SendNotificationAsyncResponse) String() string {
 func (*SendNotificationAsyncResponse) ProtoMessage() {}
 
 func (x *SendNotificationAsyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +1055,7 @@ func (x *SendNotificationAsyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationAsyncResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationAsyncResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SendNotificationAsyncResponse) GetNotificationId() int64 {
@@ -725,7 +1089,7 @@ type SendNotificationBatchRequest struct {
 
 func (x *SendNotificationBatchRequest) Reset() {
 	*x = SendNotificationBatchRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -737,7 +1101,7 @@ func (x *SendNotificationBatchRequest) String() string {
 func (*SendNotificationBatchRequest) ProtoMessage() {}
 
 func (x *SendNotificationBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,7 +1114,7 @@ func (x *SendNotificationBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationBatchRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationBatchRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SendNotificationBatchRequest) GetNotifications() []*Notification {
@@ -775,7 +1139,7 @@ type SendNotificationBatchResponse struct {
 
 func (x *SendNotificationBatchResponse) Reset() {
 	*x = SendNotificationBatchResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -787,7 +1151,7 @@ func (x *SendNotificationBatchResponse) String() string {
 func (*SendNotificationBatchResponse) ProtoMessage() {}
 
 func (x *SendNotificationBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +1164,7 @@ func (x *SendNotificationBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationBatchResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationBatchResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SendNotificationBatchResponse) GetResults() []*SendNotificationResponse {
@@ -834,7 +1198,7 @@ type SendNotificationBatchAsyncRequest struct {
 
 func (x *SendNotificationBatchAsyncRequest) Reset() {
 	*x = SendNotificationBatchAsyncRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -846,7 +1210,7 @@ func (x *SendNotificationBatchAsyncRequest) String() string {
 func (*SendNotificationBatchAsyncRequest) ProtoMessage() {}
 
 func (x *SendNotificationBatchAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1223,7 @@ func (x *SendNotificationBatchAsyncRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SendNotificationBatchAsyncRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationBatchAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SendNotificationBatchAsyncRequest) GetNotifications() []*Notification {
@@ -879,7 +1243,7 @@ type SendNotificationBatchAsyncResponse struct {
 
 func (x *SendNotificationBatchAsyncResponse) Reset() {
 	*x = SendNotificationBatchAsyncResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -891,7 +1255,7 @@ func (x *SendNotificationBatchAsyncResponse) String() string {
 func (*SendNotificationBatchAsyncResponse) ProtoMessage() {}
 
 func (x *SendNotificationBatchAsyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,7 +1268,7 @@ func (x *SendNotificationBatchAsyncResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SendNotificationBatchAsyncResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationBatchAsyncResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SendNotificationBatchAsyncResponse) GetNotificationIds() []int64 {
@@ -914,29 +1278,604 @@ func (x *SendNotificationBatchAsyncResponse) GetNotificationIds() []int64 {
 	return nil
 }
 
-// 准备事务请求
-type PrepareTxRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notification  *Notification          `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
+// 流式异步发送请求
+type StreamSendNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 客户端为每条通知生成的序号，确认时原样返回
+	Seq           int64         `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Notification  *Notification `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PrepareTxRequest) Reset() {
-	*x = PrepareTxRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[10]
+func (x *StreamSendNotificationsRequest) Reset() {
+	*x = StreamSendNotificationsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PrepareTxRequest) String() string {
+func (x *StreamSendNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrepareTxRequest) ProtoMessage() {}
+func (*StreamSendNotificationsRequest) ProtoMessage() {}
 
-func (x *PrepareTxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[10]
+func (x *StreamSendNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSendNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*StreamSendNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamSendNotificationsRequest) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *StreamSendNotificationsRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// 流式异步发送的单条确认
+type StreamSendNotificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 请求中的序号
+	Seq int64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// 成功时为通知ID
+	NotificationId int64 `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 失败时的错误代码，例如参数不合法
+	ErrorCode     ErrorCode `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
+	ErrorMessage  string    `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSendNotificationsResponse) Reset() {
+	*x = StreamSendNotificationsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSendNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSendNotificationsResponse) ProtoMessage() {}
+
+func (x *StreamSendNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSendNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*StreamSendNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StreamSendNotificationsResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *StreamSendNotificationsResponse) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *StreamSendNotificationsResponse) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *StreamSendNotificationsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// 准备事务请求
+type PrepareTxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *Notification          `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareTxRequest) Reset() {
+	*x = PrepareTxRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareTxRequest) ProtoMessage() {}
+
+func (x *PrepareTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareTxRequest.ProtoReflect.Descriptor instead.
+func (*PrepareTxRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PrepareTxRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// 准备事务响应
+type PrepareTxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepareTxResponse) Reset() {
+	*x = PrepareTxResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepareTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareTxResponse) ProtoMessage() {}
+
+func (x *PrepareTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareTxResponse.ProtoReflect.Descriptor instead.
+func (*PrepareTxResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
+}
+
+// 提交事务请求
+type CommitTxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitTxRequest) Reset() {
+	*x = CommitTxRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitTxRequest) ProtoMessage() {}
+
+func (x *CommitTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitTxRequest.ProtoReflect.Descriptor instead.
+func (*CommitTxRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CommitTxRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 提交事务响应
+type CommitTxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitTxResponse) Reset() {
+	*x = CommitTxResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitTxResponse) ProtoMessage() {}
+
+func (x *CommitTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitTxResponse.ProtoReflect.Descriptor instead.
+func (*CommitTxResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{17}
+}
+
+// 取消事务请求
+type CancelTxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTxRequest) Reset() {
+	*x = CancelTxRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTxRequest) ProtoMessage() {}
+
+func (x *CancelTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTxRequest.ProtoReflect.Descriptor instead.
+func (*CancelTxRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelTxRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 取消事务响应
+type CancelTxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTxResponse) Reset() {
+	*x = CancelTxResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTxResponse) ProtoMessage() {}
+
+func (x *CancelTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTxResponse.ProtoReflect.Descriptor instead.
+func (*CancelTxResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{19}
+}
+
+// 取消通知请求
+type CancelNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelNotificationRequest) Reset() {
+	*x = CancelNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelNotificationRequest) ProtoMessage() {}
+
+func (x *CancelNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelNotificationRequest.ProtoReflect.Descriptor instead.
+func (*CancelNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CancelNotificationRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 取消通知响应
+type CancelNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelNotificationResponse) Reset() {
+	*x = CancelNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelNotificationResponse) ProtoMessage() {}
+
+func (x *CancelNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelNotificationResponse.ProtoReflect.Descriptor instead.
+func (*CancelNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{21}
+}
+
+// 修改通知发送策略请求
+type RescheduleNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// 新的发送策略
+	SendStrategy  *SendStrategy `protobuf:"bytes,2,opt,name=send_strategy,json=sendStrategy,proto3" json:"send_strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleNotificationRequest) Reset() {
+	*x = RescheduleNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleNotificationRequest) ProtoMessage() {}
+
+func (x *RescheduleNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleNotificationRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RescheduleNotificationRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RescheduleNotificationRequest) GetSendStrategy() *SendStrategy {
+	if x != nil {
+		return x.SendStrategy
+	}
+	return nil
+}
+
+// 修改通知发送策略响应
+type RescheduleNotificationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId int64                  `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 新的计划发送时间，毫秒时间戳
+	ScheduledStime int64 `protobuf:"varint,2,opt,name=scheduled_stime,json=scheduledStime,proto3" json:"scheduled_stime,omitempty"`
+	ScheduledEtime int64 `protobuf:"varint,3,opt,name=scheduled_etime,json=scheduledEtime,proto3" json:"scheduled_etime,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RescheduleNotificationResponse) Reset() {
+	*x = RescheduleNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleNotificationResponse) ProtoMessage() {}
+
+func (x *RescheduleNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleNotificationResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RescheduleNotificationResponse) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *RescheduleNotificationResponse) GetScheduledStime() int64 {
+	if x != nil {
+		return x.ScheduledStime
+	}
+	return 0
+}
+
+func (x *RescheduleNotificationResponse) GetScheduledEtime() int64 {
+	if x != nil {
+		return x.ScheduledEtime
+	}
+	return 0
+}
+
+// 周期发送
+type NotificationSeries struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Key    string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Status SeriesStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SeriesStatus" json:"status,omitempty"`
+	// 已经生成的子通知数量
+	Occurrences int32 `protobuf:"varint,3,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	// 下一次发送时间，毫秒时间戳，已结束时为 0
+	NextTime      int64 `protobuf:"varint,4,opt,name=next_time,json=nextTime,proto3" json:"next_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSeries) Reset() {
+	*x = NotificationSeries{}
+	mi := &file_notification_v1_notification_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSeries) ProtoMessage() {}
+
+func (x *NotificationSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,40 +1886,62 @@ func (x *PrepareTxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrepareTxRequest.ProtoReflect.Descriptor instead.
-func (*PrepareTxRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use NotificationSeries.ProtoReflect.Descriptor instead.
+func (*NotificationSeries) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *PrepareTxRequest) GetNotification() *Notification {
+func (x *NotificationSeries) GetKey() string {
 	if x != nil {
-		return x.Notification
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-// 准备事务响应
-type PrepareTxResponse struct {
+func (x *NotificationSeries) GetStatus() SeriesStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeriesStatus_SERIES_STATUS_UNSPECIFIED
+}
+
+func (x *NotificationSeries) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *NotificationSeries) GetNextTime() int64 {
+	if x != nil {
+		return x.NextTime
+	}
+	return 0
+}
+
+// 暂停周期发送请求
+type PauseNotificationSeriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PrepareTxResponse) Reset() {
-	*x = PrepareTxResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[11]
+func (x *PauseNotificationSeriesRequest) Reset() {
+	*x = PauseNotificationSeriesRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PrepareTxResponse) String() string {
+func (x *PauseNotificationSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrepareTxResponse) ProtoMessage() {}
+func (*PauseNotificationSeriesRequest) ProtoMessage() {}
 
-func (x *PrepareTxResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[11]
+func (x *PauseNotificationSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,34 +1952,86 @@ func (x *PrepareTxResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrepareTxResponse.ProtoReflect.Descriptor instead.
-func (*PrepareTxResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PauseNotificationSeriesRequest.ProtoReflect.Descriptor instead.
+func (*PauseNotificationSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{25}
 }
 
-// 提交事务请求
-type CommitTxRequest struct {
+func (x *PauseNotificationSeriesRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 暂停周期发送响应
+type PauseNotificationSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *NotificationSeries    `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseNotificationSeriesResponse) Reset() {
+	*x = PauseNotificationSeriesResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseNotificationSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseNotificationSeriesResponse) ProtoMessage() {}
+
+func (x *PauseNotificationSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseNotificationSeriesResponse.ProtoReflect.Descriptor instead.
+func (*PauseNotificationSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PauseNotificationSeriesResponse) GetSeries() *NotificationSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// 恢复周期发送请求
+type ResumeNotificationSeriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommitTxRequest) Reset() {
-	*x = CommitTxRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[12]
+func (x *ResumeNotificationSeriesRequest) Reset() {
+	*x = ResumeNotificationSeriesRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommitTxRequest) String() string {
+func (x *ResumeNotificationSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitTxRequest) ProtoMessage() {}
+func (*ResumeNotificationSeriesRequest) ProtoMessage() {}
 
-func (x *CommitTxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[12]
+func (x *ResumeNotificationSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,40 +2042,41 @@ func (x *CommitTxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitTxRequest.ProtoReflect.Descriptor instead.
-func (*CommitTxRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ResumeNotificationSeriesRequest.ProtoReflect.Descriptor instead.
+func (*ResumeNotificationSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CommitTxRequest) GetKey() string {
+func (x *ResumeNotificationSeriesRequest) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-// 提交事务响应
-type CommitTxResponse struct {
+// 恢复周期发送响应
+type ResumeNotificationSeriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *NotificationSeries    `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommitTxResponse) Reset() {
-	*x = CommitTxResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[13]
+func (x *ResumeNotificationSeriesResponse) Reset() {
+	*x = ResumeNotificationSeriesResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommitTxResponse) String() string {
+func (x *ResumeNotificationSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitTxResponse) ProtoMessage() {}
+func (*ResumeNotificationSeriesResponse) ProtoMessage() {}
 
-func (x *CommitTxResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[13]
+func (x *ResumeNotificationSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,34 +2087,41 @@ func (x *CommitTxResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitTxResponse.ProtoReflect.Descriptor instead.
-func (*CommitTxResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ResumeNotificationSeriesResponse.ProtoReflect.Descriptor instead.
+func (*ResumeNotificationSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{28}
 }
 
-// 取消事务请求
-type CancelTxRequest struct {
+func (x *ResumeNotificationSeriesResponse) GetSeries() *NotificationSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// 停止周期发送请求
+type StopNotificationSeriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelTxRequest) Reset() {
-	*x = CancelTxRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[14]
+func (x *StopNotificationSeriesRequest) Reset() {
+	*x = StopNotificationSeriesRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelTxRequest) String() string {
+func (x *StopNotificationSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelTxRequest) ProtoMessage() {}
+func (*StopNotificationSeriesRequest) ProtoMessage() {}
 
-func (x *CancelTxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[14]
+func (x *StopNotificationSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,40 +2132,41 @@ func (x *CancelTxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelTxRequest.ProtoReflect.Descriptor instead.
-func (*CancelTxRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use StopNotificationSeriesRequest.ProtoReflect.Descriptor instead.
+func (*StopNotificationSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CancelTxRequest) GetKey() string {
+func (x *StopNotificationSeriesRequest) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-// 取消事务响应
-type CancelTxResponse struct {
+// 停止周期发送响应
+type StopNotificationSeriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *NotificationSeries    `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelTxResponse) Reset() {
-	*x = CancelTxResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[15]
+func (x *StopNotificationSeriesResponse) Reset() {
+	*x = StopNotificationSeriesResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelTxResponse) String() string {
+func (x *StopNotificationSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelTxResponse) ProtoMessage() {}
+func (*StopNotificationSeriesResponse) ProtoMessage() {}
 
-func (x *CancelTxResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[15]
+func (x *StopNotificationSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,9 +2177,16 @@ func (x *CancelTxResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelTxResponse.ProtoReflect.Descriptor instead.
-func (*CancelTxResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use StopNotificationSeriesResponse.ProtoReflect.Descriptor instead.
+func (*StopNotificationSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StopNotificationSeriesResponse) GetSeries() *NotificationSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
 }
 
 // 空结构表示立即发送
@@ -1169,7 +2198,7 @@ type SendStrategy_ImmediateStrategy struct {
 
 func (x *SendStrategy_ImmediateStrategy) Reset() {
 	*x = SendStrategy_ImmediateStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	mi := &file_notification_v1_notification_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1181,7 +2210,7 @@ func (x *SendStrategy_ImmediateStrategy) String() string {
 func (*SendStrategy_ImmediateStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_ImmediateStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	mi := &file_notification_v1_notification_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,7 +2236,7 @@ type SendStrategy_DelayedStrategy struct {
 
 func (x *SendStrategy_DelayedStrategy) Reset() {
 	*x = SendStrategy_DelayedStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	mi := &file_notification_v1_notification_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1219,7 +2248,7 @@ func (x *SendStrategy_DelayedStrategy) String() string {
 func (*SendStrategy_DelayedStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_DelayedStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	mi := &file_notification_v1_notification_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,7 +2281,7 @@ type SendStrategy_ScheduledStrategy struct {
 
 func (x *SendStrategy_ScheduledStrategy) Reset() {
 	*x = SendStrategy_ScheduledStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	mi := &file_notification_v1_notification_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1264,7 +2293,7 @@ func (x *SendStrategy_ScheduledStrategy) String() string {
 func (*SendStrategy_ScheduledStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_ScheduledStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	mi := &file_notification_v1_notification_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1299,7 +2328,7 @@ type SendStrategy_TimeWindowStrategy struct {
 
 func (x *SendStrategy_TimeWindowStrategy) Reset() {
 	*x = SendStrategy_TimeWindowStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	mi := &file_notification_v1_notification_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1311,7 +2340,7 @@ func (x *SendStrategy_TimeWindowStrategy) String() string {
 func (*SendStrategy_TimeWindowStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_TimeWindowStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	mi := &file_notification_v1_notification_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,7 +2380,7 @@ type SendStrategy_DeadlineStrategy struct {
 
 func (x *SendStrategy_DeadlineStrategy) Reset() {
 	*x = SendStrategy_DeadlineStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	mi := &file_notification_v1_notification_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1363,7 +2392,7 @@ func (x *SendStrategy_DeadlineStrategy) String() string {
 func (*SendStrategy_DeadlineStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_DeadlineStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	mi := &file_notification_v1_notification_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,18 +2415,160 @@ func (x *SendStrategy_DeadlineStrategy) GetDeadline() *timestamppb.Timestamp {
 	return nil
 }
 
+// 每次发送生成一条子通知，key 为 "{key}#{计划发送时间的秒级时间戳}"，可以按子通知查询、取消和回调
+type SendStrategy_RecurringStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 标准 5 段 cron 表达式，也支持 @daily 等描述符，两次发送的间隔不能小于一分钟
+	CronExpression string `protobuf:"bytes,1,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// IANA 时区，例如 Asia/Shanghai，为空时使用 UTC
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// 截止时间，为空时不限
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 最多发送次数，0 表示不限
+	MaxOccurrences int32 `protobuf:"varint,4,opt,name=max_occurrences,json=maxOccurrences,proto3" json:"max_occurrences,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SendStrategy_RecurringStrategy) Reset() {
+	*x = SendStrategy_RecurringStrategy{}
+	mi := &file_notification_v1_notification_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStrategy_RecurringStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStrategy_RecurringStrategy) ProtoMessage() {}
+
+func (x *SendStrategy_RecurringStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStrategy_RecurringStrategy.ProtoReflect.Descriptor instead.
+func (*SendStrategy_RecurringStrategy) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *SendStrategy_RecurringStrategy) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *SendStrategy_RecurringStrategy) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SendStrategy_RecurringStrategy) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SendStrategy_RecurringStrategy) GetMaxOccurrences() int32 {
+	if x != nil {
+		return x.MaxOccurrences
+	}
+	return 0
+}
+
+// 仅支持站内信和邮件，通知只能有一个接收者
+// 同一业务、接收者和分组的通知在分组中第一条通知到达后的等待时长内合并，用汇总模板渲染为一条消息发送
+// 汇总模板可以使用 ${count}（合并的通知数量）和 ${items}（每条通知按自己的模板渲染后的内容，每行一条）
+// 每条通知在汇总通知发送结束后单独回调，结果中带有汇总通知ID
+type SendStrategy_DigestStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 汇总分组，最长 128 个字符
+	GroupKey string `protobuf:"bytes,1,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// 汇总模板ID
+	DigestTemplateId string `protobuf:"bytes,2,opt,name=digest_template_id,json=digestTemplateId,proto3" json:"digest_template_id,omitempty"`
+	// 等待合并的时长，单位秒，1 分钟到 24 小时
+	WindowSeconds int64 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStrategy_DigestStrategy) Reset() {
+	*x = SendStrategy_DigestStrategy{}
+	mi := &file_notification_v1_notification_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStrategy_DigestStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStrategy_DigestStrategy) ProtoMessage() {}
+
+func (x *SendStrategy_DigestStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStrategy_DigestStrategy.ProtoReflect.Descriptor instead.
+func (*SendStrategy_DigestStrategy) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *SendStrategy_DigestStrategy) GetGroupKey() string {
+	if x != nil {
+		return x.GroupKey
+	}
+	return ""
+}
+
+func (x *SendStrategy_DigestStrategy) GetDigestTemplateId() string {
+	if x != nil {
+		return x.DigestTemplateId
+	}
+	return ""
+}
+
+func (x *SendStrategy_DigestStrategy) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
 var File_notification_v1_notification_proto protoreflect.FileDescriptor
 
 const file_notification_v1_notification_proto_rawDesc = "" +
 	"\n" +
-	"\"notification/v1/notification.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x99\x06\n" +
+	"\"notification/v1/notification.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\t\n" +
 	"\fSendStrategy\x12O\n" +
 	"\timmediate\x18\x01 \x01(\v2/.notification.v1.SendStrategy.ImmediateStrategyH\x00R\timmediate\x12I\n" +
 	"\adelayed\x18\x02 \x01(\v2-.notification.v1.SendStrategy.DelayedStrategyH\x00R\adelayed\x12O\n" +
 	"\tscheduled\x18\x03 \x01(\v2/.notification.v1.SendStrategy.ScheduledStrategyH\x00R\tscheduled\x12S\n" +
 	"\vtime_window\x18\x04 \x01(\v20.notification.v1.SendStrategy.TimeWindowStrategyH\x00R\n" +
 	"timeWindow\x12L\n" +
-	"\bdeadline\x18\x05 \x01(\v2..notification.v1.SendStrategy.DeadlineStrategyH\x00R\bdeadline\x1a\x13\n" +
+	"\bdeadline\x18\x05 \x01(\v2..notification.v1.SendStrategy.DeadlineStrategyH\x00R\bdeadline\x12O\n" +
+	"\trecurring\x18\x06 \x01(\v2/.notification.v1.SendStrategy.RecurringStrategyH\x00R\trecurring\x12F\n" +
+	"\x06digest\x18\a \x01(\v2,.notification.v1.SendStrategy.DigestStrategyH\x00R\x06digest\x1a\x13\n" +
 	"\x11ImmediateStrategy\x1a6\n" +
 	"\x0fDelayedStrategy\x12#\n" +
 	"\rdelay_seconds\x18\x01 \x01(\x03R\fdelaySeconds\x1aL\n" +
@@ -1407,8 +2578,17 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\x17start_time_milliseconds\x18\x01 \x01(\x03R\x15startTimeMilliseconds\x122\n" +
 	"\x15end_time_milliseconds\x18\x02 \x01(\x03R\x13endTimeMilliseconds\x1aJ\n" +
 	"\x10DeadlineStrategy\x126\n" +
-	"\bdeadline\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadlineB\x0f\n" +
-	"\rstrategy_type\"\x92\x03\n" +
+	"\bdeadline\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x1a\xb8\x01\n" +
+	"\x11RecurringStrategy\x12'\n" +
+	"\x0fcron_expression\x18\x01 \x01(\tR\x0ecronExpression\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12'\n" +
+	"\x0fmax_occurrences\x18\x04 \x01(\x05R\x0emaxOccurrences\x1a\x82\x01\n" +
+	"\x0eDigestStrategy\x12\x1b\n" +
+	"\tgroup_key\x18\x01 \x01(\tR\bgroupKey\x12,\n" +
+	"\x12digest_template_id\x18\x02 \x01(\tR\x10digestTemplateId\x12%\n" +
+	"\x0ewindow_seconds\x18\x03 \x01(\x03R\rwindowSecondsB\x0f\n" +
+	"\rstrategy_type\"\x91\x04\n" +
 	"\fNotification\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
 	"\treceivers\x18\x02 \x03(\tR\treceivers\x122\n" +
@@ -1417,18 +2597,36 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"templateId\x12Z\n" +
 	"\x0ftemplate_params\x18\x05 \x03(\v21.notification.v1.Notification.TemplateParamsEntryR\x0etemplateParams\x12B\n" +
 	"\rsend_strategy\x18\x06 \x01(\v2\x1d.notification.v1.SendStrategyR\fsendStrategy\x12\x1a\n" +
-	"\breceiver\x18\a \x01(\tR\breceiver\x1aA\n" +
+	"\breceiver\x18\a \x01(\tR\breceiver\x12P\n" +
+	"\x12fallback_templates\x18\b \x03(\v2!.notification.v1.FallbackTemplateR\x11fallbackTemplates\x12+\n" +
+	"\x11receiver_timezone\x18\t \x01(\tR\x10receiverTimezone\x1aA\n" +
 	"\x13TemplateParamsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\\\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"g\n" +
+	"\x10FallbackTemplate\x122\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1f\n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\n" +
+	"templateId\"\\\n" +
 	"\x17SendNotificationRequest\x12A\n" +
-	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xd8\x01\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\x98\x03\n" +
 	"\x18SendNotificationResponse\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\x03R\x0enotificationId\x123\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x129\n" +
 	"\n" +
 	"error_code\x18\x03 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
-	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"a\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\x12E\n" +
+	"\x11delivered_channel\x18\x05 \x01(\x0e2\x18.notification.v1.ChannelR\x10deliveredChannel\x12A\n" +
+	"\n" +
+	"deliveries\x18\x06 \x03(\v2!.notification.v1.ReceiverDeliveryR\n" +
+	"deliveries\x124\n" +
+	"\x16digest_notification_id\x18\a \x01(\x03R\x14digestNotificationId\"\xbc\x01\n" +
+	"\x10ReceiverDelivery\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1f.notification.v1.DeliveryStatusR\x06status\x12\x19\n" +
+	"\berr_code\x18\x03 \x01(\tR\aerrCode\x12\x17\n" +
+	"\aerr_msg\x18\x04 \x01(\tR\x06errMsg\x12\x1f\n" +
+	"\vreport_time\x18\x05 \x01(\x03R\n" +
+	"reportTime\"a\n" +
 	"\x1cSendNotificationAsyncRequest\x12A\n" +
 	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xa8\x01\n" +
 	"\x1dSendNotificationAsyncResponse\x12'\n" +
@@ -1446,7 +2644,16 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"!SendNotificationBatchAsyncRequest\x12C\n" +
 	"\rnotifications\x18\x01 \x03(\v2\x1d.notification.v1.NotificationR\rnotifications\"O\n" +
 	"\"SendNotificationBatchAsyncResponse\x12)\n" +
-	"\x10notification_ids\x18\x01 \x03(\x03R\x0fnotificationIds\"U\n" +
+	"\x10notification_ids\x18\x01 \x03(\x03R\x0fnotificationIds\"u\n" +
+	"\x1eStreamSendNotificationsRequest\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12A\n" +
+	"\fnotification\x18\x02 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xbc\x01\n" +
+	"\x1fStreamSendNotificationsResponse\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x03R\x0enotificationId\x129\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"U\n" +
 	"\x10PrepareTxRequest\x12A\n" +
 	"\fnotification\x18\x02 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\x13\n" +
 	"\x11PrepareTxResponse\"#\n" +
@@ -1455,13 +2662,43 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\x10CommitTxResponse\"#\n" +
 	"\x0fCancelTxRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\"\x12\n" +
-	"\x10CancelTxResponse*B\n" +
+	"\x10CancelTxResponse\"-\n" +
+	"\x19CancelNotificationRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x1c\n" +
+	"\x1aCancelNotificationResponse\"u\n" +
+	"\x1dRescheduleNotificationRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12B\n" +
+	"\rsend_strategy\x18\x02 \x01(\v2\x1d.notification.v1.SendStrategyR\fsendStrategy\"\x9b\x01\n" +
+	"\x1eRescheduleNotificationResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x03R\x0enotificationId\x12'\n" +
+	"\x0fscheduled_stime\x18\x02 \x01(\x03R\x0escheduledStime\x12'\n" +
+	"\x0fscheduled_etime\x18\x03 \x01(\x03R\x0escheduledEtime\"\x9c\x01\n" +
+	"\x12NotificationSeries\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.notification.v1.SeriesStatusR\x06status\x12 \n" +
+	"\voccurrences\x18\x03 \x01(\x05R\voccurrences\x12\x1b\n" +
+	"\tnext_time\x18\x04 \x01(\x03R\bnextTime\"2\n" +
+	"\x1ePauseNotificationSeriesRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"^\n" +
+	"\x1fPauseNotificationSeriesResponse\x12;\n" +
+	"\x06series\x18\x01 \x01(\v2#.notification.v1.NotificationSeriesR\x06series\"3\n" +
+	"\x1fResumeNotificationSeriesRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"_\n" +
+	" ResumeNotificationSeriesResponse\x12;\n" +
+	"\x06series\x18\x01 \x01(\v2#.notification.v1.NotificationSeriesR\x06series\"1\n" +
+	"\x1dStopNotificationSeriesRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"]\n" +
+	"\x1eStopNotificationSeriesResponse\x12;\n" +
+	"\x06series\x18\x01 \x01(\v2#.notification.v1.NotificationSeriesR\x06series*a\n" +
 	"\aChannel\x12\x17\n" +
 	"\x13CHANNEL_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03SMS\x10\x01\x12\t\n" +
 	"\x05EMAIL\x10\x02\x12\n" +
 	"\n" +
-	"\x06IN_APP\x10\x03*l\n" +
+	"\x06IN_APP\x10\x03\x12\v\n" +
+	"\aWEBHOOK\x10\x04\x12\x06\n" +
+	"\x02IM\x10\x05\x12\b\n" +
+	"\x04PUSH\x10\x06*\x9b\x01\n" +
 	"\n" +
 	"SendStatus\x12\x1b\n" +
 	"\x17SEND_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
@@ -1470,7 +2707,17 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\aPENDING\x10\x03\x12\r\n" +
 	"\tSUCCEEDED\x10\x04\x12\n" +
 	"\n" +
-	"\x06FAILED\x10\x05*\x9e\x03\n" +
+	"\x06FAILED\x10\x05\x12\x17\n" +
+	"\x13PARTIALLY_SUCCEEDED\x10\x06\x12\x14\n" +
+	"\x10FREQUENCY_CAPPED\x10\a*\x96\x01\n" +
+	"\x0eDeliveryStatus\x12\x1f\n" +
+	"\x1bDELIVERY_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aWAITING\x10\x01\x12\r\n" +
+	"\tDELIVERED\x10\x02\x12\x0f\n" +
+	"\vUNDELIVERED\x10\x03\x12\x14\n" +
+	"\x10DELIVERY_PENDING\x10\x04\x12\x11\n" +
+	"\rSUBMIT_FAILED\x10\x05\x12\r\n" +
+	"\tSUBMITTED\x10\x06*\xba\x03\n" +
 	"\tErrorCode\x12\x1a\n" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11INVALID_PARAMETER\x10\x01\x12\x10\n" +
@@ -1489,15 +2736,28 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\bNO_QUOTA\x10\r\x12\x13\n" +
 	"\x0fQUOTA_NOT_FOUND\x10\x0e\x12\x16\n" +
 	"\x12PROVIDER_NOT_FOUND\x10\x0f\x12\x13\n" +
-	"\x0fUNKNOWN_CHANNEL\x10\x102\xec\x05\n" +
+	"\x0fUNKNOWN_CHANNEL\x10\x10\x12\x1a\n" +
+	"\x16FREQUENCY_CAP_EXCEEDED\x10\x11*\x99\x01\n" +
+	"\fSeriesStatus\x12\x1d\n" +
+	"\x19SERIES_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14SERIES_STATUS_ACTIVE\x10\x01\x12\x18\n" +
+	"\x14SERIES_STATUS_PAUSED\x10\x02\x12\x19\n" +
+	"\x15SERIES_STATUS_STOPPED\x10\x03\x12\x1b\n" +
+	"\x17SERIES_STATUS_COMPLETED\x10\x042\xd3\v\n" +
 	"\x13NotificationService\x12g\n" +
 	"\x10SendNotification\x12(.notification.v1.SendNotificationRequest\x1a).notification.v1.SendNotificationResponse\x12v\n" +
 	"\x15SendNotificationAsync\x12-.notification.v1.SendNotificationAsyncRequest\x1a..notification.v1.SendNotificationAsyncResponse\x12v\n" +
 	"\x15SendNotificationBatch\x12-.notification.v1.SendNotificationBatchRequest\x1a..notification.v1.SendNotificationBatchResponse\x12\x85\x01\n" +
-	"\x1aSendNotificationBatchAsync\x122.notification.v1.SendNotificationBatchAsyncRequest\x1a3.notification.v1.SendNotificationBatchAsyncResponse\x12R\n" +
+	"\x1aSendNotificationBatchAsync\x122.notification.v1.SendNotificationBatchAsyncRequest\x1a3.notification.v1.SendNotificationBatchAsyncResponse\x12\x80\x01\n" +
+	"\x17StreamSendNotifications\x12/.notification.v1.StreamSendNotificationsRequest\x1a0.notification.v1.StreamSendNotificationsResponse(\x010\x01\x12R\n" +
 	"\tPrepareTx\x12!.notification.v1.PrepareTxRequest\x1a\".notification.v1.PrepareTxResponse\x12O\n" +
 	"\bCommitTx\x12 .notification.v1.CommitTxRequest\x1a!.notification.v1.CommitTxResponse\x12O\n" +
-	"\bCancelTx\x12 .notification.v1.CancelTxRequest\x1a!.notification.v1.CancelTxResponseB\xc3\x01\n" +
+	"\bCancelTx\x12 .notification.v1.CancelTxRequest\x1a!.notification.v1.CancelTxResponse\x12m\n" +
+	"\x12CancelNotification\x12*.notification.v1.CancelNotificationRequest\x1a+.notification.v1.CancelNotificationResponse\x12y\n" +
+	"\x16RescheduleNotification\x12..notification.v1.RescheduleNotificationRequest\x1a/.notification.v1.RescheduleNotificationResponse\x12|\n" +
+	"\x17PauseNotificationSeries\x12/.notification.v1.PauseNotificationSeriesRequest\x1a0.notification.v1.PauseNotificationSeriesResponse\x12\x7f\n" +
+	"\x18ResumeNotificationSeries\x120.notification.v1.ResumeNotificationSeriesRequest\x1a1.notification.v1.ResumeNotificationSeriesResponse\x12y\n" +
+	"\x16StopNotificationSeries\x12..notification.v1.StopNotificationSeriesRequest\x1a/.notification.v1.StopNotificationSeriesResponseB\xc3\x01\n" +
 	"\x13com.notification.v1B\x11NotificationProtoP\x01Z<go-notification/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
 
 var (
@@ -1512,75 +2772,121 @@ func file_notification_v1_notification_proto_rawDescGZIP() []byte {
 	return file_notification_v1_notification_proto_rawDescData
 }
 
-var file_notification_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_notification_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_notification_v1_notification_proto_goTypes = []any{
 	(Channel)(0),                               // 0: notification.v1.Channel
 	(SendStatus)(0),                            // 1: notification.v1.SendStatus
-	(ErrorCode)(0),                             // 2: notification.v1.ErrorCode
-	(*SendStrategy)(nil),                       // 3: notification.v1.SendStrategy
-	(*Notification)(nil),                       // 4: notification.v1.Notification
-	(*SendNotificationRequest)(nil),            // 5: notification.v1.SendNotificationRequest
-	(*SendNotificationResponse)(nil),           // 6: notification.v1.SendNotificationResponse
-	(*SendNotificationAsyncRequest)(nil),       // 7: notification.v1.SendNotificationAsyncRequest
-	(*SendNotificationAsyncResponse)(nil),      // 8: notification.v1.SendNotificationAsyncResponse
-	(*SendNotificationBatchRequest)(nil),       // 9: notification.v1.SendNotificationBatchRequest
-	(*SendNotificationBatchResponse)(nil),      // 10: notification.v1.SendNotificationBatchResponse
-	(*SendNotificationBatchAsyncRequest)(nil),  // 11: notification.v1.SendNotificationBatchAsyncRequest
-	(*SendNotificationBatchAsyncResponse)(nil), // 12: notification.v1.SendNotificationBatchAsyncResponse
-	(*PrepareTxRequest)(nil),                   // 13: notification.v1.PrepareTxRequest
-	(*PrepareTxResponse)(nil),                  // 14: notification.v1.PrepareTxResponse
-	(*CommitTxRequest)(nil),                    // 15: notification.v1.CommitTxRequest
-	(*CommitTxResponse)(nil),                   // 16: notification.v1.CommitTxResponse
-	(*CancelTxRequest)(nil),                    // 17: notification.v1.CancelTxRequest
-	(*CancelTxResponse)(nil),                   // 18: notification.v1.CancelTxResponse
-	(*SendStrategy_ImmediateStrategy)(nil),     // 19: notification.v1.SendStrategy.ImmediateStrategy
-	(*SendStrategy_DelayedStrategy)(nil),       // 20: notification.v1.SendStrategy.DelayedStrategy
-	(*SendStrategy_ScheduledStrategy)(nil),     // 21: notification.v1.SendStrategy.ScheduledStrategy
-	(*SendStrategy_TimeWindowStrategy)(nil),    // 22: notification.v1.SendStrategy.TimeWindowStrategy
-	(*SendStrategy_DeadlineStrategy)(nil),      // 23: notification.v1.SendStrategy.DeadlineStrategy
-	nil,                                        // 24: notification.v1.Notification.TemplateParamsEntry
-	(*timestamppb.Timestamp)(nil),              // 25: google.protobuf.Timestamp
+	(DeliveryStatus)(0),                        // 2: notification.v1.DeliveryStatus
+	(ErrorCode)(0),                             // 3: notification.v1.ErrorCode
+	(SeriesStatus)(0),                          // 4: notification.v1.SeriesStatus
+	(*SendStrategy)(nil),                       // 5: notification.v1.SendStrategy
+	(*Notification)(nil),                       // 6: notification.v1.Notification
+	(*FallbackTemplate)(nil),                   // 7: notification.v1.FallbackTemplate
+	(*SendNotificationRequest)(nil),            // 8: notification.v1.SendNotificationRequest
+	(*SendNotificationResponse)(nil),           // 9: notification.v1.SendNotificationResponse
+	(*ReceiverDelivery)(nil),                   // 10: notification.v1.ReceiverDelivery
+	(*SendNotificationAsyncRequest)(nil),       // 11: notification.v1.SendNotificationAsyncRequest
+	(*SendNotificationAsyncResponse)(nil),      // 12: notification.v1.SendNotificationAsyncResponse
+	(*SendNotificationBatchRequest)(nil),       // 13: notification.v1.SendNotificationBatchRequest
+	(*SendNotificationBatchResponse)(nil),      // 14: notification.v1.SendNotificationBatchResponse
+	(*SendNotificationBatchAsyncRequest)(nil),  // 15: notification.v1.SendNotificationBatchAsyncRequest
+	(*SendNotificationBatchAsyncResponse)(nil), // 16: notification.v1.SendNotificationBatchAsyncResponse
+	(*StreamSendNotificationsRequest)(nil),     // 17: notification.v1.StreamSendNotificationsRequest
+	(*StreamSendNotificationsResponse)(nil),    // 18: notification.v1.StreamSendNotificationsResponse
+	(*PrepareTxRequest)(nil),                   // 19: notification.v1.PrepareTxRequest
+	(*PrepareTxResponse)(nil),                  // 20: notification.v1.PrepareTxResponse
+	(*CommitTxRequest)(nil),                    // 21: notification.v1.CommitTxRequest
+	(*CommitTxResponse)(nil),                   // 22: notification.v1.CommitTxResponse
+	(*CancelTxRequest)(nil),                    // 23: notification.v1.CancelTxRequest
+	(*CancelTxResponse)(nil),                   // 24: notification.v1.CancelTxResponse
+	(*CancelNotificationRequest)(nil),          // 25: notification.v1.CancelNotificationRequest
+	(*CancelNotificationResponse)(nil),         // 26: notification.v1.CancelNotificationResponse
+	(*RescheduleNotificationRequest)(nil),      // 27: notification.v1.RescheduleNotificationRequest
+	(*RescheduleNotificationResponse)(nil),     // 28: notification.v1.RescheduleNotificationResponse
+	(*NotificationSeries)(nil),                 // 29: notification.v1.NotificationSeries
+	(*PauseNotificationSeriesRequest)(nil),     // 30: notification.v1.PauseNotificationSeriesRequest
+	(*PauseNotificationSeriesResponse)(nil),    // 31: notification.v1.PauseNotificationSeriesResponse
+	(*ResumeNotificationSeriesRequest)(nil),    // 32: notification.v1.ResumeNotificationSeriesRequest
+	(*ResumeNotificationSeriesResponse)(nil),   // 33: notification.v1.ResumeNotificationSeriesResponse
+	(*StopNotificationSeriesRequest)(nil),      // 34: notification.v1.StopNotificationSeriesRequest
+	(*StopNotificationSeriesResponse)(nil),     // 35: notification.v1.StopNotificationSeriesResponse
+	(*SendStrategy_ImmediateStrategy)(nil),     // 36: notification.v1.SendStrategy.ImmediateStrategy
+	(*SendStrategy_DelayedStrategy)(nil),       // 37: notification.v1.SendStrategy.DelayedStrategy
+	(*SendStrategy_ScheduledStrategy)(nil),     // 38: notification.v1.SendStrategy.ScheduledStrategy
+	(*SendStrategy_TimeWindowStrategy)(nil),    // 39: notification.v1.SendStrategy.TimeWindowStrategy
+	(*SendStrategy_DeadlineStrategy)(nil),      // 40: notification.v1.SendStrategy.DeadlineStrategy
+	(*SendStrategy_RecurringStrategy)(nil),     // 41: notification.v1.SendStrategy.RecurringStrategy
+	(*SendStrategy_DigestStrategy)(nil),        // 42: notification.v1.SendStrategy.DigestStrategy
+	nil,                                        // 43: notification.v1.Notification.TemplateParamsEntry
+	(*timestamppb.Timestamp)(nil),              // 44: google.protobuf.Timestamp
 }
 var file_notification_v1_notification_proto_depIdxs = []int32{
-	19, // 0: notification.v1.SendStrategy.immediate:type_name -> notification.v1.SendStrategy.ImmediateStrategy
-	20, // 1: notification.v1.SendStrategy.delayed:type_name -> notification.v1.SendStrategy.DelayedStrategy
-	21, // 2: notification.v1.SendStrategy.scheduled:type_name -> notification.v1.SendStrategy.ScheduledStrategy
-	22, // 3: notification.v1.SendStrategy.time_window:type_name -> notification.v1.SendStrategy.TimeWindowStrategy
-	23, // 4: notification.v1.SendStrategy.deadline:type_name -> notification.v1.SendStrategy.DeadlineStrategy
-	0,  // 5: notification.v1.Notification.channel:type_name -> notification.v1.Channel
-	24, // 6: notification.v1.Notification.template_params:type_name -> notification.v1.Notification.TemplateParamsEntry
-	3,  // 7: notification.v1.Notification.send_strategy:type_name -> notification.v1.SendStrategy
-	4,  // 8: notification.v1.SendNotificationRequest.notification:type_name -> notification.v1.Notification
-	1,  // 9: notification.v1.SendNotificationResponse.status:type_name -> notification.v1.SendStatus
-	2,  // 10: notification.v1.SendNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
-	4,  // 11: notification.v1.SendNotificationAsyncRequest.notification:type_name -> notification.v1.Notification
-	2,  // 12: notification.v1.SendNotificationAsyncResponse.error_code:type_name -> notification.v1.ErrorCode
-	4,  // 13: notification.v1.SendNotificationBatchRequest.notifications:type_name -> notification.v1.Notification
-	6,  // 14: notification.v1.SendNotificationBatchResponse.results:type_name -> notification.v1.SendNotificationResponse
-	4,  // 15: notification.v1.SendNotificationBatchAsyncRequest.notifications:type_name -> notification.v1.Notification
-	4,  // 16: notification.v1.PrepareTxRequest.notification:type_name -> notification.v1.Notification
-	25, // 17: notification.v1.SendStrategy.ScheduledStrategy.send_time:type_name -> google.protobuf.Timestamp
-	25, // 18: notification.v1.SendStrategy.DeadlineStrategy.deadline:type_name -> google.protobuf.Timestamp
-	5,  // 19: notification.v1.NotificationService.SendNotification:input_type -> notification.v1.SendNotificationRequest
-	7,  // 20: notification.v1.NotificationService.SendNotificationAsync:input_type -> notification.v1.SendNotificationAsyncRequest
-	9,  // 21: notification.v1.NotificationService.SendNotificationBatch:input_type -> notification.v1.SendNotificationBatchRequest
-	11, // 22: notification.v1.NotificationService.SendNotificationBatchAsync:input_type -> notification.v1.SendNotificationBatchAsyncRequest
-	13, // 23: notification.v1.NotificationService.PrepareTx:input_type -> notification.v1.PrepareTxRequest
-	15, // 24: notification.v1.NotificationService.CommitTx:input_type -> notification.v1.CommitTxRequest
-	17, // 25: notification.v1.NotificationService.CancelTx:input_type -> notification.v1.CancelTxRequest
-	6,  // 26: notification.v1.NotificationService.SendNotification:output_type -> notification.v1.SendNotificationResponse
-	8,  // 27: notification.v1.NotificationService.SendNotificationAsync:output_type -> notification.v1.SendNotificationAsyncResponse
-	10, // 28: notification.v1.NotificationService.SendNotificationBatch:output_type -> notification.v1.SendNotificationBatchResponse
-	12, // 29: notification.v1.NotificationService.SendNotificationBatchAsync:output_type -> notification.v1.SendNotificationBatchAsyncResponse
-	14, // 30: notification.v1.NotificationService.PrepareTx:output_type -> notification.v1.PrepareTxResponse
-	16, // 31: notification.v1.NotificationService.CommitTx:output_type -> notification.v1.CommitTxResponse
-	18, // 32: notification.v1.NotificationService.CancelTx:output_type -> notification.v1.CancelTxResponse
-	26, // [26:33] is the sub-list for method output_type
-	19, // [19:26] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	36, // 0: notification.v1.SendStrategy.immediate:type_name -> notification.v1.SendStrategy.ImmediateStrategy
+	37, // 1: notification.v1.SendStrategy.delayed:type_name -> notification.v1.SendStrategy.DelayedStrategy
+	38, // 2: notification.v1.SendStrategy.scheduled:type_name -> notification.v1.SendStrategy.ScheduledStrategy
+	39, // 3: notification.v1.SendStrategy.time_window:type_name -> notification.v1.SendStrategy.TimeWindowStrategy
+	40, // 4: notification.v1.SendStrategy.deadline:type_name -> notification.v1.SendStrategy.DeadlineStrategy
+	41, // 5: notification.v1.SendStrategy.recurring:type_name -> notification.v1.SendStrategy.RecurringStrategy
+	42, // 6: notification.v1.SendStrategy.digest:type_name -> notification.v1.SendStrategy.DigestStrategy
+	0,  // 7: notification.v1.Notification.channel:type_name -> notification.v1.Channel
+	43, // 8: notification.v1.Notification.template_params:type_name -> notification.v1.Notification.TemplateParamsEntry
+	5,  // 9: notification.v1.Notification.send_strategy:type_name -> notification.v1.SendStrategy
+	7,  // 10: notification.v1.Notification.fallback_templates:type_name -> notification.v1.FallbackTemplate
+	0,  // 11: notification.v1.FallbackTemplate.channel:type_name -> notification.v1.Channel
+	6,  // 12: notification.v1.SendNotificationRequest.notification:type_name -> notification.v1.Notification
+	1,  // 13: notification.v1.SendNotificationResponse.status:type_name -> notification.v1.SendStatus
+	3,  // 14: notification.v1.SendNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
+	0,  // 15: notification.v1.SendNotificationResponse.delivered_channel:type_name -> notification.v1.Channel
+	10, // 16: notification.v1.SendNotificationResponse.deliveries:type_name -> notification.v1.ReceiverDelivery
+	2,  // 17: notification.v1.ReceiverDelivery.status:type_name -> notification.v1.DeliveryStatus
+	6,  // 18: notification.v1.SendNotificationAsyncRequest.notification:type_name -> notification.v1.Notification
+	3,  // 19: notification.v1.SendNotificationAsyncResponse.error_code:type_name -> notification.v1.ErrorCode
+	6,  // 20: notification.v1.SendNotificationBatchRequest.notifications:type_name -> notification.v1.Notification
+	9,  // 21: notification.v1.SendNotificationBatchResponse.results:type_name -> notification.v1.SendNotificationResponse
+	6,  // 22: notification.v1.SendNotificationBatchAsyncRequest.notifications:type_name -> notification.v1.Notification
+	6,  // 23: notification.v1.StreamSendNotificationsRequest.notification:type_name -> notification.v1.Notification
+	3,  // 24: notification.v1.StreamSendNotificationsResponse.error_code:type_name -> notification.v1.ErrorCode
+	6,  // 25: notification.v1.PrepareTxRequest.notification:type_name -> notification.v1.Notification
+	5,  // 26: notification.v1.RescheduleNotificationRequest.send_strategy:type_name -> notification.v1.SendStrategy
+	4,  // 27: notification.v1.NotificationSeries.status:type_name -> notification.v1.SeriesStatus
+	29, // 28: notification.v1.PauseNotificationSeriesResponse.series:type_name -> notification.v1.NotificationSeries
+	29, // 29: notification.v1.ResumeNotificationSeriesResponse.series:type_name -> notification.v1.NotificationSeries
+	29, // 30: notification.v1.StopNotificationSeriesResponse.series:type_name -> notification.v1.NotificationSeries
+	44, // 31: notification.v1.SendStrategy.ScheduledStrategy.send_time:type_name -> google.protobuf.Timestamp
+	44, // 32: notification.v1.SendStrategy.DeadlineStrategy.deadline:type_name -> google.protobuf.Timestamp
+	44, // 33: notification.v1.SendStrategy.RecurringStrategy.end_time:type_name -> google.protobuf.Timestamp
+	8,  // 34: notification.v1.NotificationService.SendNotification:input_type -> notification.v1.SendNotificationRequest
+	11, // 35: notification.v1.NotificationService.SendNotificationAsync:input_type -> notification.v1.SendNotificationAsyncRequest
+	13, // 36: notification.v1.NotificationService.SendNotificationBatch:input_type -> notification.v1.SendNotificationBatchRequest
+	15, // 37: notification.v1.NotificationService.SendNotificationBatchAsync:input_type -> notification.v1.SendNotificationBatchAsyncRequest
+	17, // 38: notification.v1.NotificationService.StreamSendNotifications:input_type -> notification.v1.StreamSendNotificationsRequest
+	19, // 39: notification.v1.NotificationService.PrepareTx:input_type -> notification.v1.PrepareTxRequest
+	21, // 40: notification.v1.NotificationService.CommitTx:input_type -> notification.v1.CommitTxRequest
+	23, // 41: notification.v1.NotificationService.CancelTx:input_type -> notification.v1.CancelTxRequest
+	25, // 42: notification.v1.NotificationService.CancelNotification:input_type -> notification.v1.CancelNotificationRequest
+	27, // 43: notification.v1.NotificationService.RescheduleNotification:input_type -> notification.v1.RescheduleNotificationRequest
+	30, // 44: notification.v1.NotificationService.PauseNotificationSeries:input_type -> notification.v1.PauseNotificationSeriesRequest
+	32, // 45: notification.v1.NotificationService.ResumeNotificationSeries:input_type -> notification.v1.ResumeNotificationSeriesRequest
+	34, // 46: notification.v1.NotificationService.StopNotificationSeries:input_type -> notification.v1.StopNotificationSeriesRequest
+	9,  // 47: notification.v1.NotificationService.SendNotification:output_type -> notification.v1.SendNotificationResponse
+	12, // 48: notification.v1.NotificationService.SendNotificationAsync:output_type -> notification.v1.SendNotificationAsyncResponse
+	14, // 49: notification.v1.NotificationService.SendNotificationBatch:output_type -> notification.v1.SendNotificationBatchResponse
+	16, // 50: notification.v1.NotificationService.SendNotificationBatchAsync:output_type -> notification.v1.SendNotificationBatchAsyncResponse
+	18, // 51: notification.v1.NotificationService.StreamSendNotifications:output_type -> notification.v1.StreamSendNotificationsResponse
+	20, // 52: notification.v1.NotificationService.PrepareTx:output_type -> notification.v1.PrepareTxResponse
+	22, // 53: notification.v1.NotificationService.CommitTx:output_type -> notification.v1.CommitTxResponse
+	24, // 54: notification.v1.NotificationService.CancelTx:output_type -> notification.v1.CancelTxResponse
+	26, // 55: notification.v1.NotificationService.CancelNotification:output_type -> notification.v1.CancelNotificationResponse
+	28, // 56: notification.v1.NotificationService.RescheduleNotification:output_type -> notification.v1.RescheduleNotificationResponse
+	31, // 57: notification.v1.NotificationService.PauseNotificationSeries:output_type -> notification.v1.PauseNotificationSeriesResponse
+	33, // 58: notification.v1.NotificationService.ResumeNotificationSeries:output_type -> notification.v1.ResumeNotificationSeriesResponse
+	35, // 59: notification.v1.NotificationService.StopNotificationSeries:output_type -> notification.v1.StopNotificationSeriesResponse
+	47, // [47:60] is the sub-list for method output_type
+	34, // [34:47] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_notification_v1_notification_proto_init() }
@@ -1594,14 +2900,16 @@ func file_notification_v1_notification_proto_init() {
 		(*SendStrategy_Scheduled)(nil),
 		(*SendStrategy_TimeWindow)(nil),
 		(*SendStrategy_Deadline)(nil),
+		(*SendStrategy_Recurring)(nil),
+		(*SendStrategy_Digest)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   22,
+			NumEnums:      5,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -263,6 +263,88 @@ func (m *SendStrategy) validate(all bool) error {
 			}
 		}
 
+	case *SendStrategy_Recurring:
+		if v == nil {
+			err := SendStrategyValidationError{
+				field:  "StrategyType",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRecurring()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendStrategyValidationError{
+						field:  "Recurring",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendStrategyValidationError{
+						field:  "Recurring",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRecurring()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendStrategyValidationError{
+					field:  "Recurring",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SendStrategy_Digest:
+		if v == nil {
+			err := SendStrategyValidationError{
+				field:  "StrategyType",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDigest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendStrategyValidationError{
+						field:  "Digest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendStrategyValidationError{
+						field:  "Digest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDigest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendStrategyValidationError{
+					field:  "Digest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -405,6 +487,42 @@ func (m *Notification) validate(all bool) error {
 
 	// no validation rules for Receiver
 
+	for idx, item := range m.GetFallbackTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  fmt.Sprintf("FallbackTemplates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  fmt.Sprintf("FallbackTemplates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationValidationError{
+					field:  fmt.Sprintf("FallbackTemplates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ReceiverTimezone
+
 	if len(errors) > 0 {
 		return NotificationMultiError(errors)
 	}
@@ -482,6 +600,110 @@ var _ interface {
 	ErrorName() string
 } = NotificationValidationError{}
 
+// Validate checks the field values on FallbackTemplate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FallbackTemplate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FallbackTemplate with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FallbackTemplateMultiError, or nil if none found.
+func (m *FallbackTemplate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FallbackTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	// no validation rules for TemplateId
+
+	if len(errors) > 0 {
+		return FallbackTemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// FallbackTemplateMultiError is an error wrapping multiple validation errors
+// returned by FallbackTemplate.ValidateAll() if the designated constraints
+// aren't met.
+type FallbackTemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FallbackTemplateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FallbackTemplateMultiError) AllErrors() []error { return m }
+
+// FallbackTemplateValidationError is the validation error returned by
+// FallbackTemplate.Validate if the designated constraints aren't met.
+type FallbackTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FallbackTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FallbackTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FallbackTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FallbackTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FallbackTemplateValidationError) ErrorName() string { return "FallbackTemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FallbackTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFallbackTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FallbackTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FallbackTemplateValidationError{}
+
 // Validate checks the field values on SendNotificationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -643,6 +865,44 @@ func (m *SendNotificationResponse) validate(all bool) error {
 
 	// no validation rules for ErrorMessage
 
+	// no validation rules for DeliveredChannel
+
+	for idx, item := range m.GetDeliveries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendNotificationResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendNotificationResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendNotificationResponseValidationError{
+					field:  fmt.Sprintf("Deliveries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DigestNotificationId
+
 	if len(errors) > 0 {
 		return SendNotificationResponseMultiError(errors)
 	}
@@ -723,66 +983,176 @@ var _ interface {
 	ErrorName() string
 } = SendNotificationResponseValidationError{}
 
-// Validate checks the field values on SendNotificationAsyncRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SendNotificationAsyncRequest) Validate() error {
+// Validate checks the field values on ReceiverDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReceiverDelivery) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SendNotificationAsyncRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReceiverDelivery with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SendNotificationAsyncRequestMultiError, or nil if none found.
-func (m *SendNotificationAsyncRequest) ValidateAll() error {
+// ReceiverDeliveryMultiError, or nil if none found.
+func (m *ReceiverDelivery) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SendNotificationAsyncRequest) validate(all bool) error {
+func (m *ReceiverDelivery) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNotification()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SendNotificationAsyncRequestValidationError{
-					field:  "Notification",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SendNotificationAsyncRequestValidationError{
-					field:  "Notification",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SendNotificationAsyncRequestValidationError{
-				field:  "Notification",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Receiver
+
+	// no validation rules for Status
+
+	// no validation rules for ErrCode
+
+	// no validation rules for ErrMsg
+
+	// no validation rules for ReportTime
 
 	if len(errors) > 0 {
-		return SendNotificationAsyncRequestMultiError(errors)
+		return ReceiverDeliveryMultiError(errors)
 	}
 
 	return nil
 }
 
-// SendNotificationAsyncRequestMultiError is an error wrapping multiple
-// validation errors returned by SendNotificationAsyncRequest.ValidateAll() if
+// ReceiverDeliveryMultiError is an error wrapping multiple validation errors
+// returned by ReceiverDelivery.ValidateAll() if the designated constraints
+// aren't met.
+type ReceiverDeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiverDeliveryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiverDeliveryMultiError) AllErrors() []error { return m }
+
+// ReceiverDeliveryValidationError is the validation error returned by
+// ReceiverDelivery.Validate if the designated constraints aren't met.
+type ReceiverDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiverDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiverDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiverDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiverDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiverDeliveryValidationError) ErrorName() string { return "ReceiverDeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiverDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiverDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiverDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiverDeliveryValidationError{}
+
+// Validate checks the field values on SendNotificationAsyncRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendNotificationAsyncRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendNotificationAsyncRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendNotificationAsyncRequestMultiError, or nil if none found.
+func (m *SendNotificationAsyncRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendNotificationAsyncRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendNotificationAsyncRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendNotificationAsyncRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendNotificationAsyncRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendNotificationAsyncRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendNotificationAsyncRequestMultiError is an error wrapping multiple
+// validation errors returned by SendNotificationAsyncRequest.ValidateAll() if
 // the designated constraints aren't met.
 type SendNotificationAsyncRequestMultiError []error
 
@@ -1486,33 +1856,35 @@ var _ interface {
 	ErrorName() string
 } = SendNotificationBatchAsyncResponseValidationError{}
 
-// Validate checks the field values on PrepareTxRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PrepareTxRequest) Validate() error {
+// Validate checks the field values on StreamSendNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamSendNotificationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PrepareTxRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PrepareTxRequestMultiError, or nil if none found.
-func (m *PrepareTxRequest) ValidateAll() error {
+// ValidateAll checks the field values on StreamSendNotificationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StreamSendNotificationsRequestMultiError, or nil if none found.
+func (m *StreamSendNotificationsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PrepareTxRequest) validate(all bool) error {
+func (m *StreamSendNotificationsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Seq
+
 	if all {
 		switch v := interface{}(m.GetNotification()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PrepareTxRequestValidationError{
+				errors = append(errors, StreamSendNotificationsRequestValidationError{
 					field:  "Notification",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1520,7 +1892,7 @@ func (m *PrepareTxRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PrepareTxRequestValidationError{
+				errors = append(errors, StreamSendNotificationsRequestValidationError{
 					field:  "Notification",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1529,7 +1901,7 @@ func (m *PrepareTxRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PrepareTxRequestValidationError{
+			return StreamSendNotificationsRequestValidationError{
 				field:  "Notification",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1538,19 +1910,19 @@ func (m *PrepareTxRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PrepareTxRequestMultiError(errors)
+		return StreamSendNotificationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PrepareTxRequestMultiError is an error wrapping multiple validation errors
-// returned by PrepareTxRequest.ValidateAll() if the designated constraints
-// aren't met.
-type PrepareTxRequestMultiError []error
+// StreamSendNotificationsRequestMultiError is an error wrapping multiple
+// validation errors returned by StreamSendNotificationsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type StreamSendNotificationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PrepareTxRequestMultiError) Error() string {
+func (m StreamSendNotificationsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1559,11 +1931,12 @@ func (m PrepareTxRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PrepareTxRequestMultiError) AllErrors() []error { return m }
+func (m StreamSendNotificationsRequestMultiError) AllErrors() []error { return m }
 
-// PrepareTxRequestValidationError is the validation error returned by
-// PrepareTxRequest.Validate if the designated constraints aren't met.
-type PrepareTxRequestValidationError struct {
+// StreamSendNotificationsRequestValidationError is the validation error
+// returned by StreamSendNotificationsRequest.Validate if the designated
+// constraints aren't met.
+type StreamSendNotificationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1571,22 +1944,24 @@ type PrepareTxRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PrepareTxRequestValidationError) Field() string { return e.field }
+func (e StreamSendNotificationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PrepareTxRequestValidationError) Reason() string { return e.reason }
+func (e StreamSendNotificationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PrepareTxRequestValidationError) Cause() error { return e.cause }
+func (e StreamSendNotificationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PrepareTxRequestValidationError) Key() bool { return e.key }
+func (e StreamSendNotificationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PrepareTxRequestValidationError) ErrorName() string { return "PrepareTxRequestValidationError" }
+func (e StreamSendNotificationsRequestValidationError) ErrorName() string {
+	return "StreamSendNotificationsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PrepareTxRequestValidationError) Error() string {
+func (e StreamSendNotificationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1598,14 +1973,14 @@ func (e PrepareTxRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPrepareTxRequest.%s: %s%s",
+		"invalid %sStreamSendNotificationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PrepareTxRequestValidationError{}
+var _ error = StreamSendNotificationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1613,44 +1988,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PrepareTxRequestValidationError{}
+} = StreamSendNotificationsRequestValidationError{}
 
-// Validate checks the field values on PrepareTxResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PrepareTxResponse) Validate() error {
+// Validate checks the field values on StreamSendNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamSendNotificationsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PrepareTxResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PrepareTxResponseMultiError, or nil if none found.
-func (m *PrepareTxResponse) ValidateAll() error {
+// ValidateAll checks the field values on StreamSendNotificationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StreamSendNotificationsResponseMultiError, or nil if none found.
+func (m *StreamSendNotificationsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PrepareTxResponse) validate(all bool) error {
+func (m *StreamSendNotificationsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Seq
+
+	// no validation rules for NotificationId
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
 	if len(errors) > 0 {
-		return PrepareTxResponseMultiError(errors)
+		return StreamSendNotificationsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PrepareTxResponseMultiError is an error wrapping multiple validation errors
-// returned by PrepareTxResponse.ValidateAll() if the designated constraints
-// aren't met.
-type PrepareTxResponseMultiError []error
+// StreamSendNotificationsResponseMultiError is an error wrapping multiple
+// validation errors returned by StreamSendNotificationsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type StreamSendNotificationsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PrepareTxResponseMultiError) Error() string {
+func (m StreamSendNotificationsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1659,11 +2042,12 @@ func (m PrepareTxResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PrepareTxResponseMultiError) AllErrors() []error { return m }
+func (m StreamSendNotificationsResponseMultiError) AllErrors() []error { return m }
 
-// PrepareTxResponseValidationError is the validation error returned by
-// PrepareTxResponse.Validate if the designated constraints aren't met.
-type PrepareTxResponseValidationError struct {
+// StreamSendNotificationsResponseValidationError is the validation error
+// returned by StreamSendNotificationsResponse.Validate if the designated
+// constraints aren't met.
+type StreamSendNotificationsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1671,24 +2055,24 @@ type PrepareTxResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PrepareTxResponseValidationError) Field() string { return e.field }
+func (e StreamSendNotificationsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PrepareTxResponseValidationError) Reason() string { return e.reason }
+func (e StreamSendNotificationsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PrepareTxResponseValidationError) Cause() error { return e.cause }
+func (e StreamSendNotificationsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PrepareTxResponseValidationError) Key() bool { return e.key }
+func (e StreamSendNotificationsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PrepareTxResponseValidationError) ErrorName() string {
-	return "PrepareTxResponseValidationError"
+func (e StreamSendNotificationsResponseValidationError) ErrorName() string {
+	return "StreamSendNotificationsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PrepareTxResponseValidationError) Error() string {
+func (e StreamSendNotificationsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1700,14 +2084,14 @@ func (e PrepareTxResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPrepareTxResponse.%s: %s%s",
+		"invalid %sStreamSendNotificationsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PrepareTxResponseValidationError{}
+var _ error = StreamSendNotificationsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1715,46 +2099,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PrepareTxResponseValidationError{}
+} = StreamSendNotificationsResponseValidationError{}
 
-// Validate checks the field values on CommitTxRequest with the rules defined
+// Validate checks the field values on PrepareTxRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CommitTxRequest) Validate() error {
+func (m *PrepareTxRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommitTxRequest with the rules
+// ValidateAll checks the field values on PrepareTxRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CommitTxRequestMultiError, or nil if none found.
-func (m *CommitTxRequest) ValidateAll() error {
+// PrepareTxRequestMultiError, or nil if none found.
+func (m *PrepareTxRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommitTxRequest) validate(all bool) error {
+func (m *PrepareTxRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Key
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrepareTxRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrepareTxRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrepareTxRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CommitTxRequestMultiError(errors)
+		return PrepareTxRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommitTxRequestMultiError is an error wrapping multiple validation errors
-// returned by CommitTxRequest.ValidateAll() if the designated constraints
+// PrepareTxRequestMultiError is an error wrapping multiple validation errors
+// returned by PrepareTxRequest.ValidateAll() if the designated constraints
 // aren't met.
-type CommitTxRequestMultiError []error
+type PrepareTxRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommitTxRequestMultiError) Error() string {
+func (m PrepareTxRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1763,11 +2174,215 @@ func (m CommitTxRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommitTxRequestMultiError) AllErrors() []error { return m }
+func (m PrepareTxRequestMultiError) AllErrors() []error { return m }
 
-// CommitTxRequestValidationError is the validation error returned by
-// CommitTxRequest.Validate if the designated constraints aren't met.
-type CommitTxRequestValidationError struct {
+// PrepareTxRequestValidationError is the validation error returned by
+// PrepareTxRequest.Validate if the designated constraints aren't met.
+type PrepareTxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrepareTxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrepareTxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrepareTxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrepareTxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrepareTxRequestValidationError) ErrorName() string { return "PrepareTxRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PrepareTxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrepareTxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrepareTxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrepareTxRequestValidationError{}
+
+// Validate checks the field values on PrepareTxResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PrepareTxResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrepareTxResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrepareTxResponseMultiError, or nil if none found.
+func (m *PrepareTxResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrepareTxResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PrepareTxResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrepareTxResponseMultiError is an error wrapping multiple validation errors
+// returned by PrepareTxResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PrepareTxResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrepareTxResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrepareTxResponseMultiError) AllErrors() []error { return m }
+
+// PrepareTxResponseValidationError is the validation error returned by
+// PrepareTxResponse.Validate if the designated constraints aren't met.
+type PrepareTxResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrepareTxResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrepareTxResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrepareTxResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrepareTxResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrepareTxResponseValidationError) ErrorName() string {
+	return "PrepareTxResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrepareTxResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrepareTxResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrepareTxResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrepareTxResponseValidationError{}
+
+// Validate checks the field values on CommitTxRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CommitTxRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommitTxRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommitTxRequestMultiError, or nil if none found.
+func (m *CommitTxRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommitTxRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return CommitTxRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitTxRequestMultiError is an error wrapping multiple validation errors
+// returned by CommitTxRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CommitTxRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitTxRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitTxRequestMultiError) AllErrors() []error { return m }
+
+// CommitTxRequestValidationError is the validation error returned by
+// CommitTxRequest.Validate if the designated constraints aren't met.
+type CommitTxRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1956,7 +2571,1244 @@ func (m *CancelTxRequest) validate(all bool) error {
 type CancelTxRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelTxRequestMultiError) Error() string {
+func (m CancelTxRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelTxRequestMultiError) AllErrors() []error { return m }
+
+// CancelTxRequestValidationError is the validation error returned by
+// CancelTxRequest.Validate if the designated constraints aren't met.
+type CancelTxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelTxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelTxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelTxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelTxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelTxRequestValidationError) ErrorName() string { return "CancelTxRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CancelTxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelTxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelTxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelTxRequestValidationError{}
+
+// Validate checks the field values on CancelTxResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CancelTxResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelTxResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelTxResponseMultiError, or nil if none found.
+func (m *CancelTxResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelTxResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CancelTxResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelTxResponseMultiError is an error wrapping multiple validation errors
+// returned by CancelTxResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CancelTxResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelTxResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelTxResponseMultiError) AllErrors() []error { return m }
+
+// CancelTxResponseValidationError is the validation error returned by
+// CancelTxResponse.Validate if the designated constraints aren't met.
+type CancelTxResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelTxResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelTxResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelTxResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelTxResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelTxResponseValidationError) ErrorName() string { return "CancelTxResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CancelTxResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelTxResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelTxResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelTxResponseValidationError{}
+
+// Validate checks the field values on CancelNotificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelNotificationRequestMultiError, or nil if none found.
+func (m *CancelNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return CancelNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelNotificationRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelNotificationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CancelNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelNotificationRequestMultiError) AllErrors() []error { return m }
+
+// CancelNotificationRequestValidationError is the validation error returned by
+// CancelNotificationRequest.Validate if the designated constraints aren't met.
+type CancelNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelNotificationRequestValidationError) ErrorName() string {
+	return "CancelNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelNotificationRequestValidationError{}
+
+// Validate checks the field values on CancelNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelNotificationResponseMultiError, or nil if none found.
+func (m *CancelNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CancelNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelNotificationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CancelNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelNotificationResponseMultiError) AllErrors() []error { return m }
+
+// CancelNotificationResponseValidationError is the validation error returned
+// by CancelNotificationResponse.Validate if the designated constraints aren't met.
+type CancelNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelNotificationResponseValidationError) ErrorName() string {
+	return "CancelNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelNotificationResponseValidationError{}
+
+// Validate checks the field values on RescheduleNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RescheduleNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RescheduleNotificationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RescheduleNotificationRequestMultiError, or nil if none found.
+func (m *RescheduleNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RescheduleNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetSendStrategy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RescheduleNotificationRequestValidationError{
+					field:  "SendStrategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RescheduleNotificationRequestValidationError{
+					field:  "SendStrategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendStrategy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RescheduleNotificationRequestValidationError{
+				field:  "SendStrategy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RescheduleNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RescheduleNotificationRequestMultiError is an error wrapping multiple
+// validation errors returned by RescheduleNotificationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RescheduleNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RescheduleNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RescheduleNotificationRequestMultiError) AllErrors() []error { return m }
+
+// RescheduleNotificationRequestValidationError is the validation error
+// returned by RescheduleNotificationRequest.Validate if the designated
+// constraints aren't met.
+type RescheduleNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RescheduleNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RescheduleNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RescheduleNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RescheduleNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RescheduleNotificationRequestValidationError) ErrorName() string {
+	return "RescheduleNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RescheduleNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRescheduleNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RescheduleNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RescheduleNotificationRequestValidationError{}
+
+// Validate checks the field values on RescheduleNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RescheduleNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RescheduleNotificationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RescheduleNotificationResponseMultiError, or nil if none found.
+func (m *RescheduleNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RescheduleNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for ScheduledStime
+
+	// no validation rules for ScheduledEtime
+
+	if len(errors) > 0 {
+		return RescheduleNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RescheduleNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by RescheduleNotificationResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RescheduleNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RescheduleNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RescheduleNotificationResponseMultiError) AllErrors() []error { return m }
+
+// RescheduleNotificationResponseValidationError is the validation error
+// returned by RescheduleNotificationResponse.Validate if the designated
+// constraints aren't met.
+type RescheduleNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RescheduleNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RescheduleNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RescheduleNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RescheduleNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RescheduleNotificationResponseValidationError) ErrorName() string {
+	return "RescheduleNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RescheduleNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRescheduleNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RescheduleNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RescheduleNotificationResponseValidationError{}
+
+// Validate checks the field values on NotificationSeries with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationSeries) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationSeries with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationSeriesMultiError, or nil if none found.
+func (m *NotificationSeries) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationSeries) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Status
+
+	// no validation rules for Occurrences
+
+	// no validation rules for NextTime
+
+	if len(errors) > 0 {
+		return NotificationSeriesMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationSeriesMultiError is an error wrapping multiple validation errors
+// returned by NotificationSeries.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationSeriesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationSeriesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationSeriesMultiError) AllErrors() []error { return m }
+
+// NotificationSeriesValidationError is the validation error returned by
+// NotificationSeries.Validate if the designated constraints aren't met.
+type NotificationSeriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationSeriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationSeriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationSeriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationSeriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationSeriesValidationError) ErrorName() string {
+	return "NotificationSeriesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationSeriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationSeries.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationSeriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationSeriesValidationError{}
+
+// Validate checks the field values on PauseNotificationSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseNotificationSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseNotificationSeriesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PauseNotificationSeriesRequestMultiError, or nil if none found.
+func (m *PauseNotificationSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseNotificationSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return PauseNotificationSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseNotificationSeriesRequestMultiError is an error wrapping multiple
+// validation errors returned by PauseNotificationSeriesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PauseNotificationSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseNotificationSeriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseNotificationSeriesRequestMultiError) AllErrors() []error { return m }
+
+// PauseNotificationSeriesRequestValidationError is the validation error
+// returned by PauseNotificationSeriesRequest.Validate if the designated
+// constraints aren't met.
+type PauseNotificationSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseNotificationSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseNotificationSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseNotificationSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseNotificationSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseNotificationSeriesRequestValidationError) ErrorName() string {
+	return "PauseNotificationSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseNotificationSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseNotificationSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseNotificationSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseNotificationSeriesRequestValidationError{}
+
+// Validate checks the field values on PauseNotificationSeriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseNotificationSeriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseNotificationSeriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PauseNotificationSeriesResponseMultiError, or nil if none found.
+func (m *PauseNotificationSeriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseNotificationSeriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSeries()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PauseNotificationSeriesResponseValidationError{
+					field:  "Series",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PauseNotificationSeriesResponseValidationError{
+					field:  "Series",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeries()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PauseNotificationSeriesResponseValidationError{
+				field:  "Series",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PauseNotificationSeriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseNotificationSeriesResponseMultiError is an error wrapping multiple
+// validation errors returned by PauseNotificationSeriesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PauseNotificationSeriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseNotificationSeriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseNotificationSeriesResponseMultiError) AllErrors() []error { return m }
+
+// PauseNotificationSeriesResponseValidationError is the validation error
+// returned by PauseNotificationSeriesResponse.Validate if the designated
+// constraints aren't met.
+type PauseNotificationSeriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseNotificationSeriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseNotificationSeriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseNotificationSeriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseNotificationSeriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseNotificationSeriesResponseValidationError) ErrorName() string {
+	return "PauseNotificationSeriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseNotificationSeriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseNotificationSeriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseNotificationSeriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseNotificationSeriesResponseValidationError{}
+
+// Validate checks the field values on ResumeNotificationSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeNotificationSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeNotificationSeriesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResumeNotificationSeriesRequestMultiError, or nil if none found.
+func (m *ResumeNotificationSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeNotificationSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return ResumeNotificationSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeNotificationSeriesRequestMultiError is an error wrapping multiple
+// validation errors returned by ResumeNotificationSeriesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ResumeNotificationSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeNotificationSeriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeNotificationSeriesRequestMultiError) AllErrors() []error { return m }
+
+// ResumeNotificationSeriesRequestValidationError is the validation error
+// returned by ResumeNotificationSeriesRequest.Validate if the designated
+// constraints aren't met.
+type ResumeNotificationSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeNotificationSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeNotificationSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeNotificationSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeNotificationSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeNotificationSeriesRequestValidationError) ErrorName() string {
+	return "ResumeNotificationSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeNotificationSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeNotificationSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeNotificationSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeNotificationSeriesRequestValidationError{}
+
+// Validate checks the field values on ResumeNotificationSeriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ResumeNotificationSeriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeNotificationSeriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ResumeNotificationSeriesResponseMultiError, or nil if none found.
+func (m *ResumeNotificationSeriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeNotificationSeriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSeries()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResumeNotificationSeriesResponseValidationError{
+					field:  "Series",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResumeNotificationSeriesResponseValidationError{
+					field:  "Series",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeries()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResumeNotificationSeriesResponseValidationError{
+				field:  "Series",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResumeNotificationSeriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeNotificationSeriesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ResumeNotificationSeriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeNotificationSeriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeNotificationSeriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeNotificationSeriesResponseMultiError) AllErrors() []error { return m }
+
+// ResumeNotificationSeriesResponseValidationError is the validation error
+// returned by ResumeNotificationSeriesResponse.Validate if the designated
+// constraints aren't met.
+type ResumeNotificationSeriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeNotificationSeriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeNotificationSeriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeNotificationSeriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeNotificationSeriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeNotificationSeriesResponseValidationError) ErrorName() string {
+	return "ResumeNotificationSeriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeNotificationSeriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeNotificationSeriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeNotificationSeriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeNotificationSeriesResponseValidationError{}
+
+// Validate checks the field values on StopNotificationSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StopNotificationSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StopNotificationSeriesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StopNotificationSeriesRequestMultiError, or nil if none found.
+func (m *StopNotificationSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StopNotificationSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return StopNotificationSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StopNotificationSeriesRequestMultiError is an error wrapping multiple
+// validation errors returned by StopNotificationSeriesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type StopNotificationSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StopNotificationSeriesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1965,11 +3817,12 @@ func (m CancelTxRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelTxRequestMultiError) AllErrors() []error { return m }
+func (m StopNotificationSeriesRequestMultiError) AllErrors() []error { return m }
 
-// CancelTxRequestValidationError is the validation error returned by
-// CancelTxRequest.Validate if the designated constraints aren't met.
-type CancelTxRequestValidationError struct {
+// StopNotificationSeriesRequestValidationError is the validation error
+// returned by StopNotificationSeriesRequest.Validate if the designated
+// constraints aren't met.
+type StopNotificationSeriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1977,22 +3830,24 @@ type CancelTxRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelTxRequestValidationError) Field() string { return e.field }
+func (e StopNotificationSeriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelTxRequestValidationError) Reason() string { return e.reason }
+func (e StopNotificationSeriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelTxRequestValidationError) Cause() error { return e.cause }
+func (e StopNotificationSeriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelTxRequestValidationError) Key() bool { return e.key }
+func (e StopNotificationSeriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelTxRequestValidationError) ErrorName() string { return "CancelTxRequestValidationError" }
+func (e StopNotificationSeriesRequestValidationError) ErrorName() string {
+	return "StopNotificationSeriesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CancelTxRequestValidationError) Error() string {
+func (e StopNotificationSeriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2004,14 +3859,14 @@ func (e CancelTxRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelTxRequest.%s: %s%s",
+		"invalid %sStopNotificationSeriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelTxRequestValidationError{}
+var _ error = StopNotificationSeriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2019,44 +3874,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelTxRequestValidationError{}
+} = StopNotificationSeriesRequestValidationError{}
 
-// Validate checks the field values on CancelTxResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CancelTxResponse) Validate() error {
+// Validate checks the field values on StopNotificationSeriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StopNotificationSeriesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelTxResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CancelTxResponseMultiError, or nil if none found.
-func (m *CancelTxResponse) ValidateAll() error {
+// ValidateAll checks the field values on StopNotificationSeriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StopNotificationSeriesResponseMultiError, or nil if none found.
+func (m *StopNotificationSeriesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelTxResponse) validate(all bool) error {
+func (m *StopNotificationSeriesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSeries()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StopNotificationSeriesResponseValidationError{
+					field:  "Series",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StopNotificationSeriesResponseValidationError{
+					field:  "Series",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeries()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StopNotificationSeriesResponseValidationError{
+				field:  "Series",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return CancelTxResponseMultiError(errors)
+		return StopNotificationSeriesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelTxResponseMultiError is an error wrapping multiple validation errors
-// returned by CancelTxResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CancelTxResponseMultiError []error
+// StopNotificationSeriesResponseMultiError is an error wrapping multiple
+// validation errors returned by StopNotificationSeriesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type StopNotificationSeriesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelTxResponseMultiError) Error() string {
+func (m StopNotificationSeriesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2065,11 +3949,12 @@ func (m CancelTxResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelTxResponseMultiError) AllErrors() []error { return m }
+func (m StopNotificationSeriesResponseMultiError) AllErrors() []error { return m }
 
-// CancelTxResponseValidationError is the validation error returned by
-// CancelTxResponse.Validate if the designated constraints aren't met.
-type CancelTxResponseValidationError struct {
+// StopNotificationSeriesResponseValidationError is the validation error
+// returned by StopNotificationSeriesResponse.Validate if the designated
+// constraints aren't met.
+type StopNotificationSeriesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2077,22 +3962,24 @@ type CancelTxResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelTxResponseValidationError) Field() string { return e.field }
+func (e StopNotificationSeriesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelTxResponseValidationError) Reason() string { return e.reason }
+func (e StopNotificationSeriesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelTxResponseValidationError) Cause() error { return e.cause }
+func (e StopNotificationSeriesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelTxResponseValidationError) Key() bool { return e.key }
+func (e StopNotificationSeriesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelTxResponseValidationError) ErrorName() string { return "CancelTxResponseValidationError" }
+func (e StopNotificationSeriesResponseValidationError) ErrorName() string {
+	return "StopNotificationSeriesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CancelTxResponseValidationError) Error() string {
+func (e StopNotificationSeriesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2104,14 +3991,14 @@ func (e CancelTxResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelTxResponse.%s: %s%s",
+		"invalid %sStopNotificationSeriesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelTxResponseValidationError{}
+var _ error = StopNotificationSeriesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2119,7 +4006,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelTxResponseValidationError{}
+} = StopNotificationSeriesResponseValidationError{}
 
 // Validate checks the field values on SendStrategy_ImmediateStrategy with the
 // rules defined in the proto definition for this message. If any rules are
@@ -2699,3 +4586,250 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SendStrategy_DeadlineStrategyValidationError{}
+
+// Validate checks the field values on SendStrategy_RecurringStrategy with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendStrategy_RecurringStrategy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendStrategy_RecurringStrategy with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendStrategy_RecurringStrategyMultiError, or nil if none found.
+func (m *SendStrategy_RecurringStrategy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendStrategy_RecurringStrategy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CronExpression
+
+	// no validation rules for Timezone
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendStrategy_RecurringStrategyValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendStrategy_RecurringStrategyValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendStrategy_RecurringStrategyValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxOccurrences
+
+	if len(errors) > 0 {
+		return SendStrategy_RecurringStrategyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendStrategy_RecurringStrategyMultiError is an error wrapping multiple
+// validation errors returned by SendStrategy_RecurringStrategy.ValidateAll()
+// if the designated constraints aren't met.
+type SendStrategy_RecurringStrategyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendStrategy_RecurringStrategyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendStrategy_RecurringStrategyMultiError) AllErrors() []error { return m }
+
+// SendStrategy_RecurringStrategyValidationError is the validation error
+// returned by SendStrategy_RecurringStrategy.Validate if the designated
+// constraints aren't met.
+type SendStrategy_RecurringStrategyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendStrategy_RecurringStrategyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendStrategy_RecurringStrategyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendStrategy_RecurringStrategyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendStrategy_RecurringStrategyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendStrategy_RecurringStrategyValidationError) ErrorName() string {
+	return "SendStrategy_RecurringStrategyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendStrategy_RecurringStrategyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendStrategy_RecurringStrategy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendStrategy_RecurringStrategyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendStrategy_RecurringStrategyValidationError{}
+
+// Validate checks the field values on SendStrategy_DigestStrategy with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendStrategy_DigestStrategy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendStrategy_DigestStrategy with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendStrategy_DigestStrategyMultiError, or nil if none found.
+func (m *SendStrategy_DigestStrategy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendStrategy_DigestStrategy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupKey
+
+	// no validation rules for DigestTemplateId
+
+	// no validation rules for WindowSeconds
+
+	if len(errors) > 0 {
+		return SendStrategy_DigestStrategyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendStrategy_DigestStrategyMultiError is an error wrapping multiple
+// validation errors returned by SendStrategy_DigestStrategy.ValidateAll() if
+// the designated constraints aren't met.
+type SendStrategy_DigestStrategyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendStrategy_DigestStrategyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendStrategy_DigestStrategyMultiError) AllErrors() []error { return m }
+
+// SendStrategy_DigestStrategyValidationError is the validation error returned
+// by SendStrategy_DigestStrategy.Validate if the designated constraints
+// aren't met.
+type SendStrategy_DigestStrategyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendStrategy_DigestStrategyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendStrategy_DigestStrategyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendStrategy_DigestStrategyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendStrategy_DigestStrategyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendStrategy_DigestStrategyValidationError) ErrorName() string {
+	return "SendStrategy_DigestStrategyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendStrategy_DigestStrategyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendStrategy_DigestStrategy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendStrategy_DigestStrategyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendStrategy_DigestStrategyValidationError{}
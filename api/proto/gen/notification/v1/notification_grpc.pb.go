@@ -23,9 +23,15 @@ const (
 	NotificationService_SendNotificationAsync_FullMethodName      = "/notification.v1.NotificationService/SendNotificationAsync"
 	NotificationService_SendNotificationBatch_FullMethodName      = "/notification.v1.NotificationService/SendNotificationBatch"
 	NotificationService_SendNotificationBatchAsync_FullMethodName = "/notification.v1.NotificationService/SendNotificationBatchAsync"
+	NotificationService_StreamSendNotifications_FullMethodName    = "/notification.v1.NotificationService/StreamSendNotifications"
 	NotificationService_PrepareTx_FullMethodName                  = "/notification.v1.NotificationService/PrepareTx"
 	NotificationService_CommitTx_FullMethodName                   = "/notification.v1.NotificationService/CommitTx"
 	NotificationService_CancelTx_FullMethodName                   = "/notification.v1.NotificationService/CancelTx"
+	NotificationService_CancelNotification_FullMethodName         = "/notification.v1.NotificationService/CancelNotification"
+	NotificationService_RescheduleNotification_FullMethodName     = "/notification.v1.NotificationService/RescheduleNotification"
+	NotificationService_PauseNotificationSeries_FullMethodName    = "/notification.v1.NotificationService/PauseNotificationSeries"
+	NotificationService_ResumeNotificationSeries_FullMethodName   = "/notification.v1.NotificationService/ResumeNotificationSeries"
+	NotificationService_StopNotificationSeries_FullMethodName     = "/notification.v1.NotificationService/StopNotificationSeries"
 )
 
 // NotificationServiceClient is the client API for NotificationService service.
@@ -40,12 +46,25 @@ type NotificationServiceClient interface {
 	SendNotificationBatch(ctx context.Context, in *SendNotificationBatchRequest, opts ...grpc.CallOption) (*SendNotificationBatchResponse, error)
 	// 异步批量发送
 	SendNotificationBatchAsync(ctx context.Context, in *SendNotificationBatchAsyncRequest, opts ...grpc.CallOption) (*SendNotificationBatchAsyncResponse, error)
+	// 流式异步发送，客户端持续发送通知，服务端分批写入后按序号逐条确认
+	// 服务端写入一批时不再接收新的通知，由 gRPC 流控让客户端等待；确认的顺序不保证与发送顺序一致
+	StreamSendNotifications(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamSendNotificationsRequest, StreamSendNotificationsResponse], error)
 	// 准备事务
 	PrepareTx(ctx context.Context, in *PrepareTxRequest, opts ...grpc.CallOption) (*PrepareTxResponse, error)
 	// 提交事务
 	CommitTx(ctx context.Context, in *CommitTxRequest, opts ...grpc.CallOption) (*CommitTxResponse, error)
 	// 取消事务
 	CancelTx(ctx context.Context, in *CancelTxRequest, opts ...grpc.CallOption) (*CancelTxResponse, error)
+	// 取消待发送的通知，已经开始发送的通知不能取消
+	CancelNotification(ctx context.Context, in *CancelNotificationRequest, opts ...grpc.CallOption) (*CancelNotificationResponse, error)
+	// 修改待发送通知的发送策略，已经开始发送的通知不能修改
+	RescheduleNotification(ctx context.Context, in *RescheduleNotificationRequest, opts ...grpc.CallOption) (*RescheduleNotificationResponse, error)
+	// 暂停周期发送
+	PauseNotificationSeries(ctx context.Context, in *PauseNotificationSeriesRequest, opts ...grpc.CallOption) (*PauseNotificationSeriesResponse, error)
+	// 恢复周期发送，暂停期间错过的发送不再补发
+	ResumeNotificationSeries(ctx context.Context, in *ResumeNotificationSeriesRequest, opts ...grpc.CallOption) (*ResumeNotificationSeriesResponse, error)
+	// 停止周期发送，停止后不能恢复
+	StopNotificationSeries(ctx context.Context, in *StopNotificationSeriesRequest, opts ...grpc.CallOption) (*StopNotificationSeriesResponse, error)
 }
 
 type notificationServiceClient struct {
@@ -96,6 +115,19 @@ func (c *notificationServiceClient) SendNotificationBatchAsync(ctx context.Conte
 	return out, nil
 }
 
+func (c *notificationServiceClient) StreamSendNotifications(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamSendNotificationsRequest, StreamSendNotificationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotificationService_ServiceDesc.Streams[0], NotificationService_StreamSendNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamSendNotificationsRequest, StreamSendNotificationsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotificationService_StreamSendNotificationsClient = grpc.BidiStreamingClient[StreamSendNotificationsRequest, StreamSendNotificationsResponse]
+
 func (c *notificationServiceClient) PrepareTx(ctx context.Context, in *PrepareTxRequest, opts ...grpc.CallOption) (*PrepareTxResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PrepareTxResponse)
@@ -126,6 +158,56 @@ func (c *notificationServiceClient) CancelTx(ctx context.Context, in *CancelTxRe
 	return out, nil
 }
 
+func (c *notificationServiceClient) CancelNotification(ctx context.Context, in *CancelNotificationRequest, opts ...grpc.CallOption) (*CancelNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CancelNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) RescheduleNotification(ctx context.Context, in *RescheduleNotificationRequest, opts ...grpc.CallOption) (*RescheduleNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_RescheduleNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) PauseNotificationSeries(ctx context.Context, in *PauseNotificationSeriesRequest, opts ...grpc.CallOption) (*PauseNotificationSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseNotificationSeriesResponse)
+	err := c.cc.Invoke(ctx, NotificationService_PauseNotificationSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ResumeNotificationSeries(ctx context.Context, in *ResumeNotificationSeriesRequest, opts ...grpc.CallOption) (*ResumeNotificationSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeNotificationSeriesResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ResumeNotificationSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) StopNotificationSeries(ctx context.Context, in *StopNotificationSeriesRequest, opts ...grpc.CallOption) (*StopNotificationSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopNotificationSeriesResponse)
+	err := c.cc.Invoke(ctx, NotificationService_StopNotificationSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations should embed UnimplementedNotificationServiceServer
 // for forward compatibility.
@@ -138,12 +220,25 @@ type NotificationServiceServer interface {
 	SendNotificationBatch(context.Context, *SendNotificationBatchRequest) (*SendNotificationBatchResponse, error)
 	// 异步批量发送
 	SendNotificationBatchAsync(context.Context, *SendNotificationBatchAsyncRequest) (*SendNotificationBatchAsyncResponse, error)
+	// 流式异步发送，客户端持续发送通知，服务端分批写入后按序号逐条确认
+	// 服务端写入一批时不再接收新的通知，由 gRPC 流控让客户端等待；确认的顺序不保证与发送顺序一致
+	StreamSendNotifications(grpc.BidiStreamingServer[StreamSendNotificationsRequest, StreamSendNotificationsResponse]) error
 	// 准备事务
 	PrepareTx(context.Context, *PrepareTxRequest) (*PrepareTxResponse, error)
 	// 提交事务
 	CommitTx(context.Context, *CommitTxRequest) (*CommitTxResponse, error)
 	// 取消事务
 	CancelTx(context.Context, *CancelTxRequest) (*CancelTxResponse, error)
+	// 取消待发送的通知，已经开始发送的通知不能取消
+	CancelNotification(context.Context, *CancelNotificationRequest) (*CancelNotificationResponse, error)
+	// 修改待发送通知的发送策略，已经开始发送的通知不能修改
+	RescheduleNotification(context.Context, *RescheduleNotificationRequest) (*RescheduleNotificationResponse, error)
+	// 暂停周期发送
+	PauseNotificationSeries(context.Context, *PauseNotificationSeriesRequest) (*PauseNotificationSeriesResponse, error)
+	// 恢复周期发送，暂停期间错过的发送不再补发
+	ResumeNotificationSeries(context.Context, *ResumeNotificationSeriesRequest) (*ResumeNotificationSeriesResponse, error)
+	// 停止周期发送，停止后不能恢复
+	StopNotificationSeries(context.Context, *StopNotificationSeriesRequest) (*StopNotificationSeriesResponse, error)
 }
 
 // UnimplementedNotificationServiceServer should be embedded to have
@@ -165,6 +260,9 @@ func (UnimplementedNotificationServiceServer) SendNotificationBatch(context.Cont
 func (UnimplementedNotificationServiceServer) SendNotificationBatchAsync(context.Context, *SendNotificationBatchAsyncRequest) (*SendNotificationBatchAsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendNotificationBatchAsync not implemented")
 }
+func (UnimplementedNotificationServiceServer) StreamSendNotifications(grpc.BidiStreamingServer[StreamSendNotificationsRequest, StreamSendNotificationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSendNotifications not implemented")
+}
 func (UnimplementedNotificationServiceServer) PrepareTx(context.Context, *PrepareTxRequest) (*PrepareTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrepareTx not implemented")
 }
@@ -174,6 +272,21 @@ func (UnimplementedNotificationServiceServer) CommitTx(context.Context, *CommitT
 func (UnimplementedNotificationServiceServer) CancelTx(context.Context, *CancelTxRequest) (*CancelTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelTx not implemented")
 }
+func (UnimplementedNotificationServiceServer) CancelNotification(context.Context, *CancelNotificationRequest) (*CancelNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) RescheduleNotification(context.Context, *RescheduleNotificationRequest) (*RescheduleNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) PauseNotificationSeries(context.Context, *PauseNotificationSeriesRequest) (*PauseNotificationSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseNotificationSeries not implemented")
+}
+func (UnimplementedNotificationServiceServer) ResumeNotificationSeries(context.Context, *ResumeNotificationSeriesRequest) (*ResumeNotificationSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeNotificationSeries not implemented")
+}
+func (UnimplementedNotificationServiceServer) StopNotificationSeries(context.Context, *StopNotificationSeriesRequest) (*StopNotificationSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopNotificationSeries not implemented")
+}
 func (UnimplementedNotificationServiceServer) testEmbeddedByValue() {}
 
 // UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -266,6 +379,13 @@ func _NotificationService_SendNotificationBatchAsync_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_StreamSendNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotificationServiceServer).StreamSendNotifications(&grpc.GenericServerStream[StreamSendNotificationsRequest, StreamSendNotificationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotificationService_StreamSendNotificationsServer = grpc.BidiStreamingServer[StreamSendNotificationsRequest, StreamSendNotificationsResponse]
+
 func _NotificationService_PrepareTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PrepareTxRequest)
 	if err := dec(in); err != nil {
@@ -320,6 +440,96 @@ func _NotificationService_CancelTx_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_CancelNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CancelNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CancelNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CancelNotification(ctx, req.(*CancelNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_RescheduleNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).RescheduleNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_RescheduleNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).RescheduleNotification(ctx, req.(*RescheduleNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_PauseNotificationSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseNotificationSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).PauseNotificationSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_PauseNotificationSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).PauseNotificationSeries(ctx, req.(*PauseNotificationSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ResumeNotificationSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeNotificationSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ResumeNotificationSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ResumeNotificationSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ResumeNotificationSeries(ctx, req.(*ResumeNotificationSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_StopNotificationSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopNotificationSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).StopNotificationSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_StopNotificationSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).StopNotificationSeries(ctx, req.(*StopNotificationSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -355,7 +565,34 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelTx",
 			Handler:    _NotificationService_CancelTx_Handler,
 		},
+		{
+			MethodName: "CancelNotification",
+			Handler:    _NotificationService_CancelNotification_Handler,
+		},
+		{
+			MethodName: "RescheduleNotification",
+			Handler:    _NotificationService_RescheduleNotification_Handler,
+		},
+		{
+			MethodName: "PauseNotificationSeries",
+			Handler:    _NotificationService_PauseNotificationSeries_Handler,
+		},
+		{
+			MethodName: "ResumeNotificationSeries",
+			Handler:    _NotificationService_ResumeNotificationSeries_Handler,
+		},
+		{
+			MethodName: "StopNotificationSeries",
+			Handler:    _NotificationService_StopNotificationSeries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSendNotifications",
+			Handler:       _NotificationService_StreamSendNotifications_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "notification/v1/notification.proto",
 }
@@ -21,6 +21,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 检索的排序字段，相同时按通知ID排序
+type NotificationSortField int32
+
+const (
+	// 未指定时按创建时间排序
+	NotificationSortField_NOTIFICATION_SORT_FIELD_UNSPECIFIED NotificationSortField = 0
+	// 创建时间
+	NotificationSortField_SORT_BY_CTIME NotificationSortField = 1
+	// 更新时间
+	NotificationSortField_SORT_BY_UTIME NotificationSortField = 2
+)
+
+// Enum value maps for NotificationSortField.
+var (
+	NotificationSortField_name = map[int32]string{
+		0: "NOTIFICATION_SORT_FIELD_UNSPECIFIED",
+		1: "SORT_BY_CTIME",
+		2: "SORT_BY_UTIME",
+	}
+	NotificationSortField_value = map[string]int32{
+		"NOTIFICATION_SORT_FIELD_UNSPECIFIED": 0,
+		"SORT_BY_CTIME":                       1,
+		"SORT_BY_UTIME":                       2,
+	}
+)
+
+func (x NotificationSortField) Enum() *NotificationSortField {
+	p := new(NotificationSortField)
+	*p = x
+	return p
+}
+
+func (x NotificationSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_v1_notification_query_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationSortField) Type() protoreflect.EnumType {
+	return &file_notification_v1_notification_query_proto_enumTypes[0]
+}
+
+func (x NotificationSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationSortField.Descriptor instead.
+func (NotificationSortField) EnumDescriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{0}
+}
+
 // 单条查询请求
 type QueryNotificationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -203,6 +256,672 @@ func (x *BatchQueryNotificationsResponse) GetResults() []*SendNotificationRespon
 	return nil
 }
 
+// 检索请求，所有条件都可选，限定在调用方的业务之下
+type SearchNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者，手机号带不带国家码前缀都能匹配
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 渠道
+	Channel Channel `protobuf:"varint,2,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 发送状态，满足其一即可，PENDING 包含发送中的通知
+	Statuses []SendStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=notification.v1.SendStatus" json:"statuses,omitempty"`
+	// 模板ID
+	TemplateId string `protobuf:"bytes,4,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 创建时间范围，毫秒时间戳
+	StartTime int64 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 业务内唯一标识的前缀
+	KeyPrefix string `protobuf:"bytes,7,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	// 排序字段
+	SortBy NotificationSortField `protobuf:"varint,8,opt,name=sort_by,json=sortBy,proto3,enum=notification.v1.NotificationSortField" json:"sort_by,omitempty"`
+	// 是否升序，默认倒序
+	Ascending bool `protobuf:"varint,9,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	// 上一页返回的游标，首页不传
+	Cursor string `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 每页条数，默认20，最大100
+	Limit         int32 `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotificationsRequest) Reset() {
+	*x = SearchNotificationsRequest{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotificationsRequest) ProtoMessage() {}
+
+func (x *SearchNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchNotificationsRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *SearchNotificationsRequest) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *SearchNotificationsRequest) GetStatuses() []SendStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *SearchNotificationsRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *SearchNotificationsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SearchNotificationsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SearchNotificationsRequest) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *SearchNotificationsRequest) GetSortBy() NotificationSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return NotificationSortField_NOTIFICATION_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *SearchNotificationsRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *SearchNotificationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 检索结果中的通知
+type NotificationSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知平台生成的通知ID
+	NotificationId int64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 业务方某个业务内部的唯一标识
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// 接收者
+	Receivers []string `protobuf:"bytes,3,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	// 渠道
+	Channel Channel `protobuf:"varint,4,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 模板ID
+	TemplateId string `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 发送状态
+	Status SendStatus `protobuf:"varint,6,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 实际送达的渠道
+	DeliveredChannel Channel `protobuf:"varint,7,opt,name=delivered_channel,json=deliveredChannel,proto3,enum=notification.v1.Channel" json:"delivered_channel,omitempty"`
+	// 计划发送时间，毫秒时间戳
+	ScheduledStime int64 `protobuf:"varint,8,opt,name=scheduled_stime,json=scheduledStime,proto3" json:"scheduled_stime,omitempty"`
+	ScheduledEtime int64 `protobuf:"varint,9,opt,name=scheduled_etime,json=scheduledEtime,proto3" json:"scheduled_etime,omitempty"`
+	// 创建和更新时间，毫秒时间戳
+	Ctime         int64 `protobuf:"varint,10,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime         int64 `protobuf:"varint,11,opt,name=utime,proto3" json:"utime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSummary) Reset() {
+	*x = NotificationSummary{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSummary) ProtoMessage() {}
+
+func (x *NotificationSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSummary.ProtoReflect.Descriptor instead.
+func (*NotificationSummary) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NotificationSummary) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *NotificationSummary) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NotificationSummary) GetReceivers() []string {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *NotificationSummary) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *NotificationSummary) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *NotificationSummary) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *NotificationSummary) GetDeliveredChannel() Channel {
+	if x != nil {
+		return x.DeliveredChannel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *NotificationSummary) GetScheduledStime() int64 {
+	if x != nil {
+		return x.ScheduledStime
+	}
+	return 0
+}
+
+func (x *NotificationSummary) GetScheduledEtime() int64 {
+	if x != nil {
+		return x.ScheduledEtime
+	}
+	return 0
+}
+
+func (x *NotificationSummary) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *NotificationSummary) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+// 检索响应
+type SearchNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*NotificationSummary `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	// 下一页游标
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// 是否还有更多数据
+	HasMore       bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNotificationsResponse) Reset() {
+	*x = SearchNotificationsResponse{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNotificationsResponse) ProtoMessage() {}
+
+func (x *SearchNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchNotificationsResponse) GetNotifications() []*NotificationSummary {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *SearchNotificationsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *SearchNotificationsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 状态流转查询请求
+type GetNotificationTimelineRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务方某个业务内部的唯一标识
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationTimelineRequest) Reset() {
+	*x = GetNotificationTimelineRequest{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationTimelineRequest) ProtoMessage() {}
+
+func (x *GetNotificationTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetNotificationTimelineRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 一次状态变化
+type NotificationTransition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 变化前的状态，创建时为空，SENDING 表示已经交给发送流程
+	FromStatus string `protobuf:"bytes,1,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	// 变化后的状态
+	ToStatus string `protobuf:"bytes,2,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	// 触发方，API、SCHEDULER、TX_CHECK、TIMEOUT_TASK、REPLAY
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// 最近一次提交的供应商
+	Provider string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 错误信息
+	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 发生时间，毫秒时间戳
+	Time          int64 `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationTransition) Reset() {
+	*x = NotificationTransition{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationTransition) ProtoMessage() {}
+
+func (x *NotificationTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationTransition.ProtoReflect.Descriptor instead.
+func (*NotificationTransition) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NotificationTransition) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *NotificationTransition) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *NotificationTransition) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *NotificationTransition) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *NotificationTransition) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *NotificationTransition) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// 状态流转查询响应
+type GetNotificationTimelineResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId int64                  `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 当前状态
+	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 按发生顺序排列
+	Transitions   []*NotificationTransition `protobuf:"bytes,3,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationTimelineResponse) Reset() {
+	*x = GetNotificationTimelineResponse{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationTimelineResponse) ProtoMessage() {}
+
+func (x *GetNotificationTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetNotificationTimelineResponse) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *GetNotificationTimelineResponse) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *GetNotificationTimelineResponse) GetTransitions() []*NotificationTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+// 订阅请求，keys 和 notification_ids 都为空时订阅业务下的所有通知，否则满足其一即可
+type WatchNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务方某个业务内部的唯一标识
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// 通知平台生成的通知ID
+	NotificationIds []int64 `protobuf:"varint,2,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	// 重连时传入最后收到的事件的 resume_token，从该事件之后继续推送；不传时只推送订阅之后的新变化
+	ResumeToken   string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNotificationsRequest) Reset() {
+	*x = WatchNotificationsRequest{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNotificationsRequest) ProtoMessage() {}
+
+func (x *WatchNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*WatchNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchNotificationsRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *WatchNotificationsRequest) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+func (x *WatchNotificationsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// 一次状态变化
+type NotificationStatusEvent struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	NotificationId int64                   `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	Key            string                  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Transition     *NotificationTransition `protobuf:"bytes,3,opt,name=transition,proto3" json:"transition,omitempty"`
+	// 用于断线重连后继续订阅
+	ResumeToken   string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationStatusEvent) Reset() {
+	*x = NotificationStatusEvent{}
+	mi := &file_notification_v1_notification_query_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationStatusEvent) ProtoMessage() {}
+
+func (x *NotificationStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_query_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationStatusEvent.ProtoReflect.Descriptor instead.
+func (*NotificationStatusEvent) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NotificationStatusEvent) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *NotificationStatusEvent) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NotificationStatusEvent) GetTransition() *NotificationTransition {
+	if x != nil {
+		return x.Transition
+	}
+	return nil
+}
+
+func (x *NotificationStatusEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 var File_notification_v1_notification_query_proto protoreflect.FileDescriptor
 
 const file_notification_v1_notification_query_proto_rawDesc = "" +
@@ -215,10 +934,77 @@ const file_notification_v1_notification_query_proto_rawDesc = "" +
 	"\x1eBatchQueryNotificationsRequest\x12\x12\n" +
 	"\x04keys\x18\x01 \x03(\tR\x04keys\"f\n" +
 	"\x1fBatchQueryNotificationsResponse\x12C\n" +
-	"\aresults\x18\x01 \x03(\v2).notification.v1.SendNotificationResponseR\aresults2\x84\x02\n" +
+	"\aresults\x18\x01 \x03(\v2).notification.v1.SendNotificationResponseR\aresults\"\xac\x03\n" +
+	"\x1aSearchNotificationsRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x122\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x127\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x1b.notification.v1.SendStatusR\bstatuses\x12\x1f\n" +
+	"\vtemplate_id\x18\x04 \x01(\tR\n" +
+	"templateId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\x03R\aendTime\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\a \x01(\tR\tkeyPrefix\x12?\n" +
+	"\asort_by\x18\b \x01(\x0e2&.notification.v1.NotificationSortFieldR\x06sortBy\x12\x1c\n" +
+	"\tascending\x18\t \x01(\bR\tascending\x12\x16\n" +
+	"\x06cursor\x18\n" +
+	" \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\v \x01(\x05R\x05limit\"\xbd\x03\n" +
+	"\x13NotificationSummary\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x03R\x0enotificationId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1c\n" +
+	"\treceivers\x18\x03 \x03(\tR\treceivers\x122\n" +
+	"\achannel\x18\x04 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1f\n" +
+	"\vtemplate_id\x18\x05 \x01(\tR\n" +
+	"templateId\x123\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12E\n" +
+	"\x11delivered_channel\x18\a \x01(\x0e2\x18.notification.v1.ChannelR\x10deliveredChannel\x12'\n" +
+	"\x0fscheduled_stime\x18\b \x01(\x03R\x0escheduledStime\x12'\n" +
+	"\x0fscheduled_etime\x18\t \x01(\x03R\x0escheduledEtime\x12\x14\n" +
+	"\x05ctime\x18\n" +
+	" \x01(\x03R\x05ctime\x12\x14\n" +
+	"\x05utime\x18\v \x01(\x03R\x05utime\"\xa5\x01\n" +
+	"\x1bSearchNotificationsResponse\x12J\n" +
+	"\rnotifications\x18\x01 \x03(\v2$.notification.v1.NotificationSummaryR\rnotifications\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"2\n" +
+	"\x1eGetNotificationTimelineRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\xc1\x01\n" +
+	"\x16NotificationTransition\x12\x1f\n" +
+	"\vfrom_status\x18\x01 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x02 \x01(\tR\btoStatus\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x1a\n" +
+	"\bprovider\x18\x04 \x01(\tR\bprovider\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12\x12\n" +
+	"\x04time\x18\x06 \x01(\x03R\x04time\"\xca\x01\n" +
+	"\x1fGetNotificationTimelineResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x03R\x0enotificationId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12I\n" +
+	"\vtransitions\x18\x03 \x03(\v2'.notification.v1.NotificationTransitionR\vtransitions\"}\n" +
+	"\x19WatchNotificationsRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\x12)\n" +
+	"\x10notification_ids\x18\x02 \x03(\x03R\x0fnotificationIds\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"\xc0\x01\n" +
+	"\x17NotificationStatusEvent\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x03R\x0enotificationId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12G\n" +
+	"\n" +
+	"transition\x18\x03 \x01(\v2'.notification.v1.NotificationTransitionR\n" +
+	"transition\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken*f\n" +
+	"\x15NotificationSortField\x12'\n" +
+	"#NOTIFICATION_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSORT_BY_CTIME\x10\x01\x12\x11\n" +
+	"\rSORT_BY_UTIME\x10\x022\xe2\x04\n" +
 	"\x18NotificationQueryService\x12j\n" +
 	"\x11QueryNotification\x12).notification.v1.QueryNotificationRequest\x1a*.notification.v1.QueryNotificationResponse\x12|\n" +
-	"\x17BatchQueryNotifications\x12/.notification.v1.BatchQueryNotificationsRequest\x1a0.notification.v1.BatchQueryNotificationsResponseB\xc8\x01\n" +
+	"\x17BatchQueryNotifications\x12/.notification.v1.BatchQueryNotificationsRequest\x1a0.notification.v1.BatchQueryNotificationsResponse\x12p\n" +
+	"\x13SearchNotifications\x12+.notification.v1.SearchNotificationsRequest\x1a,.notification.v1.SearchNotificationsResponse\x12|\n" +
+	"\x17GetNotificationTimeline\x12/.notification.v1.GetNotificationTimelineRequest\x1a0.notification.v1.GetNotificationTimelineResponse\x12l\n" +
+	"\x12WatchNotifications\x12*.notification.v1.WatchNotificationsRequest\x1a(.notification.v1.NotificationStatusEvent0\x01B\xc8\x01\n" +
 	"\x13com.notification.v1B\x16NotificationQueryProtoP\x01Z<go-notification/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
 
 var (
@@ -233,26 +1019,54 @@ func file_notification_v1_notification_query_proto_rawDescGZIP() []byte {
 	return file_notification_v1_notification_query_proto_rawDescData
 }
 
-var file_notification_v1_notification_query_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_notification_v1_notification_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notification_v1_notification_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_notification_v1_notification_query_proto_goTypes = []any{
-	(*QueryNotificationRequest)(nil),        // 0: notification.v1.QueryNotificationRequest
-	(*QueryNotificationResponse)(nil),       // 1: notification.v1.QueryNotificationResponse
-	(*BatchQueryNotificationsRequest)(nil),  // 2: notification.v1.BatchQueryNotificationsRequest
-	(*BatchQueryNotificationsResponse)(nil), // 3: notification.v1.BatchQueryNotificationsResponse
-	(*SendNotificationResponse)(nil),        // 4: notification.v1.SendNotificationResponse
+	(NotificationSortField)(0),              // 0: notification.v1.NotificationSortField
+	(*QueryNotificationRequest)(nil),        // 1: notification.v1.QueryNotificationRequest
+	(*QueryNotificationResponse)(nil),       // 2: notification.v1.QueryNotificationResponse
+	(*BatchQueryNotificationsRequest)(nil),  // 3: notification.v1.BatchQueryNotificationsRequest
+	(*BatchQueryNotificationsResponse)(nil), // 4: notification.v1.BatchQueryNotificationsResponse
+	(*SearchNotificationsRequest)(nil),      // 5: notification.v1.SearchNotificationsRequest
+	(*NotificationSummary)(nil),             // 6: notification.v1.NotificationSummary
+	(*SearchNotificationsResponse)(nil),     // 7: notification.v1.SearchNotificationsResponse
+	(*GetNotificationTimelineRequest)(nil),  // 8: notification.v1.GetNotificationTimelineRequest
+	(*NotificationTransition)(nil),          // 9: notification.v1.NotificationTransition
+	(*GetNotificationTimelineResponse)(nil), // 10: notification.v1.GetNotificationTimelineResponse
+	(*WatchNotificationsRequest)(nil),       // 11: notification.v1.WatchNotificationsRequest
+	(*NotificationStatusEvent)(nil),         // 12: notification.v1.NotificationStatusEvent
+	(*SendNotificationResponse)(nil),        // 13: notification.v1.SendNotificationResponse
+	(Channel)(0),                            // 14: notification.v1.Channel
+	(SendStatus)(0),                         // 15: notification.v1.SendStatus
 }
 var file_notification_v1_notification_query_proto_depIdxs = []int32{
-	4, // 0: notification.v1.QueryNotificationResponse.result:type_name -> notification.v1.SendNotificationResponse
-	4, // 1: notification.v1.BatchQueryNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
-	0, // 2: notification.v1.NotificationQueryService.QueryNotification:input_type -> notification.v1.QueryNotificationRequest
-	2, // 3: notification.v1.NotificationQueryService.BatchQueryNotifications:input_type -> notification.v1.BatchQueryNotificationsRequest
-	1, // 4: notification.v1.NotificationQueryService.QueryNotification:output_type -> notification.v1.QueryNotificationResponse
-	3, // 5: notification.v1.NotificationQueryService.BatchQueryNotifications:output_type -> notification.v1.BatchQueryNotificationsResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	13, // 0: notification.v1.QueryNotificationResponse.result:type_name -> notification.v1.SendNotificationResponse
+	13, // 1: notification.v1.BatchQueryNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
+	14, // 2: notification.v1.SearchNotificationsRequest.channel:type_name -> notification.v1.Channel
+	15, // 3: notification.v1.SearchNotificationsRequest.statuses:type_name -> notification.v1.SendStatus
+	0,  // 4: notification.v1.SearchNotificationsRequest.sort_by:type_name -> notification.v1.NotificationSortField
+	14, // 5: notification.v1.NotificationSummary.channel:type_name -> notification.v1.Channel
+	15, // 6: notification.v1.NotificationSummary.status:type_name -> notification.v1.SendStatus
+	14, // 7: notification.v1.NotificationSummary.delivered_channel:type_name -> notification.v1.Channel
+	6,  // 8: notification.v1.SearchNotificationsResponse.notifications:type_name -> notification.v1.NotificationSummary
+	15, // 9: notification.v1.GetNotificationTimelineResponse.status:type_name -> notification.v1.SendStatus
+	9,  // 10: notification.v1.GetNotificationTimelineResponse.transitions:type_name -> notification.v1.NotificationTransition
+	9,  // 11: notification.v1.NotificationStatusEvent.transition:type_name -> notification.v1.NotificationTransition
+	1,  // 12: notification.v1.NotificationQueryService.QueryNotification:input_type -> notification.v1.QueryNotificationRequest
+	3,  // 13: notification.v1.NotificationQueryService.BatchQueryNotifications:input_type -> notification.v1.BatchQueryNotificationsRequest
+	5,  // 14: notification.v1.NotificationQueryService.SearchNotifications:input_type -> notification.v1.SearchNotificationsRequest
+	8,  // 15: notification.v1.NotificationQueryService.GetNotificationTimeline:input_type -> notification.v1.GetNotificationTimelineRequest
+	11, // 16: notification.v1.NotificationQueryService.WatchNotifications:input_type -> notification.v1.WatchNotificationsRequest
+	2,  // 17: notification.v1.NotificationQueryService.QueryNotification:output_type -> notification.v1.QueryNotificationResponse
+	4,  // 18: notification.v1.NotificationQueryService.BatchQueryNotifications:output_type -> notification.v1.BatchQueryNotificationsResponse
+	7,  // 19: notification.v1.NotificationQueryService.SearchNotifications:output_type -> notification.v1.SearchNotificationsResponse
+	10, // 20: notification.v1.NotificationQueryService.GetNotificationTimeline:output_type -> notification.v1.GetNotificationTimelineResponse
+	12, // 21: notification.v1.NotificationQueryService.WatchNotifications:output_type -> notification.v1.NotificationStatusEvent
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_notification_v1_notification_query_proto_init() }
@@ -266,13 +1080,14 @@ func file_notification_v1_notification_query_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_notification_query_proto_rawDesc), len(file_notification_v1_notification_query_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_notification_v1_notification_query_proto_goTypes,
 		DependencyIndexes: file_notification_v1_notification_query_proto_depIdxs,
+		EnumInfos:         file_notification_v1_notification_query_proto_enumTypes,
 		MessageInfos:      file_notification_v1_notification_query_proto_msgTypes,
 	}.Build()
 	File_notification_v1_notification_query_proto = out.File
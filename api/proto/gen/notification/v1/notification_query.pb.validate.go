@@ -509,3 +509,989 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = BatchQueryNotificationsResponseValidationError{}
+
+// Validate checks the field values on SearchNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchNotificationsRequestMultiError, or nil if none found.
+func (m *SearchNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	// no validation rules for Channel
+
+	// no validation rules for TemplateId
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for KeyPrefix
+
+	// no validation rules for SortBy
+
+	// no validation rules for Ascending
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return SearchNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchNotificationsRequestMultiError is an error wrapping multiple
+// validation errors returned by SearchNotificationsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SearchNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// SearchNotificationsRequestValidationError is the validation error returned
+// by SearchNotificationsRequest.Validate if the designated constraints aren't met.
+type SearchNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchNotificationsRequestValidationError) ErrorName() string {
+	return "SearchNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchNotificationsRequestValidationError{}
+
+// Validate checks the field values on NotificationSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationSummaryMultiError, or nil if none found.
+func (m *NotificationSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Key
+
+	// no validation rules for Channel
+
+	// no validation rules for TemplateId
+
+	// no validation rules for Status
+
+	// no validation rules for DeliveredChannel
+
+	// no validation rules for ScheduledStime
+
+	// no validation rules for ScheduledEtime
+
+	// no validation rules for Ctime
+
+	// no validation rules for Utime
+
+	if len(errors) > 0 {
+		return NotificationSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationSummaryMultiError is an error wrapping multiple validation
+// errors returned by NotificationSummary.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationSummaryMultiError) AllErrors() []error { return m }
+
+// NotificationSummaryValidationError is the validation error returned by
+// NotificationSummary.Validate if the designated constraints aren't met.
+type NotificationSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationSummaryValidationError) ErrorName() string {
+	return "NotificationSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationSummaryValidationError{}
+
+// Validate checks the field values on SearchNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchNotificationsResponseMultiError, or nil if none found.
+func (m *SearchNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchNotificationsResponseValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	// no validation rules for HasMore
+
+	if len(errors) > 0 {
+		return SearchNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchNotificationsResponseMultiError is an error wrapping multiple
+// validation errors returned by SearchNotificationsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SearchNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchNotificationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// SearchNotificationsResponseValidationError is the validation error returned
+// by SearchNotificationsResponse.Validate if the designated constraints
+// aren't met.
+type SearchNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchNotificationsResponseValidationError) ErrorName() string {
+	return "SearchNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchNotificationsResponseValidationError{}
+
+// Validate checks the field values on GetNotificationTimelineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNotificationTimelineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNotificationTimelineRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetNotificationTimelineRequestMultiError, or nil if none found.
+func (m *GetNotificationTimelineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNotificationTimelineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return GetNotificationTimelineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNotificationTimelineRequestMultiError is an error wrapping multiple
+// validation errors returned by GetNotificationTimelineRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetNotificationTimelineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNotificationTimelineRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNotificationTimelineRequestMultiError) AllErrors() []error { return m }
+
+// GetNotificationTimelineRequestValidationError is the validation error
+// returned by GetNotificationTimelineRequest.Validate if the designated
+// constraints aren't met.
+type GetNotificationTimelineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNotificationTimelineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNotificationTimelineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNotificationTimelineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNotificationTimelineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNotificationTimelineRequestValidationError) ErrorName() string {
+	return "GetNotificationTimelineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNotificationTimelineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNotificationTimelineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNotificationTimelineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNotificationTimelineRequestValidationError{}
+
+// Validate checks the field values on NotificationTransition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationTransition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationTransition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationTransitionMultiError, or nil if none found.
+func (m *NotificationTransition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationTransition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromStatus
+
+	// no validation rules for ToStatus
+
+	// no validation rules for Actor
+
+	// no validation rules for Provider
+
+	// no validation rules for ErrorMessage
+
+	// no validation rules for Time
+
+	if len(errors) > 0 {
+		return NotificationTransitionMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationTransitionMultiError is an error wrapping multiple validation
+// errors returned by NotificationTransition.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationTransitionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationTransitionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationTransitionMultiError) AllErrors() []error { return m }
+
+// NotificationTransitionValidationError is the validation error returned by
+// NotificationTransition.Validate if the designated constraints aren't met.
+type NotificationTransitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationTransitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationTransitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationTransitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationTransitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationTransitionValidationError) ErrorName() string {
+	return "NotificationTransitionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationTransitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationTransition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationTransitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationTransitionValidationError{}
+
+// Validate checks the field values on GetNotificationTimelineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNotificationTimelineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNotificationTimelineResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetNotificationTimelineResponseMultiError, or nil if none found.
+func (m *GetNotificationTimelineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNotificationTimelineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetTransitions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetNotificationTimelineResponseValidationError{
+						field:  fmt.Sprintf("Transitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetNotificationTimelineResponseValidationError{
+						field:  fmt.Sprintf("Transitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetNotificationTimelineResponseValidationError{
+					field:  fmt.Sprintf("Transitions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetNotificationTimelineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNotificationTimelineResponseMultiError is an error wrapping multiple
+// validation errors returned by GetNotificationTimelineResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetNotificationTimelineResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNotificationTimelineResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNotificationTimelineResponseMultiError) AllErrors() []error { return m }
+
+// GetNotificationTimelineResponseValidationError is the validation error
+// returned by GetNotificationTimelineResponse.Validate if the designated
+// constraints aren't met.
+type GetNotificationTimelineResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNotificationTimelineResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNotificationTimelineResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNotificationTimelineResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNotificationTimelineResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNotificationTimelineResponseValidationError) ErrorName() string {
+	return "GetNotificationTimelineResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNotificationTimelineResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNotificationTimelineResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNotificationTimelineResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNotificationTimelineResponseValidationError{}
+
+// Validate checks the field values on WatchNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchNotificationsRequestMultiError, or nil if none found.
+func (m *WatchNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return WatchNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchNotificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchNotificationsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type WatchNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// WatchNotificationsRequestValidationError is the validation error returned by
+// WatchNotificationsRequest.Validate if the designated constraints aren't met.
+type WatchNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchNotificationsRequestValidationError) ErrorName() string {
+	return "WatchNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchNotificationsRequestValidationError{}
+
+// Validate checks the field values on NotificationStatusEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationStatusEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationStatusEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationStatusEventMultiError, or nil if none found.
+func (m *NotificationStatusEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationStatusEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetTransition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationStatusEventValidationError{
+					field:  "Transition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationStatusEventValidationError{
+					field:  "Transition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationStatusEventValidationError{
+				field:  "Transition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	if len(errors) > 0 {
+		return NotificationStatusEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationStatusEventMultiError is an error wrapping multiple validation
+// errors returned by NotificationStatusEvent.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationStatusEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationStatusEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationStatusEventMultiError) AllErrors() []error { return m }
+
+// NotificationStatusEventValidationError is the validation error returned by
+// NotificationStatusEvent.Validate if the designated constraints aren't met.
+type NotificationStatusEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationStatusEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationStatusEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationStatusEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationStatusEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationStatusEventValidationError) ErrorName() string {
+	return "NotificationStatusEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationStatusEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationStatusEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationStatusEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationStatusEventValidationError{}
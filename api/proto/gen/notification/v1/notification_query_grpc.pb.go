@@ -21,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	NotificationQueryService_QueryNotification_FullMethodName       = "/notification.v1.NotificationQueryService/QueryNotification"
 	NotificationQueryService_BatchQueryNotifications_FullMethodName = "/notification.v1.NotificationQueryService/BatchQueryNotifications"
+	NotificationQueryService_SearchNotifications_FullMethodName     = "/notification.v1.NotificationQueryService/SearchNotifications"
+	NotificationQueryService_GetNotificationTimeline_FullMethodName = "/notification.v1.NotificationQueryService/GetNotificationTimeline"
+	NotificationQueryService_WatchNotifications_FullMethodName      = "/notification.v1.NotificationQueryService/WatchNotifications"
 )
 
 // NotificationQueryServiceClient is the client API for NotificationQueryService service.
@@ -33,6 +36,12 @@ type NotificationQueryServiceClient interface {
 	QueryNotification(ctx context.Context, in *QueryNotificationRequest, opts ...grpc.CallOption) (*QueryNotificationResponse, error)
 	// 批量查询
 	BatchQueryNotifications(ctx context.Context, in *BatchQueryNotificationsRequest, opts ...grpc.CallOption) (*BatchQueryNotificationsResponse, error)
+	// 按条件分页检索通知
+	SearchNotifications(ctx context.Context, in *SearchNotificationsRequest, opts ...grpc.CallOption) (*SearchNotificationsResponse, error)
+	// 查询通知的状态流转记录
+	GetNotificationTimeline(ctx context.Context, in *GetNotificationTimelineRequest, opts ...grpc.CallOption) (*GetNotificationTimelineResponse, error)
+	// 订阅通知的状态变化，连接期间持续推送
+	WatchNotifications(ctx context.Context, in *WatchNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationStatusEvent], error)
 }
 
 type notificationQueryServiceClient struct {
@@ -63,6 +72,45 @@ func (c *notificationQueryServiceClient) BatchQueryNotifications(ctx context.Con
 	return out, nil
 }
 
+func (c *notificationQueryServiceClient) SearchNotifications(ctx context.Context, in *SearchNotificationsRequest, opts ...grpc.CallOption) (*SearchNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationQueryService_SearchNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationQueryServiceClient) GetNotificationTimeline(ctx context.Context, in *GetNotificationTimelineRequest, opts ...grpc.CallOption) (*GetNotificationTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationTimelineResponse)
+	err := c.cc.Invoke(ctx, NotificationQueryService_GetNotificationTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationQueryServiceClient) WatchNotifications(ctx context.Context, in *WatchNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationStatusEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotificationQueryService_ServiceDesc.Streams[0], NotificationQueryService_WatchNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchNotificationsRequest, NotificationStatusEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotificationQueryService_WatchNotificationsClient = grpc.ServerStreamingClient[NotificationStatusEvent]
+
 // NotificationQueryServiceServer is the server API for NotificationQueryService service.
 // All implementations should embed UnimplementedNotificationQueryServiceServer
 // for forward compatibility.
@@ -73,6 +121,12 @@ type NotificationQueryServiceServer interface {
 	QueryNotification(context.Context, *QueryNotificationRequest) (*QueryNotificationResponse, error)
 	// 批量查询
 	BatchQueryNotifications(context.Context, *BatchQueryNotificationsRequest) (*BatchQueryNotificationsResponse, error)
+	// 按条件分页检索通知
+	SearchNotifications(context.Context, *SearchNotificationsRequest) (*SearchNotificationsResponse, error)
+	// 查询通知的状态流转记录
+	GetNotificationTimeline(context.Context, *GetNotificationTimelineRequest) (*GetNotificationTimelineResponse, error)
+	// 订阅通知的状态变化，连接期间持续推送
+	WatchNotifications(*WatchNotificationsRequest, grpc.ServerStreamingServer[NotificationStatusEvent]) error
 }
 
 // UnimplementedNotificationQueryServiceServer should be embedded to have
@@ -88,6 +142,15 @@ func (UnimplementedNotificationQueryServiceServer) QueryNotification(context.Con
 func (UnimplementedNotificationQueryServiceServer) BatchQueryNotifications(context.Context, *BatchQueryNotificationsRequest) (*BatchQueryNotificationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchQueryNotifications not implemented")
 }
+func (UnimplementedNotificationQueryServiceServer) SearchNotifications(context.Context, *SearchNotificationsRequest) (*SearchNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchNotifications not implemented")
+}
+func (UnimplementedNotificationQueryServiceServer) GetNotificationTimeline(context.Context, *GetNotificationTimelineRequest) (*GetNotificationTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationTimeline not implemented")
+}
+func (UnimplementedNotificationQueryServiceServer) WatchNotifications(*WatchNotificationsRequest, grpc.ServerStreamingServer[NotificationStatusEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNotifications not implemented")
+}
 func (UnimplementedNotificationQueryServiceServer) testEmbeddedByValue() {}
 
 // UnsafeNotificationQueryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -144,6 +207,53 @@ func _NotificationQueryService_BatchQueryNotifications_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationQueryService_SearchNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationQueryServiceServer).SearchNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationQueryService_SearchNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationQueryServiceServer).SearchNotifications(ctx, req.(*SearchNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationQueryService_GetNotificationTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationQueryServiceServer).GetNotificationTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationQueryService_GetNotificationTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationQueryServiceServer).GetNotificationTimeline(ctx, req.(*GetNotificationTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationQueryService_WatchNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationQueryServiceServer).WatchNotifications(m, &grpc.GenericServerStream[WatchNotificationsRequest, NotificationStatusEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotificationQueryService_WatchNotificationsServer = grpc.ServerStreamingServer[NotificationStatusEvent]
+
 // NotificationQueryService_ServiceDesc is the grpc.ServiceDesc for NotificationQueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -159,7 +269,21 @@ var NotificationQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchQueryNotifications",
 			Handler:    _NotificationQueryService_BatchQueryNotifications_Handler,
 		},
+		{
+			MethodName: "SearchNotifications",
+			Handler:    _NotificationQueryService_SearchNotifications_Handler,
+		},
+		{
+			MethodName: "GetNotificationTimeline",
+			Handler:    _NotificationQueryService_GetNotificationTimeline_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchNotifications",
+			Handler:       _NotificationQueryService_WatchNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "notification/v1/notification_query.proto",
 }
@@ -0,0 +1,308 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: notification/v1/push_token.proto
+
+package notificationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 被判定为无效或已注销的设备令牌
+type InvalidPushToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 记录ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 设备令牌
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// 判定无效的供应商
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 厂商返回的原因
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 首次标记时间，毫秒时间戳
+	Ctime int64 `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	// 最近一次标记时间，毫秒时间戳
+	Utime         int64 `protobuf:"varint,6,opt,name=utime,proto3" json:"utime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidPushToken) Reset() {
+	*x = InvalidPushToken{}
+	mi := &file_notification_v1_push_token_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidPushToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidPushToken) ProtoMessage() {}
+
+func (x *InvalidPushToken) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_push_token_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidPushToken.ProtoReflect.Descriptor instead.
+func (*InvalidPushToken) Descriptor() ([]byte, []int) {
+	return file_notification_v1_push_token_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InvalidPushToken) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InvalidPushToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *InvalidPushToken) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *InvalidPushToken) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InvalidPushToken) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *InvalidPushToken) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+// 无效令牌分页查询请求
+type ListInvalidPushTokensRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 游标，即上一页最后一条记录的ID，首页传0
+	Cursor int64 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 每页条数
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvalidPushTokensRequest) Reset() {
+	*x = ListInvalidPushTokensRequest{}
+	mi := &file_notification_v1_push_token_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvalidPushTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvalidPushTokensRequest) ProtoMessage() {}
+
+func (x *ListInvalidPushTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_push_token_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvalidPushTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListInvalidPushTokensRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_push_token_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListInvalidPushTokensRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListInvalidPushTokensRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 无效令牌分页查询响应
+type ListInvalidPushTokensResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tokens []*InvalidPushToken    `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// 下一页游标
+	NextCursor int64 `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// 是否还有更多数据
+	HasMore       bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvalidPushTokensResponse) Reset() {
+	*x = ListInvalidPushTokensResponse{}
+	mi := &file_notification_v1_push_token_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvalidPushTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvalidPushTokensResponse) ProtoMessage() {}
+
+func (x *ListInvalidPushTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_push_token_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvalidPushTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListInvalidPushTokensResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_push_token_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListInvalidPushTokensResponse) GetTokens() []*InvalidPushToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *ListInvalidPushTokensResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *ListInvalidPushTokensResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+var File_notification_v1_push_token_proto protoreflect.FileDescriptor
+
+const file_notification_v1_push_token_proto_rawDesc = "" +
+	"\n" +
+	" notification/v1/push_token.proto\x12\x0fnotification.v1\"\x98\x01\n" +
+	"\x10InvalidPushToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05ctime\x18\x05 \x01(\x03R\x05ctime\x12\x14\n" +
+	"\x05utime\x18\x06 \x01(\x03R\x05utime\"L\n" +
+	"\x1cListInvalidPushTokensRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x96\x01\n" +
+	"\x1dListInvalidPushTokensResponse\x129\n" +
+	"\x06tokens\x18\x01 \x03(\v2!.notification.v1.InvalidPushTokenR\x06tokens\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore2\x8a\x01\n" +
+	"\x10PushTokenService\x12v\n" +
+	"\x15ListInvalidPushTokens\x12-.notification.v1.ListInvalidPushTokensRequest\x1a..notification.v1.ListInvalidPushTokensResponseB\xc0\x01\n" +
+	"\x13com.notification.v1B\x0ePushTokenProtoP\x01Z<go-notification/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
+
+var (
+	file_notification_v1_push_token_proto_rawDescOnce sync.Once
+	file_notification_v1_push_token_proto_rawDescData []byte
+)
+
+func file_notification_v1_push_token_proto_rawDescGZIP() []byte {
+	file_notification_v1_push_token_proto_rawDescOnce.Do(func() {
+		file_notification_v1_push_token_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notification_v1_push_token_proto_rawDesc), len(file_notification_v1_push_token_proto_rawDesc)))
+	})
+	return file_notification_v1_push_token_proto_rawDescData
+}
+
+var file_notification_v1_push_token_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_notification_v1_push_token_proto_goTypes = []any{
+	(*InvalidPushToken)(nil),              // 0: notification.v1.InvalidPushToken
+	(*ListInvalidPushTokensRequest)(nil),  // 1: notification.v1.ListInvalidPushTokensRequest
+	(*ListInvalidPushTokensResponse)(nil), // 2: notification.v1.ListInvalidPushTokensResponse
+}
+var file_notification_v1_push_token_proto_depIdxs = []int32{
+	0, // 0: notification.v1.ListInvalidPushTokensResponse.tokens:type_name -> notification.v1.InvalidPushToken
+	1, // 1: notification.v1.PushTokenService.ListInvalidPushTokens:input_type -> notification.v1.ListInvalidPushTokensRequest
+	2, // 2: notification.v1.PushTokenService.ListInvalidPushTokens:output_type -> notification.v1.ListInvalidPushTokensResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_notification_v1_push_token_proto_init() }
+func file_notification_v1_push_token_proto_init() {
+	if File_notification_v1_push_token_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_push_token_proto_rawDesc), len(file_notification_v1_push_token_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notification_v1_push_token_proto_goTypes,
+		DependencyIndexes: file_notification_v1_push_token_proto_depIdxs,
+		MessageInfos:      file_notification_v1_push_token_proto_msgTypes,
+	}.Build()
+	File_notification_v1_push_token_proto = out.File
+	file_notification_v1_push_token_proto_goTypes = nil
+	file_notification_v1_push_token_proto_depIdxs = nil
+}
@@ -0,0 +1,396 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification/v1/push_token.proto
+
+package notificationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on InvalidPushToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InvalidPushToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvalidPushToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvalidPushTokenMultiError, or nil if none found.
+func (m *InvalidPushToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvalidPushToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Token
+
+	// no validation rules for Provider
+
+	// no validation rules for Reason
+
+	// no validation rules for Ctime
+
+	// no validation rules for Utime
+
+	if len(errors) > 0 {
+		return InvalidPushTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvalidPushTokenMultiError is an error wrapping multiple validation errors
+// returned by InvalidPushToken.ValidateAll() if the designated constraints
+// aren't met.
+type InvalidPushTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvalidPushTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvalidPushTokenMultiError) AllErrors() []error { return m }
+
+// InvalidPushTokenValidationError is the validation error returned by
+// InvalidPushToken.Validate if the designated constraints aren't met.
+type InvalidPushTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvalidPushTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvalidPushTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvalidPushTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvalidPushTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvalidPushTokenValidationError) ErrorName() string { return "InvalidPushTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvalidPushTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvalidPushToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvalidPushTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvalidPushTokenValidationError{}
+
+// Validate checks the field values on ListInvalidPushTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInvalidPushTokensRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvalidPushTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInvalidPushTokensRequestMultiError, or nil if none found.
+func (m *ListInvalidPushTokensRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvalidPushTokensRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListInvalidPushTokensRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvalidPushTokensRequestMultiError is an error wrapping multiple
+// validation errors returned by ListInvalidPushTokensRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListInvalidPushTokensRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvalidPushTokensRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvalidPushTokensRequestMultiError) AllErrors() []error { return m }
+
+// ListInvalidPushTokensRequestValidationError is the validation error returned
+// by ListInvalidPushTokensRequest.Validate if the designated constraints
+// aren't met.
+type ListInvalidPushTokensRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvalidPushTokensRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvalidPushTokensRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvalidPushTokensRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvalidPushTokensRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvalidPushTokensRequestValidationError) ErrorName() string {
+	return "ListInvalidPushTokensRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvalidPushTokensRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvalidPushTokensRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvalidPushTokensRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvalidPushTokensRequestValidationError{}
+
+// Validate checks the field values on ListInvalidPushTokensResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInvalidPushTokensResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvalidPushTokensResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListInvalidPushTokensResponseMultiError, or nil if none found.
+func (m *ListInvalidPushTokensResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvalidPushTokensResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInvalidPushTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInvalidPushTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInvalidPushTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	// no validation rules for HasMore
+
+	if len(errors) > 0 {
+		return ListInvalidPushTokensResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvalidPushTokensResponseMultiError is an error wrapping multiple
+// validation errors returned by ListInvalidPushTokensResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListInvalidPushTokensResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvalidPushTokensResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvalidPushTokensResponseMultiError) AllErrors() []error { return m }
+
+// ListInvalidPushTokensResponseValidationError is the validation error
+// returned by ListInvalidPushTokensResponse.Validate if the designated
+// constraints aren't met.
+type ListInvalidPushTokensResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvalidPushTokensResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvalidPushTokensResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvalidPushTokensResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvalidPushTokensResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvalidPushTokensResponseValidationError) ErrorName() string {
+	return "ListInvalidPushTokensResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvalidPushTokensResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvalidPushTokensResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvalidPushTokensResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvalidPushTokensResponseValidationError{}
@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: notification/v1/push_token.proto
+
+package notificationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PushTokenService_ListInvalidPushTokens_FullMethodName = "/notification.v1.PushTokenService/ListInvalidPushTokens"
+)
+
+// PushTokenServiceClient is the client API for PushTokenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 推送设备令牌服务，查询推送时被厂商判定为无效的令牌，业务方据此清理，所有操作都限定在 JWT 中的 biz_id 之下
+type PushTokenServiceClient interface {
+	// 按标记顺序分页查询无效令牌
+	ListInvalidPushTokens(ctx context.Context, in *ListInvalidPushTokensRequest, opts ...grpc.CallOption) (*ListInvalidPushTokensResponse, error)
+}
+
+type pushTokenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPushTokenServiceClient(cc grpc.ClientConnInterface) PushTokenServiceClient {
+	return &pushTokenServiceClient{cc}
+}
+
+func (c *pushTokenServiceClient) ListInvalidPushTokens(ctx context.Context, in *ListInvalidPushTokensRequest, opts ...grpc.CallOption) (*ListInvalidPushTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvalidPushTokensResponse)
+	err := c.cc.Invoke(ctx, PushTokenService_ListInvalidPushTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PushTokenServiceServer is the server API for PushTokenService service.
+// All implementations should embed UnimplementedPushTokenServiceServer
+// for forward compatibility.
+//
+// 推送设备令牌服务，查询推送时被厂商判定为无效的令牌，业务方据此清理，所有操作都限定在 JWT 中的 biz_id 之下
+type PushTokenServiceServer interface {
+	// 按标记顺序分页查询无效令牌
+	ListInvalidPushTokens(context.Context, *ListInvalidPushTokensRequest) (*ListInvalidPushTokensResponse, error)
+}
+
+// UnimplementedPushTokenServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPushTokenServiceServer struct{}
+
+func (UnimplementedPushTokenServiceServer) ListInvalidPushTokens(context.Context, *ListInvalidPushTokensRequest) (*ListInvalidPushTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvalidPushTokens not implemented")
+}
+func (UnimplementedPushTokenServiceServer) testEmbeddedByValue() {}
+
+// UnsafePushTokenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PushTokenServiceServer will
+// result in compilation errors.
+type UnsafePushTokenServiceServer interface {
+	mustEmbedUnimplementedPushTokenServiceServer()
+}
+
+func RegisterPushTokenServiceServer(s grpc.ServiceRegistrar, srv PushTokenServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPushTokenServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PushTokenService_ServiceDesc, srv)
+}
+
+func _PushTokenService_ListInvalidPushTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvalidPushTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushTokenServiceServer).ListInvalidPushTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushTokenService_ListInvalidPushTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushTokenServiceServer).ListInvalidPushTokens(ctx, req.(*ListInvalidPushTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PushTokenService_ServiceDesc is the grpc.ServiceDesc for PushTokenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PushTokenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notification.v1.PushTokenService",
+	HandlerType: (*PushTokenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInvalidPushTokens",
+			Handler:    _PushTokenService_ListInvalidPushTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "notification/v1/push_token.proto",
+}
@@ -0,0 +1,243 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	notificationv1 "go-notification/api/proto/gen/notification/v1"
+	"go-notification/internal/api/grpc/interceptor/jwt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	campaignsvc "go-notification/internal/service/campaign"
+	templatesvc "go-notification/internal/service/template/manage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"sort"
+)
+
+// CampaignServer 活动gRPC服务，所有操作限定在JWT中的biz_id之下
+type CampaignServer struct {
+	notificationv1.UnimplementedCampaignServiceServer
+
+	campaignSvc campaignsvc.Service
+	templateSvc templatesvc.ChannelTemplateService
+}
+
+func NewCampaignServer(campaignSvc campaignsvc.Service, templateSvc templatesvc.ChannelTemplateService) *CampaignServer {
+	return &CampaignServer{campaignSvc: campaignSvc, templateSvc: templateSvc}
+}
+
+// CreateCampaign 创建草稿状态的活动
+func (s *CampaignServer) CreateCampaign(ctx context.Context, request *notificationv1.CreateCampaignRequest) (*notificationv1.CreateCampaignResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	notification, err := buildNotification(ctx, s.templateSvc, request.GetNotification(), bizID)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	campaign, err := s.campaignSvc.Create(ctx, domain.Campaign{
+		BizID:        bizID,
+		Key:          notification.Key,
+		Name:         request.GetName(),
+		Notification: notification,
+		SendRate:     request.GetSendRate(),
+	})
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &notificationv1.CreateCampaignResponse{Campaign: s.toProto(campaign)}, nil
+}
+
+// UploadCampaignReceivers 解析CSV文件并追加接收者
+func (s *CampaignServer) UploadCampaignReceivers(ctx context.Context, request *notificationv1.UploadCampaignReceiversRequest) (*notificationv1.UploadCampaignReceiversResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	receivers, err := domain.ParseCampaignReceiversCSV(bytes.NewReader(request.GetCsv()))
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	campaign, err := s.campaignSvc.AddReceivers(ctx, bizID, request.GetKey(), receivers)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &notificationv1.UploadCampaignReceiversResponse{Campaign: s.toProto(campaign)}, nil
+}
+
+// StreamCampaignReceivers 每收到一条消息就追加其中的接收者，客户端关闭发送后返回活动
+func (s *CampaignServer) StreamCampaignReceivers(stream grpc.ClientStreamingServer[notificationv1.StreamCampaignReceiversRequest, notificationv1.StreamCampaignReceiversResponse]) error {
+	ctx := stream.Context()
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var campaign domain.Campaign
+	for {
+		request, err1 := stream.Recv()
+		if errors.Is(err1, io.EOF) {
+			break
+		}
+		if err1 != nil {
+			return err1
+		}
+		receivers := make([]domain.CampaignReceiver, 0, len(request.GetReceivers()))
+		for _, r := range request.GetReceivers() {
+			receivers = append(receivers, domain.CampaignReceiver{
+				Receiver: r.GetReceiver(),
+				Params:   r.GetParams(),
+			})
+		}
+		campaign, err = s.campaignSvc.AddReceivers(ctx, bizID, request.GetKey(), receivers)
+		if err != nil {
+			return s.toGRPCError(err)
+		}
+	}
+	if campaign.ID == 0 {
+		return status.Errorf(codes.InvalidArgument, "%v", fmt.Errorf("%w: 没有上传接收者", errs.ErrInvalidParameter))
+	}
+	return stream.SendAndClose(&notificationv1.StreamCampaignReceiversResponse{Campaign: s.toProto(campaign)})
+}
+
+// StartCampaign 开始发送
+func (s *CampaignServer) StartCampaign(ctx context.Context, request *notificationv1.StartCampaignRequest) (*notificationv1.StartCampaignResponse, error) {
+	campaign, err := s.operate(ctx, request.GetKey(), s.campaignSvc.Start)
+	if err != nil {
+		return nil, err
+	}
+	return &notificationv1.StartCampaignResponse{Campaign: campaign}, nil
+}
+
+// PauseCampaign 暂停发送
+func (s *CampaignServer) PauseCampaign(ctx context.Context, request *notificationv1.PauseCampaignRequest) (*notificationv1.PauseCampaignResponse, error) {
+	campaign, err := s.operate(ctx, request.GetKey(), s.campaignSvc.Pause)
+	if err != nil {
+		return nil, err
+	}
+	return &notificationv1.PauseCampaignResponse{Campaign: campaign}, nil
+}
+
+// ResumeCampaign 恢复发送
+func (s *CampaignServer) ResumeCampaign(ctx context.Context, request *notificationv1.ResumeCampaignRequest) (*notificationv1.ResumeCampaignResponse, error) {
+	campaign, err := s.operate(ctx, request.GetKey(), s.campaignSvc.Resume)
+	if err != nil {
+		return nil, err
+	}
+	return &notificationv1.ResumeCampaignResponse{Campaign: campaign}, nil
+}
+
+// AbortCampaign 终止发送
+func (s *CampaignServer) AbortCampaign(ctx context.Context, request *notificationv1.AbortCampaignRequest) (*notificationv1.AbortCampaignResponse, error) {
+	campaign, err := s.operate(ctx, request.GetKey(), s.campaignSvc.Abort)
+	if err != nil {
+		return nil, err
+	}
+	return &notificationv1.AbortCampaignResponse{Campaign: campaign}, nil
+}
+
+// GetCampaign 查询进度和按发送状态统计的通知数量
+func (s *CampaignServer) GetCampaign(ctx context.Context, request *notificationv1.GetCampaignRequest) (*notificationv1.GetCampaignResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	report, err := s.campaignSvc.GetReport(ctx, bizID, request.GetKey())
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+
+	// 多个内部状态对应同一个对外的状态，例如待发送和发送中
+	counts := make(map[notificationv1.SendStatus]int64, len(report.StatusCounts))
+	for st, cnt := range report.StatusCounts {
+		counts[convertToGRPCSendStatus(st)] += cnt
+	}
+	statusCounts := make([]*notificationv1.CampaignStatusCount, 0, len(counts))
+	for st, cnt := range counts {
+		statusCounts = append(statusCounts, &notificationv1.CampaignStatusCount{Status: st, Count: cnt})
+	}
+	sort.Slice(statusCounts, func(i, j int) bool {
+		return statusCounts[i].Status < statusCounts[j].Status
+	})
+	return &notificationv1.GetCampaignResponse{
+		Campaign:     s.toProto(report.Campaign),
+		StatusCounts: statusCounts,
+	}, nil
+}
+
+func (s *CampaignServer) operate(ctx context.Context, key string,
+	op func(ctx context.Context, bizID int64, key string) (domain.Campaign, error),
+) (*notificationv1.Campaign, error) {
+	if key == "" {
+		return nil, status.Error(codes.InvalidArgument, "请求参数无效：key不能为空")
+	}
+
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	campaign, err := op(ctx, bizID, key)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return s.toProto(campaign), nil
+}
+
+func (s *CampaignServer) toGRPCError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter), errors.Is(err, errs.ErrBatchSizeOverLimit):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, errs.ErrCampaignNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, errs.ErrNotificationDuplicate):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, errs.ErrInvalidOperation):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func (s *CampaignServer) toProtoStatus(st domain.CampaignStatus) notificationv1.CampaignStatus {
+	switch st {
+	case domain.CampaignStatusDraft:
+		return notificationv1.CampaignStatus_CAMPAIGN_DRAFT
+	case domain.CampaignStatusRunning:
+		return notificationv1.CampaignStatus_CAMPAIGN_RUNNING
+	case domain.CampaignStatusPaused:
+		return notificationv1.CampaignStatus_CAMPAIGN_PAUSED
+	case domain.CampaignStatusAborted:
+		return notificationv1.CampaignStatus_CAMPAIGN_ABORTED
+	case domain.CampaignStatusCompleted:
+		return notificationv1.CampaignStatus_CAMPAIGN_COMPLETED
+	default:
+		return notificationv1.CampaignStatus_CAMPAIGN_STATUS_UNSPECIFIED
+	}
+}
+
+func (s *CampaignServer) toProto(c domain.Campaign) *notificationv1.Campaign {
+	return &notificationv1.Campaign{
+		Key:        c.Key,
+		Name:       c.Name,
+		Status:     s.toProtoStatus(c.Status),
+		SendRate:   c.SendRate,
+		Total:      c.Total,
+		Dispatched: c.Dispatched,
+		Failed:     c.Failed,
+		Ctime:      c.Ctime,
+		Utime:      c.Utime,
+	}
+}
+
+func (s *CampaignServer) Register(server *grpc.Server) {
+	notificationv1.RegisterCampaignServiceServer(server, s)
+}
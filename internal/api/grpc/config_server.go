@@ -69,6 +69,7 @@ func protoToDomainBusinessConfig(protoConfig *configv1.BusinessConfig) domain.Bu
 	if protoConfig.ChannelConfig != nil {
 		channelConfig := &domain.ChannelConfig{
 			Channels: make([]domain.ChannelItem, 0, len(protoConfig.ChannelConfig.Channels)),
+			Fallback: protoConfig.ChannelConfig.Fallback,
 		}
 
 		// Convert each channel item
@@ -128,6 +129,57 @@ func protoToDomainBusinessConfig(protoConfig *configv1.BusinessConfig) domain.Bu
 		domainConfig.CallbackConfig = callbackConfig
 	}
 
+	// Convert WebhookConfig if exists
+	if protoConfig.WebhookConfig != nil {
+		domainConfig.WebhookConfig = &domain.WebhookConfig{
+			Secret:  protoConfig.WebhookConfig.Secret,
+			Timeout: protoConfig.WebhookConfig.TimeoutMs,
+		}
+	}
+
+	// Convert QuietHours if exists
+	if protoConfig.QuietHours != nil {
+		quietHours := &domain.QuietHoursConfig{
+			Rules: make([]domain.QuietHoursRule, 0, len(protoConfig.QuietHours.Rules)),
+		}
+		for _, rule := range protoConfig.QuietHours.Rules {
+			domainRule := domain.QuietHoursRule{
+				Start:    rule.Start,
+				End:      rule.End,
+				Timezone: rule.Timezone,
+			}
+			for _, channel := range rule.Channels {
+				domainRule.Channels = append(domainRule.Channels, domain.Channel(channel))
+			}
+			for _, businessType := range rule.BusinessTypes {
+				domainRule.BusinessTypes = append(domainRule.BusinessTypes, domain.BusinessType(businessType))
+			}
+			quietHours.Rules = append(quietHours.Rules, domainRule)
+		}
+		domainConfig.QuietHours = quietHours
+	}
+
+	// Convert FrequencyCap if exists
+	if protoConfig.FrequencyCap != nil {
+		frequencyCap := &domain.FrequencyCapConfig{
+			Rules: make([]domain.FrequencyCapRule, 0, len(protoConfig.FrequencyCap.Rules)),
+		}
+		for _, rule := range protoConfig.FrequencyCap.Rules {
+			domainRule := domain.FrequencyCapRule{
+				Window: rule.WindowSeconds,
+				Max:    rule.Max,
+			}
+			for _, channel := range rule.Channels {
+				domainRule.Channels = append(domainRule.Channels, domain.Channel(channel))
+			}
+			for _, businessType := range rule.BusinessTypes {
+				domainRule.BusinessTypes = append(domainRule.BusinessTypes, domain.BusinessType(businessType))
+			}
+			frequencyCap.Rules = append(frequencyCap.Rules, domainRule)
+		}
+		domainConfig.FrequencyCap = frequencyCap
+	}
+
 	return domainConfig
 }
 
@@ -0,0 +1,173 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	notificationv1 "go-notification/api/proto/gen/notification/v1"
+	"go-notification/internal/api/grpc/interceptor/jwt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/deadletter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+// DeadLetterServer 死信gRPC服务，所有操作限定在JWT中的biz_id之下
+type DeadLetterServer struct {
+	notificationv1.UnimplementedDeadLetterServiceServer
+
+	deadLetterSvc deadletter.Service
+}
+
+func NewDeadLetterServer(deadLetterSvc deadletter.Service) *DeadLetterServer {
+	return &DeadLetterServer{deadLetterSvc: deadLetterSvc}
+}
+
+// ListDeadLetters 按渠道和时间分页查询死信
+func (s *DeadLetterServer) ListDeadLetters(ctx context.Context, request *notificationv1.ListDeadLettersRequest) (*notificationv1.ListDeadLettersResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	query := domain.DeadLetterQuery{
+		BizID:     bizID,
+		StartTime: request.GetStartTime(),
+		EndTime:   request.GetEndTime(),
+		Status:    s.toDomainStatus(request.GetStatus()),
+		Cursor:    request.GetCursor(),
+		Limit:     int(request.GetLimit()),
+	}
+	if request.GetChannel() != notificationv1.Channel_CHANNEL_UNSPECIFIED {
+		query.Channel, err = domain.ChannelFromAPI(request.GetChannel())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+
+	res, err := s.deadLetterSvc.List(ctx, query)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+
+	deadLetters := make([]*notificationv1.DeadLetter, 0, len(res.DeadLetters))
+	for i := range res.DeadLetters {
+		deadLetters = append(deadLetters, s.toProto(res.DeadLetters[i]))
+	}
+	return &notificationv1.ListDeadLettersResponse{
+		DeadLetters: deadLetters,
+		NextCursor:  res.NextCursor,
+		HasMore:     res.HasMore,
+	}, nil
+}
+
+// ReplayDeadLetters 重放死信，每条死信单独返回结果
+func (s *DeadLetterServer) ReplayDeadLetters(ctx context.Context, request *notificationv1.ReplayDeadLettersRequest) (*notificationv1.ReplayDeadLettersResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var opts domain.ReplayOptions
+	if request.GetChannel() != notificationv1.Channel_CHANNEL_UNSPECIFIED {
+		opts.Channel, err = domain.ChannelFromAPI(request.GetChannel())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+	if request.GetTemplateVersionId() != "" {
+		opts.TemplateVersionID, err = strconv.ParseInt(request.GetTemplateVersionId(), 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", fmt.Errorf("%w: 模板版本ID: %s", errs.ErrInvalidParameter, request.GetTemplateVersionId()))
+		}
+	}
+
+	results, err := s.deadLetterSvc.Replay(ctx, bizID, request.GetIds(), opts)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+
+	resp := &notificationv1.ReplayDeadLettersResponse{
+		Results: make([]*notificationv1.ReplayDeadLetterResult, 0, len(results)),
+	}
+	for _, r := range results {
+		result := &notificationv1.ReplayDeadLetterResult{
+			Id:             r.ID,
+			NotificationId: r.NotificationID,
+			Success:        r.Err == nil,
+		}
+		if r.Err != nil {
+			result.ErrorCode = convertToGRPCErrorCode(r.Err)
+			result.ErrorMessage = r.Err.Error()
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+func (s *DeadLetterServer) toGRPCError(err error) error {
+	if errors.Is(err, errs.ErrInvalidParameter) || errors.Is(err, errs.ErrBatchSizeOverLimit) {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
+
+func (s *DeadLetterServer) toDomainStatus(st notificationv1.DeadLetterStatus) domain.DeadLetterStatus {
+	switch st {
+	case notificationv1.DeadLetterStatus_DEAD_LETTER_PENDING:
+		return domain.DeadLetterStatusPending
+	case notificationv1.DeadLetterStatus_DEAD_LETTER_REPLAYED:
+		return domain.DeadLetterStatusReplayed
+	default:
+		return ""
+	}
+}
+
+func (s *DeadLetterServer) toProtoStatus(st domain.DeadLetterStatus) notificationv1.DeadLetterStatus {
+	switch st {
+	case domain.DeadLetterStatusPending:
+		return notificationv1.DeadLetterStatus_DEAD_LETTER_PENDING
+	case domain.DeadLetterStatusReplayed:
+		return notificationv1.DeadLetterStatus_DEAD_LETTER_REPLAYED
+	default:
+		return notificationv1.DeadLetterStatus_DEAD_LETTER_STATUS_UNSPECIFIED
+	}
+}
+
+func (s *DeadLetterServer) toProto(dl domain.DeadLetter) *notificationv1.DeadLetter {
+	attempts := make([]*notificationv1.DeadLetterAttempt, 0, len(dl.Attempts))
+	for _, a := range dl.Attempts {
+		attempts = append(attempts, &notificationv1.DeadLetterAttempt{
+			Receiver: a.Receiver,
+			Provider: a.Provider,
+			Status:   convertToGRPCDeliveryStatus(a.Status),
+			Attempts: int32(a.Attempts),
+			ErrCode:  a.ErrCode,
+			ErrMsg:   a.ErrMsg,
+		})
+	}
+	return &notificationv1.DeadLetter{
+		Id:                dl.ID,
+		NotificationId:    dl.NotificationID,
+		Key:               dl.Key,
+		Channel:           convertToGRPCChannel(dl.Channel),
+		TemplateId:        strconv.FormatInt(dl.TemplateID, 10),
+		TemplateVersionId: strconv.FormatInt(dl.TemplateVersionID, 10),
+		Provider:          dl.Provider,
+		ErrCategory:       dl.ErrCategory.String(),
+		ErrMsg:            dl.ErrMsg,
+		RetryCount:        dl.RetryCount,
+		Attempts:          attempts,
+		Status:            s.toProtoStatus(dl.Status),
+		ReplayCount:       dl.ReplayCount,
+		Ctime:             dl.Ctime,
+		Utime:             dl.Utime,
+	}
+}
+
+func (s *DeadLetterServer) Register(server *grpc.Server) {
+	notificationv1.RegisterDeadLetterServiceServer(server, s)
+}
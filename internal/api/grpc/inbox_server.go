@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	notificationv1 "go-notification/api/proto/gen/notification/v1"
+	"go-notification/internal/api/grpc/interceptor/jwt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/inbox"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InboxServer 站内信收件箱gRPC服务，所有操作限定在JWT中的biz_id之下
+type InboxServer struct {
+	notificationv1.UnimplementedInboxServiceServer
+
+	inboxSvc inbox.Service
+}
+
+func NewInboxServer(inboxSvc inbox.Service) *InboxServer {
+	return &InboxServer{inboxSvc: inboxSvc}
+}
+
+// ListInboxMessages 按游标分页查询收件箱
+func (s *InboxServer) ListInboxMessages(ctx context.Context, request *notificationv1.ListInboxMessagesRequest) (*notificationv1.ListInboxMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	res, err := s.inboxSvc.List(ctx, domain.InboxQuery{
+		BizID:      bizID,
+		Receiver:   request.GetReceiver(),
+		Cursor:     request.GetCursor(),
+		Limit:      int(request.GetLimit()),
+		UnreadOnly: request.GetUnreadOnly(),
+	})
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+
+	messages := make([]*notificationv1.InboxMessage, 0, len(res.Messages))
+	for i := range res.Messages {
+		messages = append(messages, s.toProto(res.Messages[i]))
+	}
+	return &notificationv1.ListInboxMessagesResponse{
+		Messages:   messages,
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
+
+// GetUnreadCount 查询未读数
+func (s *InboxServer) GetUnreadCount(ctx context.Context, request *notificationv1.GetUnreadCountRequest) (*notificationv1.GetUnreadCountResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	cnt, err := s.inboxSvc.UnreadCount(ctx, bizID, request.GetReceiver())
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &notificationv1.GetUnreadCountResponse{Count: cnt}, nil
+}
+
+// MarkRead 标记为已读
+func (s *InboxServer) MarkRead(ctx context.Context, request *notificationv1.MarkReadRequest) (*notificationv1.MarkReadResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	affected, err := s.inboxSvc.MarkRead(ctx, bizID, request.GetReceiver(), request.GetIds())
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &notificationv1.MarkReadResponse{Affected: affected}, nil
+}
+
+// MarkUnread 标记为未读
+func (s *InboxServer) MarkUnread(ctx context.Context, request *notificationv1.MarkUnreadRequest) (*notificationv1.MarkUnreadResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	affected, err := s.inboxSvc.MarkUnread(ctx, bizID, request.GetReceiver(), request.GetIds())
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &notificationv1.MarkUnreadResponse{Affected: affected}, nil
+}
+
+// MarkAllRead 全部标记为已读
+func (s *InboxServer) MarkAllRead(ctx context.Context, request *notificationv1.MarkAllReadRequest) (*notificationv1.MarkAllReadResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	affected, err := s.inboxSvc.MarkAllRead(ctx, bizID, request.GetReceiver())
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &notificationv1.MarkAllReadResponse{Affected: affected}, nil
+}
+
+// DeleteInboxMessages 删除消息
+func (s *InboxServer) DeleteInboxMessages(ctx context.Context, request *notificationv1.DeleteInboxMessagesRequest) (*notificationv1.DeleteInboxMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	affected, err := s.inboxSvc.Delete(ctx, bizID, request.GetReceiver(), request.GetIds())
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &notificationv1.DeleteInboxMessagesResponse{Affected: affected}, nil
+}
+
+func (s *InboxServer) toGRPCError(err error) error {
+	if errors.Is(err, errs.ErrInvalidParameter) || errors.Is(err, errs.ErrBatchSizeOverLimit) {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
+
+func (s *InboxServer) toProto(message domain.InboxMessage) *notificationv1.InboxMessage {
+	return &notificationv1.InboxMessage{
+		Id:             message.ID,
+		NotificationId: message.NotificationID,
+		Receiver:       message.Receiver,
+		Title:          message.Title,
+		Content:        message.Content,
+		Read:           message.Read,
+		ReadTime:       message.ReadTime,
+		Ctime:          message.Ctime,
+	}
+}
+
+func (s *InboxServer) Register(server *grpc.Server) {
+	notificationv1.RegisterInboxServiceServer(server, s)
+}
@@ -90,13 +90,11 @@ func (b *InterceptorBuilder) JwtAuthInterceptor() grpc.UnaryServerInterceptor {
 			}
 			return nil, status.Error(codes.Unauthenticated, "invalid token"+err.Error())
 		}
-		v, ok := val[BizIDName]
-		if !ok {
-			bizId := v.(int64)
+		if bizId, er := BizIDFromClaims(val); er == nil {
 			ctx = context.WithValue(ctx, BizIDName, bizId)
 		}
 
-		v, ok = val["Priority"]
+		v, ok := val["Priority"]
 		if ok {
 			ctx = context.WithValue(ctx, "Priority", v)
 		}
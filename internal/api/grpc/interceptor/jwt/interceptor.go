@@ -2,6 +2,8 @@ package jwt
 
 import (
 	"context"
+	"encoding/json"
+	"github.com/golang-jwt/jwt/v4"
 	"go-notification/internal/errs"
 )
 
@@ -16,3 +18,17 @@ func GetBizIDFromContext(ctx context.Context) (int64, error) {
 	}
 	return v, nil
 }
+
+// BizIDFromClaims 从令牌声明中解析业务ID，JSON解码后数字可能是float64或json.Number
+func BizIDFromClaims(claims jwt.MapClaims) (int64, error) {
+	switch v := claims[BizIDName].(type) {
+	case int64:
+		return v, nil
+	case float64:
+		return int64(v), nil
+	case json.Number:
+		return v.Int64()
+	default:
+		return 0, errs.ErrBizIDNotFound
+	}
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	notificationv1 "go-notification/api/proto/gen/notification/v1"
+	"go-notification/internal/api/grpc/interceptor/jwt"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/pushtoken"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PushTokenServer 推送设备令牌gRPC服务，所有操作限定在JWT中的biz_id之下
+type PushTokenServer struct {
+	notificationv1.UnimplementedPushTokenServiceServer
+
+	pushTokenSvc pushtoken.Service
+}
+
+func NewPushTokenServer(pushTokenSvc pushtoken.Service) *PushTokenServer {
+	return &PushTokenServer{pushTokenSvc: pushTokenSvc}
+}
+
+// ListInvalidPushTokens 分页查询被厂商判定为无效的设备令牌
+func (s *PushTokenServer) ListInvalidPushTokens(ctx context.Context, request *notificationv1.ListInvalidPushTokensRequest) (*notificationv1.ListInvalidPushTokensResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	res, err := s.pushTokenSvc.ListInvalid(ctx, bizID, request.GetCursor(), int(request.GetLimit()))
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidParameter) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	tokens := make([]*notificationv1.InvalidPushToken, 0, len(res.Tokens))
+	for _, t := range res.Tokens {
+		tokens = append(tokens, &notificationv1.InvalidPushToken{
+			Id:       t.ID,
+			Token:    t.Token,
+			Provider: t.Provider,
+			Reason:   t.Reason,
+			Ctime:    t.Ctime,
+			Utime:    t.Utime,
+		})
+	}
+	return &notificationv1.ListInvalidPushTokensResponse{
+		Tokens:     tokens,
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
+
+func (s *PushTokenServer) Register(server *grpc.Server) {
+	notificationv1.RegisterPushTokenServiceServer(server, s)
+}
@@ -8,15 +8,25 @@ import (
 	"go-notification/internal/api/grpc/interceptor/jwt"
 	"go-notification/internal/domain"
 	"go-notification/internal/errs"
+	deliverysvc "go-notification/internal/service/delivery"
 	notificationSvc "go-notification/internal/service/notification"
+	seriessvc "go-notification/internal/service/series"
 	templatesvc "go-notification/internal/service/template/manage"
+	watchsvc "go-notification/internal/service/watch"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"io"
+	"strconv"
+	"time"
 )
 
 const (
 	batchSizeLimit = 100
+	// streamChunkSize 流式发送每批写入的通知数量
+	streamChunkSize = batchSizeLimit
+	// streamFlushInterval 不足一批时最多等待的时长，客户端等到确认才继续发送时不会卡住
+	streamFlushInterval = 100 * time.Millisecond
 )
 
 // NotificationServer 通知平台gRPC服务器处理gRPC请求
@@ -28,10 +38,13 @@ type NotificationServer struct {
 	sendSvc         notificationSvc.SendService
 	txnSvc          notificationSvc.TxNotificationService
 	templateSvc     templatesvc.ChannelTemplateService
+	deliverySvc     deliverysvc.Service
+	watchSvc        watchsvc.Service
+	seriesSvc       seriessvc.Service
 }
 
-func NewNotificationServer(notificationSvc notificationSvc.Service, sendScc notificationSvc.SendService, txnSvc notificationSvc.TxNotificationService, templateSvc templatesvc.ChannelTemplateService) *NotificationServer {
-	return &NotificationServer{notificationSvc: notificationSvc, sendSvc: sendScc, txnSvc: txnSvc, templateSvc: templateSvc}
+func NewNotificationServer(notificationSvc notificationSvc.Service, sendScc notificationSvc.SendService, txnSvc notificationSvc.TxNotificationService, templateSvc templatesvc.ChannelTemplateService, deliverySvc deliverysvc.Service, watchSvc watchsvc.Service, seriesSvc seriessvc.Service) *NotificationServer {
+	return &NotificationServer{notificationSvc: notificationSvc, sendSvc: sendScc, txnSvc: txnSvc, templateSvc: templateSvc, deliverySvc: deliverySvc, watchSvc: watchSvc, seriesSvc: seriesSvc}
 }
 
 // SendNotification 处理同步发送请求
@@ -59,7 +72,7 @@ func (n NotificationServer) SendNotification(ctx context.Context, request *notif
 		if n.isSystemError(err) {
 			return nil, status.Errorf(codes.Internal, "系统错误: %v", err)
 		} else {
-			response.ErrorCode = n.convertToGRPCErrorCode(err)
+			response.ErrorCode = convertToGRPCErrorCode(err)
 			response.ErrorMessage = err.Error()
 			response.Status = notificationv1.SendStatus_FAILED
 			return response, nil
@@ -68,6 +81,12 @@ func (n NotificationServer) SendNotification(ctx context.Context, request *notif
 
 	response.NotificationId = result.NotificationID
 	response.Status = n.covertToGRPCSendStatus(result.Status)
+	response.DeliveredChannel = convertToGRPCChannel(result.DeliveredChannel)
+	response.Deliveries = n.convertToGRPCDeliveries(result.Deliveries)
+	if result.Status == domain.SendStatusFrequencyCapped {
+		response.ErrorCode = notificationv1.ErrorCode_FREQUENCY_CAP_EXCEEDED
+		response.ErrorMessage = errs.ErrFrequencyCapped.Error()
+	}
 	return response, nil
 }
 
@@ -97,7 +116,7 @@ func (n NotificationServer) SendNotificationAsync(ctx context.Context, request *
 			return nil, status.Errorf(codes.Internal, "%v", err)
 		} else {
 			// 业务错误通过ErrorCode返回
-			response.ErrorCode = n.convertToGRPCErrorCode(err)
+			response.ErrorCode = convertToGRPCErrorCode(err)
 			response.ErrorMessage = err.Error()
 			return response, nil
 		}
@@ -162,7 +181,7 @@ func (n NotificationServer) SendNotificationBatch(ctx context.Context, request *
 		} else {
 			for i := range results {
 				results[i] = &notificationv1.SendNotificationResponse{
-					ErrorCode:    n.convertToGRPCErrorCode(err),
+					ErrorCode:    convertToGRPCErrorCode(err),
 					ErrorMessage: err.Error(),
 					Status:       notificationv1.SendStatus_FAILED,
 				}
@@ -238,6 +257,144 @@ func (n NotificationServer) SendNotificationBatchAsync(ctx context.Context, requ
 	}, nil
 }
 
+// streamItem 流式发送中等待写入的一条通知
+type streamItem struct {
+	seq          int64
+	notification domain.Notification
+}
+
+// StreamSendNotifications 流式异步发送，攒够一批或者等待超过 streamFlushInterval 后写入
+// 接收通知的协程把通知交给处理协程后才接收下一条，写入时不再接收，由 gRPC 流控反压客户端
+func (n NotificationServer) StreamSendNotifications(stream grpc.BidiStreamingServer[notificationv1.StreamSendNotificationsRequest, notificationv1.StreamSendNotificationsResponse]) error {
+	ctx := stream.Context()
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	requests := make(chan *notificationv1.StreamSendNotificationsRequest)
+	// 接收结束的原因，客户端关闭发送时为 io.EOF
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(requests)
+		for {
+			request, err1 := stream.Recv()
+			if err1 != nil {
+				recvErr <- err1
+				return
+			}
+			select {
+			case requests <- request:
+			case <-ctx.Done():
+				recvErr <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(streamFlushInterval)
+	defer ticker.Stop()
+	pending := make([]streamItem, 0, streamChunkSize)
+	for {
+		select {
+		case request, ok := <-requests:
+			if !ok {
+				if err = n.flushStream(ctx, stream, pending); err != nil {
+					return err
+				}
+				if err = <-recvErr; errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+			notification, err1 := n.buildStreamNotification(ctx, request.GetNotification(), bizID)
+			if err1 != nil {
+				if err = stream.Send(n.buildStreamAck(request.GetSeq(), 0, err1)); err != nil {
+					return err
+				}
+				continue
+			}
+			pending = append(pending, streamItem{seq: request.GetSeq(), notification: notification})
+			if len(pending) < streamChunkSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(pending) == 0 {
+				continue
+			}
+		}
+		if err = n.flushStream(ctx, stream, pending); err != nil {
+			return err
+		}
+		pending = pending[:0]
+		ticker.Reset(streamFlushInterval)
+	}
+}
+
+func (n NotificationServer) buildStreamNotification(ctx context.Context, noti *notificationv1.Notification, bizID int64) (domain.Notification, error) {
+	notification, err := n.buildNotification(ctx, noti, bizID)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	// 提前校验，避免一条不合法的通知导致整批写入失败
+	if err = notification.Validate(); err != nil {
+		return domain.Notification{}, err
+	}
+	return notification, nil
+}
+
+// flushStream 按发送策略分组批量写入并逐条确认
+// 整批写入失败时逐条重新写入，例如同一批中有重复的通知，每条通知得到自己的结果
+func (n NotificationServer) flushStream(ctx context.Context,
+	stream grpc.BidiStreamingServer[notificationv1.StreamSendNotificationsRequest, notificationv1.StreamSendNotificationsResponse],
+	items []streamItem,
+) error {
+	groups := make(map[domain.SendStrategyType][]streamItem)
+	types := make([]domain.SendStrategyType, 0, 1)
+	for i := range items {
+		typ := items[i].notification.SendStrategyConfig.Type
+		if _, ok := groups[typ]; !ok {
+			types = append(types, typ)
+		}
+		groups[typ] = append(groups[typ], items[i])
+	}
+
+	for _, typ := range types {
+		group := groups[typ]
+		notifications := make([]domain.Notification, 0, len(group))
+		for i := range group {
+			notifications = append(notifications, group[i].notification)
+		}
+		result, err := n.sendSvc.BatchSendNotificationsAsync(ctx, notifications)
+		if err == nil {
+			for i := range group {
+				if err = stream.Send(n.buildStreamAck(group[i].seq, result.NotificationIDs[i], nil)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		for i := range group {
+			res, err1 := n.sendSvc.SendNotificationAsync(ctx, group[i].notification)
+			if err = stream.Send(n.buildStreamAck(group[i].seq, res.NotificationID, err1)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (n NotificationServer) buildStreamAck(seq, notificationID int64, err error) *notificationv1.StreamSendNotificationsResponse {
+	ack := &notificationv1.StreamSendNotificationsResponse{Seq: seq}
+	if err != nil {
+		ack.ErrorCode = convertToGRPCErrorCode(err)
+		ack.ErrorMessage = err.Error()
+		return ack
+	}
+	ack.NotificationId = notificationID
+	return ack
+}
+
 // PrepareTx 处理事务通知准备请求
 func (n NotificationServer) PrepareTx(ctx context.Context, request *notificationv1.PrepareTxRequest) (*notificationv1.PrepareTxResponse, error) {
 	// 从metadata中解析Authorization JWT Token
@@ -279,6 +436,132 @@ func (n NotificationServer) CancelTx(ctx context.Context, request *notificationv
 	return &notificationv1.CancelTxResponse{}, err
 }
 
+// CancelNotification 取消待发送的通知
+func (n NotificationServer) CancelNotification(ctx context.Context, request *notificationv1.CancelNotificationRequest) (*notificationv1.CancelNotificationResponse, error) {
+	if request == nil || request.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "请求参数无效：key不能为空")
+	}
+
+	bizId, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	err = n.notificationSvc.Cancel(ctx, bizId, request.Key)
+	if err != nil {
+		return nil, n.convertToGRPCOperationError(err)
+	}
+	return &notificationv1.CancelNotificationResponse{}, nil
+}
+
+// RescheduleNotification 修改待发送通知的发送策略
+func (n NotificationServer) RescheduleNotification(ctx context.Context, request *notificationv1.RescheduleNotificationRequest) (*notificationv1.RescheduleNotificationResponse, error) {
+	if request == nil || request.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "请求参数无效：key不能为空")
+	}
+	if request.SendStrategy == nil {
+		return nil, status.Error(codes.InvalidArgument, "请求参数无效：发送策略不能为空")
+	}
+
+	bizId, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	noti, err := n.notificationSvc.Reschedule(ctx, bizId, request.Key, domain.SendStrategyConfigFromAPI(request.SendStrategy))
+	if err != nil {
+		return nil, n.convertToGRPCOperationError(err)
+	}
+	return &notificationv1.RescheduleNotificationResponse{
+		NotificationId: noti.ID,
+		ScheduledStime: noti.ScheduledSTime.UnixMilli(),
+		ScheduledEtime: noti.ScheduledETime.UnixMilli(),
+	}, nil
+}
+
+// PauseNotificationSeries 暂停周期发送
+func (n NotificationServer) PauseNotificationSeries(ctx context.Context, request *notificationv1.PauseNotificationSeriesRequest) (*notificationv1.PauseNotificationSeriesResponse, error) {
+	series, err := n.operateSeries(ctx, request.GetKey(), n.seriesSvc.Pause)
+	if err != nil {
+		return nil, err
+	}
+	return &notificationv1.PauseNotificationSeriesResponse{Series: series}, nil
+}
+
+// ResumeNotificationSeries 恢复周期发送
+func (n NotificationServer) ResumeNotificationSeries(ctx context.Context, request *notificationv1.ResumeNotificationSeriesRequest) (*notificationv1.ResumeNotificationSeriesResponse, error) {
+	series, err := n.operateSeries(ctx, request.GetKey(), n.seriesSvc.Resume)
+	if err != nil {
+		return nil, err
+	}
+	return &notificationv1.ResumeNotificationSeriesResponse{Series: series}, nil
+}
+
+// StopNotificationSeries 停止周期发送
+func (n NotificationServer) StopNotificationSeries(ctx context.Context, request *notificationv1.StopNotificationSeriesRequest) (*notificationv1.StopNotificationSeriesResponse, error) {
+	series, err := n.operateSeries(ctx, request.GetKey(), n.seriesSvc.Stop)
+	if err != nil {
+		return nil, err
+	}
+	return &notificationv1.StopNotificationSeriesResponse{Series: series}, nil
+}
+
+func (n NotificationServer) operateSeries(ctx context.Context, key string,
+	op func(ctx context.Context, bizID int64, key string) (domain.NotificationSeries, error),
+) (*notificationv1.NotificationSeries, error) {
+	if key == "" {
+		return nil, status.Error(codes.InvalidArgument, "请求参数无效：key不能为空")
+	}
+
+	bizId, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	series, err := op(ctx, bizId, key)
+	if err != nil {
+		return nil, n.convertToGRPCOperationError(err)
+	}
+	res := &notificationv1.NotificationSeries{
+		Key:         series.Key,
+		Status:      convertToGRPCSeriesStatus(series.Status),
+		Occurrences: series.Occurrences,
+	}
+	if !series.IsFinished() && !series.NextTime.IsZero() {
+		res.NextTime = series.NextTime.UnixMilli()
+	}
+	return res, nil
+}
+
+func convertToGRPCSeriesStatus(st domain.SeriesStatus) notificationv1.SeriesStatus {
+	switch st {
+	case domain.SeriesStatusActive:
+		return notificationv1.SeriesStatus_SERIES_STATUS_ACTIVE
+	case domain.SeriesStatusPaused:
+		return notificationv1.SeriesStatus_SERIES_STATUS_PAUSED
+	case domain.SeriesStatusStopped:
+		return notificationv1.SeriesStatus_SERIES_STATUS_STOPPED
+	case domain.SeriesStatusCompleted:
+		return notificationv1.SeriesStatus_SERIES_STATUS_COMPLETED
+	default:
+		return notificationv1.SeriesStatus_SERIES_STATUS_UNSPECIFIED
+	}
+}
+
+// convertToGRPCOperationError 取消、改期和操作周期发送的错误转换，当前状态不允许操作时返回 FailedPrecondition
+func (n NotificationServer) convertToGRPCOperationError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, errs.ErrNotificationNotFound), errors.Is(err, errs.ErrNotificationSeriesNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, errs.ErrInvalidOperation):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "系统错误：%v", err)
+	}
+}
+
 // QueryNotification 处理单条查询通知请求
 func (n NotificationServer) QueryNotification(ctx context.Context, request *notificationv1.QueryNotificationRequest) (*notificationv1.QueryNotificationResponse, error) {
 	// 请求参数校验
@@ -305,12 +588,21 @@ func (n NotificationServer) QueryNotification(ctx context.Context, request *noti
 		}, nil
 	}
 
-	// 将结果转换为相应
+	// 送达回执
 	const zero = 0
+	deliveries, err := n.deliverySvc.GetByNotificationIDs(ctx, []int64{notifications[zero].ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "查询送达回执失败：%v", err)
+	}
+
+	// 将结果转换为相应
 	return &notificationv1.QueryNotificationResponse{
 		Result: &notificationv1.SendNotificationResponse{
-			NotificationId: notifications[zero].ID,
-			Status:         n.covertToGRPCSendStatus(notifications[zero].Status),
+			NotificationId:       notifications[zero].ID,
+			Status:               n.covertToGRPCSendStatus(notifications[zero].Status),
+			DeliveredChannel:     convertToGRPCChannel(notifications[zero].DeliveredChannel),
+			Deliveries:           n.convertToGRPCDeliveries(deliveries[notifications[zero].ID]),
+			DigestNotificationId: notifications[zero].DigestID,
 		},
 	}, nil
 }
@@ -343,26 +635,226 @@ func (n NotificationServer) BatchQueryNotifications(ctx context.Context, request
 		return nil, status.Errorf(codes.Internal, "批量查询失败：%v", err)
 	}
 
+	// 送达回执
+	ids := make([]int64, 0, len(notifications))
+	for i := range notifications {
+		ids = append(ids, notifications[i].ID)
+	}
+	deliveries, err := n.deliverySvc.GetByNotificationIDs(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "查询送达回执失败：%v", err)
+	}
+
 	// 结果转换
 	resp := &notificationv1.BatchQueryNotificationsResponse{
 		Results: make([]*notificationv1.SendNotificationResponse, 0, len(notifications)),
 	}
 	for i := range notifications {
 		resp.Results = append(resp.Results, &notificationv1.SendNotificationResponse{
-			NotificationId: notifications[i].ID,
-			Status:         n.covertToGRPCSendStatus(notifications[i].Status),
+			NotificationId:       notifications[i].ID,
+			Status:               n.covertToGRPCSendStatus(notifications[i].Status),
+			DeliveredChannel:     convertToGRPCChannel(notifications[i].DeliveredChannel),
+			Deliveries:           n.convertToGRPCDeliveries(deliveries[notifications[i].ID]),
+			DigestNotificationId: notifications[i].DigestID,
+		})
+	}
+	return resp, nil
+}
+
+// SearchNotifications 按条件分页检索通知
+func (n NotificationServer) SearchNotifications(ctx context.Context, request *notificationv1.SearchNotificationsRequest) (*notificationv1.SearchNotificationsResponse, error) {
+	bizId, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	q, err := n.buildNotificationSearch(request, bizId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	res, err := n.notificationSvc.Search(ctx, q)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidParameter) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "检索通知失败：%v", err)
+	}
+
+	resp := &notificationv1.SearchNotificationsResponse{
+		Notifications: make([]*notificationv1.NotificationSummary, 0, len(res.Notifications)),
+		NextCursor:    res.NextCursor.Encode(),
+		HasMore:       res.HasMore,
+	}
+	for i := range res.Notifications {
+		noti := res.Notifications[i]
+		resp.Notifications = append(resp.Notifications, &notificationv1.NotificationSummary{
+			NotificationId:   noti.ID,
+			Key:              noti.Key,
+			Receivers:        noti.Receivers,
+			Channel:          convertToGRPCChannel(noti.Channel),
+			TemplateId:       strconv.FormatInt(noti.Template.ID, 10),
+			Status:           n.covertToGRPCSendStatus(noti.Status),
+			DeliveredChannel: convertToGRPCChannel(noti.DeliveredChannel),
+			ScheduledStime:   noti.ScheduledSTime.UnixMilli(),
+			ScheduledEtime:   noti.ScheduledETime.UnixMilli(),
+			Ctime:            noti.Ctime,
+			Utime:            noti.Utime,
 		})
 	}
 	return resp, nil
 }
 
+// GetNotificationTimeline 查询通知的状态流转记录
+func (n NotificationServer) GetNotificationTimeline(ctx context.Context, request *notificationv1.GetNotificationTimelineRequest) (*notificationv1.GetNotificationTimelineResponse, error) {
+	if request == nil || request.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "请求参数无效：key不能为空")
+	}
+
+	bizId, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	noti, transitions, err := n.notificationSvc.GetTimeline(ctx, bizId, request.Key)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotificationNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "查询状态流转记录失败：%v", err)
+	}
+
+	resp := &notificationv1.GetNotificationTimelineResponse{
+		NotificationId: noti.ID,
+		Status:         n.covertToGRPCSendStatus(noti.Status),
+		Transitions:    make([]*notificationv1.NotificationTransition, 0, len(transitions)),
+	}
+	for i := range transitions {
+		resp.Transitions = append(resp.Transitions, n.convertToGRPCTransition(transitions[i]))
+	}
+	return resp, nil
+}
+
+// WatchNotifications 订阅通知的状态变化，直到客户端断开
+func (n NotificationServer) WatchNotifications(request *notificationv1.WatchNotificationsRequest, stream grpc.ServerStreamingServer[notificationv1.NotificationStatusEvent]) error {
+	ctx := stream.Context()
+	bizId, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	err = n.watchSvc.Watch(ctx, watchsvc.Request{
+		BizID:           bizId,
+		NotificationIDs: request.GetNotificationIds(),
+		Keys:            request.GetKeys(),
+		ResumeToken:     request.GetResumeToken(),
+	}, func(t domain.NotificationTransition) error {
+		return stream.Send(&notificationv1.NotificationStatusEvent{
+			NotificationId: t.NotificationID,
+			Key:            t.Key,
+			Transition:     n.convertToGRPCTransition(t),
+			ResumeToken:    watchsvc.ResumeToken(t),
+		})
+	})
+	switch {
+	case err == nil, ctx.Err() != nil:
+		return nil
+	case errors.Is(err, errs.ErrInvalidParameter), errors.Is(err, errs.ErrBatchSizeOverLimit):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "订阅状态变化失败：%v", err)
+	}
+}
+
+func (n NotificationServer) convertToGRPCTransition(t domain.NotificationTransition) *notificationv1.NotificationTransition {
+	return &notificationv1.NotificationTransition{
+		FromStatus:   t.FromStatus.String(),
+		ToStatus:     t.ToStatus.String(),
+		Actor:        t.Actor.String(),
+		Provider:     t.Provider,
+		ErrorMessage: t.ErrMsg,
+		Time:         t.Ctime,
+	}
+}
+
+// buildNotificationSearch 将检索请求转换为领域层的检索条件
+func (n NotificationServer) buildNotificationSearch(request *notificationv1.SearchNotificationsRequest, bizID int64) (domain.NotificationSearch, error) {
+	cursor, err := domain.ParseNotificationCursor(request.GetCursor())
+	if err != nil {
+		return domain.NotificationSearch{}, err
+	}
+	q := domain.NotificationSearch{
+		BizID:     bizID,
+		Receiver:  request.GetReceiver(),
+		StartTime: request.GetStartTime(),
+		EndTime:   request.GetEndTime(),
+		KeyPrefix: request.GetKeyPrefix(),
+		Ascending: request.GetAscending(),
+		Cursor:    cursor,
+		Limit:     int(request.GetLimit()),
+	}
+	if request.GetChannel() != notificationv1.Channel_CHANNEL_UNSPECIFIED {
+		q.Channel, err = domain.ChannelFromAPI(request.GetChannel())
+		if err != nil {
+			return domain.NotificationSearch{}, err
+		}
+	}
+	if request.GetTemplateId() != "" {
+		q.TemplateID, err = strconv.ParseInt(request.GetTemplateId(), 10, 64)
+		if err != nil {
+			return domain.NotificationSearch{}, fmt.Errorf("%w: 模板ID: %s", errs.ErrInvalidParameter, request.GetTemplateId())
+		}
+	}
+	for _, st := range request.GetStatuses() {
+		statuses, err := n.convertToDomainSendStatuses(st)
+		if err != nil {
+			return domain.NotificationSearch{}, err
+		}
+		q.Statuses = append(q.Statuses, statuses...)
+	}
+	switch request.GetSortBy() {
+	case notificationv1.NotificationSortField_SORT_BY_UTIME:
+		q.SortBy = domain.NotificationSortByUtime
+	default:
+		q.SortBy = domain.NotificationSortByCtime
+	}
+	return q, nil
+}
+
+// convertToDomainSendStatuses 将gRPC层的发送状态转换为领域层的状态，PENDING 同时对应待发送、等待合并和发送中
+func (n NotificationServer) convertToDomainSendStatuses(st notificationv1.SendStatus) ([]domain.SendStatus, error) {
+	switch st {
+	case notificationv1.SendStatus_PREPARE:
+		return []domain.SendStatus{domain.SendStatusPrepare}, nil
+	case notificationv1.SendStatus_CANCELED:
+		return []domain.SendStatus{domain.SendStatusCanceled}, nil
+	case notificationv1.SendStatus_PENDING:
+		return []domain.SendStatus{domain.SendStatusPending, domain.SendStatusDigesting, domain.SendStatusSending}, nil
+	case notificationv1.SendStatus_SUCCEEDED:
+		return []domain.SendStatus{domain.SendStatusSucceeded}, nil
+	case notificationv1.SendStatus_FAILED:
+		return []domain.SendStatus{domain.SendStatusFailed}, nil
+	case notificationv1.SendStatus_PARTIALLY_SUCCEEDED:
+		return []domain.SendStatus{domain.SendStatusPartiallySucceeded}, nil
+	case notificationv1.SendStatus_FREQUENCY_CAPPED:
+		return []domain.SendStatus{domain.SendStatusFrequencyCapped}, nil
+	default:
+		return nil, fmt.Errorf("%w: 发送状态 %s", errs.ErrInvalidParameter, st)
+	}
+}
+
 func (n NotificationServer) buildNotification(ctx context.Context, noti *notificationv1.Notification, bizID int64) (domain.Notification, error) {
+	return buildNotification(ctx, n.templateSvc, noti, bizID)
+}
+
+// buildNotification 转换为领域层的通知，模板和降级模板必须已发布，使用模板当前的发布版本
+func buildNotification(ctx context.Context, templateSvc templatesvc.ChannelTemplateService, noti *notificationv1.Notification, bizID int64) (domain.Notification, error) {
 	notification, err := domain.NewNotificationFromAPI(noti)
 	if err != nil {
 		return domain.Notification{}, err
 	}
 
-	tmpl, err := n.templateSvc.GetTemplateByID(ctx, notification.Template.ID)
+	tmpl, err := templateSvc.GetTemplateByID(ctx, notification.Template.ID)
 	if err != nil {
 		return domain.Notification{}, fmt.Errorf("%w: 模板ID: %s", errs.ErrInvalidParameter, noti.TemplateId)
 	}
@@ -371,6 +863,14 @@ func (n NotificationServer) buildNotification(ctx context.Context, noti *notific
 		return domain.Notification{}, fmt.Errorf("%w: 模板ID: %s 未发布", errs.ErrInvalidParameter, noti.TemplateId)
 	}
 
+	// 降级模板必须已发布且属于对应渠道
+	for channel, tid := range notification.FallbackTemplates {
+		fallback, err := templateSvc.GetTemplateByID(ctx, tid)
+		if err != nil || !fallback.HasPublished() || fallback.Channel != channel {
+			return domain.Notification{}, fmt.Errorf("%w: 降级模板ID: %d 不可用于渠道 %s", errs.ErrInvalidParameter, tid, channel)
+		}
+	}
+
 	notification.BizID = bizID
 	notification.Template.VersionID = tmpl.ActiveVersionID
 	return notification, nil
@@ -383,7 +883,7 @@ func (n NotificationServer) isSystemError(err error) bool {
 		errors.Is(err, errs.ErrNotificationVersionMismatch)
 }
 
-func (n NotificationServer) convertToGRPCErrorCode(err error) notificationv1.ErrorCode {
+func convertToGRPCErrorCode(err error) notificationv1.ErrorCode {
 	// 注意：这个函数只处理业务错误，系统错误由isSystemError判断后直接通过gRPC status返回
 	switch {
 	case errors.Is(err, errs.ErrInvalidParameter):
@@ -434,6 +934,9 @@ func (n NotificationServer) convertToGRPCErrorCode(err error) notificationv1.Err
 	case errors.Is(err, errs.ErrUnknownChannel):
 		return notificationv1.ErrorCode_UNKNOWN_CHANNEL
 
+	case errors.Is(err, errs.ErrFrequencyCapped):
+		return notificationv1.ErrorCode_FREQUENCY_CAP_EXCEEDED
+
 	default:
 		return notificationv1.ErrorCode_ERROR_CODE_UNSPECIFIED
 	}
@@ -441,31 +944,102 @@ func (n NotificationServer) convertToGRPCErrorCode(err error) notificationv1.Err
 
 // covertToGRPCSendStatus 将领域层的发送状态转换为gRPC层的发送状态
 func (n NotificationServer) covertToGRPCSendStatus(status domain.SendStatus) notificationv1.SendStatus {
+	return convertToGRPCSendStatus(status)
+}
+
+func convertToGRPCSendStatus(status domain.SendStatus) notificationv1.SendStatus {
 	switch status {
 	case domain.SendStatusPrepare:
 		return notificationv1.SendStatus_PREPARE
 	case domain.SendStatusCanceled:
 		return notificationv1.SendStatus_CANCELED
-	case domain.SendStatusSending:
+	case domain.SendStatusPending, domain.SendStatusDigesting, domain.SendStatusSending:
 		return notificationv1.SendStatus_PENDING
 	case domain.SendStatusSucceeded:
 		return notificationv1.SendStatus_SUCCEEDED
 	case domain.SendStatusFailed:
 		return notificationv1.SendStatus_FAILED
+	case domain.SendStatusPartiallySucceeded:
+		return notificationv1.SendStatus_PARTIALLY_SUCCEEDED
+	case domain.SendStatusFrequencyCapped:
+		return notificationv1.SendStatus_FREQUENCY_CAPPED
 	default:
 		return notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
 	}
 }
 
+// convertToGRPCChannel 将领域层的渠道转换为gRPC层的渠道
+func convertToGRPCChannel(channel domain.Channel) notificationv1.Channel {
+	switch channel {
+	case domain.ChannelSMS:
+		return notificationv1.Channel_SMS
+	case domain.ChannelEmail:
+		return notificationv1.Channel_EMAIL
+	case domain.ChannelInApp:
+		return notificationv1.Channel_IN_APP
+	case domain.ChannelWebhook:
+		return notificationv1.Channel_WEBHOOK
+	case domain.ChannelIM:
+		return notificationv1.Channel_IM
+	case domain.ChannelPush:
+		return notificationv1.Channel_PUSH
+	default:
+		return notificationv1.Channel_CHANNEL_UNSPECIFIED
+	}
+}
+
+// convertToGRPCDeliveryStatus 将领域层的接收者状态转换为gRPC层的状态
+func convertToGRPCDeliveryStatus(status domain.DeliveryStatus) notificationv1.DeliveryStatus {
+	switch status {
+	case domain.DeliveryStatusWaiting:
+		return notificationv1.DeliveryStatus_WAITING
+	case domain.DeliveryStatusDelivered:
+		return notificationv1.DeliveryStatus_DELIVERED
+	case domain.DeliveryStatusUndelivered:
+		return notificationv1.DeliveryStatus_UNDELIVERED
+	case domain.DeliveryStatusPending:
+		return notificationv1.DeliveryStatus_DELIVERY_PENDING
+	case domain.DeliveryStatusSubmitFailed:
+		return notificationv1.DeliveryStatus_SUBMIT_FAILED
+	case domain.DeliveryStatusSubmitted:
+		return notificationv1.DeliveryStatus_SUBMITTED
+	default:
+		return notificationv1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
+
 // buildGRPCSendResponse 将领域响应转换为gRPC响应
+func (n NotificationServer) convertToGRPCDeliveries(deliveries []domain.Delivery) []*notificationv1.ReceiverDelivery {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	result := make([]*notificationv1.ReceiverDelivery, 0, len(deliveries))
+	for i := range deliveries {
+		result = append(result, &notificationv1.ReceiverDelivery{
+			Receiver:   deliveries[i].Receiver,
+			Status:     convertToGRPCDeliveryStatus(deliveries[i].Status),
+			ErrCode:    deliveries[i].ErrCode,
+			ErrMsg:     deliveries[i].ErrMsg,
+			ReportTime: deliveries[i].ReportTime,
+		})
+	}
+	return result
+}
+
 func (n NotificationServer) buildGRPCSendResponse(res domain.SendResponse, err error) *notificationv1.SendNotificationResponse {
 	response := &notificationv1.SendNotificationResponse{
-		NotificationId: res.NotificationID,
-		Status:         n.covertToGRPCSendStatus(res.Status),
+		NotificationId:   res.NotificationID,
+		Status:           n.covertToGRPCSendStatus(res.Status),
+		DeliveredChannel: convertToGRPCChannel(res.DeliveredChannel),
+		Deliveries:       n.convertToGRPCDeliveries(res.Deliveries),
+	}
+	if res.Status == domain.SendStatusFrequencyCapped {
+		response.ErrorCode = notificationv1.ErrorCode_FREQUENCY_CAP_EXCEEDED
+		response.ErrorMessage = errs.ErrFrequencyCapped.Error()
 	}
 	// 如果有错误，提取错误代码和消息
 	if err != nil {
-		response.ErrorCode = n.convertToGRPCErrorCode(err)
+		response.ErrorCode = convertToGRPCErrorCode(err)
 		response.ErrorMessage = err.Error()
 
 		// 如果状态不是失败，但是有错误，更新状态为失败
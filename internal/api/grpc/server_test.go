@@ -0,0 +1,192 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	notificationv1 "go-notification/api/proto/gen/notification/v1"
+	"go-notification/internal/api/grpc/interceptor/jwt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	notificationSvc "go-notification/internal/service/notification"
+	templatesvc "go-notification/internal/service/template/manage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type fakeSendStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*notificationv1.StreamSendNotificationsRequest
+	acks     []*notificationv1.StreamSendNotificationsResponse
+}
+
+func (s *fakeSendStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSendStream) Recv() (*notificationv1.StreamSendNotificationsRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	return request, nil
+}
+
+func (s *fakeSendStream) Send(ack *notificationv1.StreamSendNotificationsResponse) error {
+	s.acks = append(s.acks, ack)
+	return nil
+}
+
+type fakeSendSvc struct {
+	notificationSvc.SendService
+	batchErr   error
+	duplicates map[string]bool
+	batches    []int
+	nextID     int64
+}
+
+func (s *fakeSendSvc) BatchSendNotificationsAsync(_ context.Context, ns []domain.Notification) (domain.BatchSendAsyncResponse, error) {
+	s.batches = append(s.batches, len(ns))
+	if s.batchErr != nil {
+		return domain.BatchSendAsyncResponse{}, s.batchErr
+	}
+	ids := make([]int64, 0, len(ns))
+	for range ns {
+		s.nextID++
+		ids = append(ids, s.nextID)
+	}
+	return domain.BatchSendAsyncResponse{NotificationIDs: ids}, nil
+}
+
+func (s *fakeSendSvc) SendNotificationAsync(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+	if s.duplicates[n.Key] {
+		return domain.SendResponse{}, fmt.Errorf("创建通知失败: %w", errs.ErrNotificationDuplicate)
+	}
+	s.nextID++
+	return domain.SendResponse{NotificationID: s.nextID, Status: domain.SendStatusPending}, nil
+}
+
+type fakePublishedTemplateSvc struct {
+	templatesvc.ChannelTemplateService
+}
+
+func (s *fakePublishedTemplateSvc) GetTemplateByID(_ context.Context, templateID int64) (domain.ChannelTemplate, error) {
+	return domain.ChannelTemplate{ID: templateID, ActiveVersionID: 1}, nil
+}
+
+func TestNotificationServer_StreamSendNotifications(t *testing.T) {
+	t.Parallel()
+
+	newRequest := func(seq int64, receivers ...string) *notificationv1.StreamSendNotificationsRequest {
+		return &notificationv1.StreamSendNotificationsRequest{
+			Seq: seq,
+			Notification: &notificationv1.Notification{
+				Key:            fmt.Sprintf("key-%d", seq),
+				Receivers:      receivers,
+				Channel:        notificationv1.Channel_SMS,
+				TemplateId:     "1",
+				TemplateParams: map[string]string{"code": "123456"},
+			},
+		}
+	}
+	newRequests := func(cnt int) []*notificationv1.StreamSendNotificationsRequest {
+		requests := make([]*notificationv1.StreamSendNotificationsRequest, 0, cnt)
+		for i := 1; i <= cnt; i++ {
+			requests = append(requests, newRequest(int64(i), "13800000000"))
+		}
+		return requests
+	}
+
+	testCases := []struct {
+		name        string
+		requests    []*notificationv1.StreamSendNotificationsRequest
+		sendSvc     *fakeSendSvc
+		wantBatches []int
+		// 序号 -> 是否确认成功
+		wantAcks map[int64]bool
+	}{
+		{
+			name:        "分批写入并逐条确认",
+			requests:    newRequests(150),
+			sendSvc:     &fakeSendSvc{},
+			wantBatches: []int{streamChunkSize, 50},
+		},
+		{
+			name:        "参数不合法的通知单独确认失败",
+			requests:    append(newRequests(2), newRequest(3)),
+			sendSvc:     &fakeSendSvc{},
+			wantBatches: []int{2},
+			wantAcks:    map[int64]bool{1: true, 2: true, 3: false},
+		},
+		{
+			name:     "整批写入失败时逐条写入",
+			requests: newRequests(3),
+			sendSvc: &fakeSendSvc{
+				batchErr:   errs.ErrNotificationDuplicate,
+				duplicates: map[string]bool{"key-2": true},
+			},
+			wantBatches: []int{3},
+			wantAcks:    map[int64]bool{1: true, 2: false, 3: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := NotificationServer{sendSvc: tc.sendSvc, templateSvc: &fakePublishedTemplateSvc{}}
+			stream := &fakeSendStream{
+				ctx:      context.WithValue(t.Context(), jwt.BizIDName, int64(1)),
+				requests: tc.requests,
+			}
+
+			require.NoError(t, server.StreamSendNotifications(stream))
+
+			assert.Equal(t, tc.wantBatches, tc.sendSvc.batches)
+			require.Len(t, stream.acks, len(tc.requests))
+			seen := make(map[int64]bool, len(stream.acks))
+			for _, ack := range stream.acks {
+				seen[ack.GetSeq()] = true
+				wantOK, ok := tc.wantAcks[ack.GetSeq()]
+				if !ok {
+					wantOK = true
+				}
+				if wantOK {
+					assert.NotZero(t, ack.GetNotificationId(), "序号 %d", ack.GetSeq())
+					assert.Empty(t, ack.GetErrorMessage())
+				} else {
+					assert.Zero(t, ack.GetNotificationId(), "序号 %d", ack.GetSeq())
+					assert.NotEmpty(t, ack.GetErrorMessage())
+				}
+			}
+			assert.Len(t, seen, len(tc.requests))
+		})
+	}
+}
+
+func TestNotificationServer_StreamSendNotifications_RecvError(t *testing.T) {
+	t.Parallel()
+
+	server := NotificationServer{sendSvc: &fakeSendSvc{}, templateSvc: &fakePublishedTemplateSvc{}}
+	stream := &brokenSendStream{fakeSendStream: fakeSendStream{ctx: context.WithValue(t.Context(), jwt.BizIDName, int64(1))}}
+
+	err := server.StreamSendNotifications(stream)
+	assert.ErrorIs(t, err, errBrokenStream)
+}
+
+var errBrokenStream = errors.New("连接断开")
+
+type brokenSendStream struct {
+	fakeSendStream
+}
+
+func (s *brokenSendStream) Recv() (*notificationv1.StreamSendNotificationsRequest, error) {
+	return nil, errBrokenStream
+}
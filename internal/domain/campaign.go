@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go-notification/internal/errs"
+	"io"
+	"maps"
+	"strings"
+)
+
+// CampaignStatus 活动的状态
+type CampaignStatus string
+
+const (
+	CampaignStatusDraft     CampaignStatus = "DRAFT"     // 上传接收者，开始后不能再添加
+	CampaignStatusRunning   CampaignStatus = "RUNNING"   // 按发送速率生成通知
+	CampaignStatusPaused    CampaignStatus = "PAUSED"    // 已暂停，恢复后从暂停的位置继续
+	CampaignStatusAborted   CampaignStatus = "ABORTED"   // 业务方终止，已经生成的通知不受影响
+	CampaignStatusCompleted CampaignStatus = "COMPLETED" // 所有接收者都已经生成通知
+)
+
+func (s CampaignStatus) String() string {
+	return string(s)
+}
+
+const (
+	// MaxCampaignSendRate 活动每秒最多生成的通知数量
+	MaxCampaignSendRate = 5000
+	// MaxCampaignReceiversPerUpload 单次上传的接收者数量上限，更多的接收者分多次上传
+	MaxCampaignReceiversPerUpload = 50000
+	// CampaignReceiverColumn 上传的 CSV 文件中接收者所在列的列名，其余列为该接收者的模板参数
+	CampaignReceiverColumn = "receiver"
+)
+
+// Campaign 面向大量接收者的活动发送
+// 业务方上传接收者后开始发送，由活动任务按发送速率为每个接收者生成一条通知，
+// 通知的业务内唯一标识由活动的标识和接收者的序号组成，因此幂等、状态查询和回调都按每个接收者独立进行
+type Campaign struct {
+	ID    int64
+	BizID int64
+	Key   string
+	Name  string
+	// Notification 每个接收者的通知内容，接收者为空，模板参数为所有接收者共用的参数
+	Notification Notification
+	SendRate     int32 // 每秒最多生成的通知数量
+	Total        int64 // 已上传的接收者数量
+	Dispatched   int64 // 已经处理的接收者数量，按序号处理，即下一个要处理的接收者的序号减一
+	Failed       int64 // 生成通知失败的接收者数量，例如接收者格式不对
+	Status       CampaignStatus
+	Version      int
+	Ctime        int64
+	Utime        int64
+}
+
+// CampaignReceiver 活动的一个接收者，Params 覆盖活动共用的模板参数
+type CampaignReceiver struct {
+	Seq      int64 // 从 1 开始的序号，按上传顺序分配
+	Receiver string
+	Params   map[string]string
+}
+
+// CampaignReport 活动进度和按通知状态统计的发送结果，活动结束后即为最终的汇总
+type CampaignReport struct {
+	Campaign     Campaign
+	StatusCounts map[SendStatus]int64
+}
+
+// Validate 校验除接收者和模板参数以外的通知内容，这两项由每个接收者提供
+func (c *Campaign) Validate() error {
+	if c.SendRate <= 0 || c.SendRate > MaxCampaignSendRate {
+		return fmt.Errorf("%w: 发送速率应在 1 到 %d 之间", errs.ErrInvalidParameter, MaxCampaignSendRate)
+	}
+	if c.Notification.SendStrategyConfig.Type == SendStrategyRecurring {
+		return fmt.Errorf("%w: 活动不支持周期发送", errs.ErrInvalidParameter)
+	}
+	n := c.Notification
+	// 用占位的接收者和参数复用通知的校验
+	n.Receivers = []string{CampaignReceiverColumn}
+	n.Template.Params = map[string]string{CampaignReceiverColumn: CampaignReceiverColumn}
+	return n.Validate()
+}
+
+// NotificationKeyPrefix 活动生成的通知的业务内唯一标识的前缀
+func (c *Campaign) NotificationKeyPrefix() string {
+	return c.Key + "#"
+}
+
+// ReceiverNotification 为接收者生成的通知，异步发送，立即发送会改为一分钟内发送
+func (c *Campaign) ReceiverNotification(r CampaignReceiver) Notification {
+	n := c.Notification
+	n.ID = 0
+	n.Key = fmt.Sprintf("%s%d", c.NotificationKeyPrefix(), r.Seq)
+	n.Receivers = []string{r.Receiver}
+	n.Status = ""
+	n.Version = 0
+	n.Template.Params = make(map[string]string, len(c.Notification.Template.Params)+len(r.Params))
+	maps.Copy(n.Template.Params, c.Notification.Template.Params)
+	maps.Copy(n.Template.Params, r.Params)
+	n.ReplaceAsyncImmediate()
+	return n
+}
+
+// Advance 处理了 processed 个接收者，其中 failed 个生成通知失败，全部处理完后活动完成
+func (c *Campaign) Advance(processed, failed int64) {
+	c.Dispatched += processed
+	c.Failed += failed
+	if c.Dispatched >= c.Total {
+		c.Status = CampaignStatusCompleted
+	}
+}
+
+// IsFinished 已经终止或者完成，不会再生成通知
+func (c *Campaign) IsFinished() bool {
+	return c.Status == CampaignStatusAborted || c.Status == CampaignStatusCompleted
+}
+
+// ValidateCampaignReceivers 校验一次上传的接收者
+func ValidateCampaignReceivers(receivers []CampaignReceiver) error {
+	if len(receivers) == 0 {
+		return fmt.Errorf("%w: 接收者不能为空", errs.ErrInvalidParameter)
+	}
+	if len(receivers) > MaxCampaignReceiversPerUpload {
+		return fmt.Errorf("%w: %d > %d", errs.ErrBatchSizeOverLimit, len(receivers), MaxCampaignReceiversPerUpload)
+	}
+	for i := range receivers {
+		if strings.TrimSpace(receivers[i].Receiver) == "" {
+			return fmt.Errorf("%w: 第 %d 个接收者为空", errs.ErrInvalidParameter, i+1)
+		}
+	}
+	return nil
+}
+
+// ParseCampaignReceiversCSV 解析上传的接收者文件
+// 第一行为列名，必须包含 receiver 列，其余列为该接收者的模板参数，值为空的参数使用活动共用的参数
+func ParseCampaignReceiversCSV(r io.Reader) ([]CampaignReceiver, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: 接收者文件为空", errs.ErrInvalidParameter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: 接收者文件格式错误: %w", errs.ErrInvalidParameter, err)
+	}
+	receiverIdx := -1
+	for i := range header {
+		// 去掉 Excel 导出的 UTF-8 BOM
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+		if header[i] == CampaignReceiverColumn {
+			receiverIdx = i
+		}
+	}
+	if receiverIdx < 0 {
+		return nil, fmt.Errorf("%w: 接收者文件缺少 %s 列", errs.ErrInvalidParameter, CampaignReceiverColumn)
+	}
+
+	var receivers []CampaignReceiver
+	for {
+		record, err1 := reader.Read()
+		if errors.Is(err1, io.EOF) {
+			break
+		}
+		if err1 != nil {
+			return nil, fmt.Errorf("%w: 接收者文件格式错误: %w", errs.ErrInvalidParameter, err1)
+		}
+		receiver := CampaignReceiver{Receiver: strings.TrimSpace(record[receiverIdx])}
+		for i, val := range record {
+			if i == receiverIdx || val == "" {
+				continue
+			}
+			if receiver.Params == nil {
+				receiver.Params = make(map[string]string, len(record)-1)
+			}
+			receiver.Params[header[i]] = val
+		}
+		receivers = append(receivers, receiver)
+	}
+	return receivers, ValidateCampaignReceivers(receivers)
+}
@@ -1,16 +1,26 @@
 package domain
 
-import "go-notification/internal/pkg/retry"
+import (
+	"fmt"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/retry"
+	"slices"
+	"sort"
+	"time"
+)
 
 type BusinessConfig struct {
-	ID             int64           // 业务标识
-	OwnerId        int64           // 业务方 ID
-	OwnerType      string          // 业务方类型：person - 个人，organization - 组织
-	ChannelConfig  *ChannelConfig  // 渠道配置，json格式
-	TxnConfig      *TxnConfig      // 事务配置，json格式
-	RateLimit      int             // 速率限制
-	Quota          *QuotaConfig    // 配额配置，json格式
-	CallbackConfig *CallbackConfig // 回调配置，json格式
+	ID             int64               // 业务标识
+	OwnerId        int64               // 业务方 ID
+	OwnerType      string              // 业务方类型：person - 个人，organization - 组织
+	ChannelConfig  *ChannelConfig      // 渠道配置，json格式
+	TxnConfig      *TxnConfig          // 事务配置，json格式
+	RateLimit      int                 // 速率限制
+	Quota          *QuotaConfig        // 配额配置，json格式
+	CallbackConfig *CallbackConfig     // 回调配置，json格式
+	WebhookConfig  *WebhookConfig      // WEBHOOK 渠道配置，json格式
+	QuietHours     *QuietHoursConfig   // 免打扰配置，json格式
+	FrequencyCap   *FrequencyCapConfig // 接收者发送频率上限配置，json格式
 	Ctime          int64
 	Utime          int64
 }
@@ -18,14 +28,44 @@ type BusinessConfig struct {
 type ChannelConfig struct {
 	Channels    []ChannelItem `json:"channels"`
 	RetryPolicy *retry.Config `json:"retryPolicy"`
+	// Fallback 开启渠道降级：主渠道所有供应商都失败或主渠道被禁用时，按优先级尝试其他启用的渠道
+	Fallback bool `json:"fallback"`
 }
 
 type ChannelItem struct {
 	Channel  string `json:"channel"`
-	Priority int    `json:"priority"`
+	Priority int    `json:"priority"` // 数值越小优先级越高
 	Enabled  bool   `json:"enabled"`
 }
 
+// FallbackChannels 返回降级模式下依次尝试的渠道
+// 主渠道未被禁用时排在最前，其余启用的渠道按优先级排在后面
+func (c *ChannelConfig) FallbackChannels(primary Channel) []Channel {
+	items := make([]ChannelItem, 0, len(c.Channels))
+	primaryEnabled := true
+	for _, item := range c.Channels {
+		if Channel(item.Channel) == primary {
+			primaryEnabled = item.Enabled
+			continue
+		}
+		if item.Enabled && Channel(item.Channel).IsValid() {
+			items = append(items, item)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Priority < items[j].Priority
+	})
+
+	channels := make([]Channel, 0, len(items)+1)
+	if primaryEnabled {
+		channels = append(channels, primary)
+	}
+	for _, item := range items {
+		channels = append(channels, Channel(item.Channel))
+	}
+	return channels
+}
+
 type TxnConfig struct {
 	// 回查方法名
 	ServiceName string `json:"serviceName"`
@@ -51,3 +91,191 @@ type CallbackConfig struct {
 	ServiceName string        `json:"serviceName"`
 	RetryPolicy *retry.Config `json:"retryPolicy"`
 }
+
+// WebhookConfig WEBHOOK 渠道配置
+type WebhookConfig struct {
+	// Secret 请求体签名密钥，业务方用它校验请求来自通知平台
+	Secret string `json:"secret"`
+	// Timeout 单次请求超时时间，单位毫秒
+	Timeout int64 `json:"timeout"`
+}
+
+// QuietHoursConfig 免打扰配置，调度发送时落在免打扰时段内的通知推迟到时段结束后发送
+// 验证码不受免打扰限制
+type QuietHoursConfig struct {
+	Rules []QuietHoursRule `json:"rules"`
+}
+
+// QuietHoursRule 一条免打扰规则，渠道或业务类型为空时对所有渠道或业务类型生效
+type QuietHoursRule struct {
+	Channels      []Channel      `json:"channels"`
+	BusinessTypes []BusinessType `json:"businessTypes"`
+	// Start 和 End 为当天的时刻，格式 HH:MM，Start 晚于 End 时跨天，例如 22:00 到次日 08:00
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// Timezone 计算时段使用的 IANA 时区，通知带有接收者时区时以接收者时区为准，都为空时使用 UTC
+	Timezone string `json:"timezone"`
+}
+
+// quietHoursLayout 免打扰时段的时刻格式
+const quietHoursLayout = "15:04"
+
+func (c *QuietHoursConfig) Validate() error {
+	for i, rule := range c.Rules {
+		start, err := time.Parse(quietHoursLayout, rule.Start)
+		if err != nil {
+			return fmt.Errorf("%w: 第 %d 条免打扰规则的开始时刻 %q 格式应为 HH:MM", errs.ErrInvalidParameter, i+1, rule.Start)
+		}
+		end, err := time.Parse(quietHoursLayout, rule.End)
+		if err != nil {
+			return fmt.Errorf("%w: 第 %d 条免打扰规则的结束时刻 %q 格式应为 HH:MM", errs.ErrInvalidParameter, i+1, rule.End)
+		}
+		if start.Equal(end) {
+			return fmt.Errorf("%w: 第 %d 条免打扰规则的开始时刻和结束时刻相同", errs.ErrInvalidParameter, i+1)
+		}
+		if _, err = time.LoadLocation(rule.Timezone); err != nil {
+			return fmt.Errorf("%w: 第 %d 条免打扰规则的时区 %q 无效", errs.ErrInvalidParameter, i+1, rule.Timezone)
+		}
+		for _, channel := range rule.Channels {
+			if !channel.IsValid() {
+				return fmt.Errorf("%w: 第 %d 条免打扰规则的渠道 %q 无效", errs.ErrInvalidParameter, i+1, channel)
+			}
+		}
+		for _, businessType := range rule.BusinessTypes {
+			if !businessType.IsValid() {
+				return fmt.Errorf("%w: 第 %d 条免打扰规则的业务类型 %d 无效", errs.ErrInvalidParameter, i+1, businessType)
+			}
+		}
+	}
+	return nil
+}
+
+// Defer 计算 at 落在免打扰时段内时允许发送的时间，不在任何时段内时第二个返回值为 false
+// 多条规则的时段首尾相接或者重叠时，一直推迟到不在任何时段内
+func (c *QuietHoursConfig) Defer(at time.Time, channel Channel, businessType BusinessType, receiverTimezone string) (time.Time, bool) {
+	if c == nil || businessType == BusinessTypeVerificationCode {
+		return at, false
+	}
+	deferred := false
+	// 每一轮至少推迟到一条规则的时段结束，规则数加一轮后仍在时段内说明时段覆盖了全天
+	for i := 0; i <= len(c.Rules); i++ {
+		moved := false
+		for _, rule := range c.Rules {
+			if !rule.matches(channel, businessType) {
+				continue
+			}
+			if end, ok := rule.windowEnd(at, receiverTimezone); ok {
+				at, moved, deferred = end, true, true
+			}
+		}
+		if !moved {
+			break
+		}
+	}
+	return at, deferred
+}
+
+func (r QuietHoursRule) matches(channel Channel, businessType BusinessType) bool {
+	return (len(r.Channels) == 0 || slices.Contains(r.Channels, channel)) &&
+		(len(r.BusinessTypes) == 0 || slices.Contains(r.BusinessTypes, businessType))
+}
+
+// windowEnd at 落在时段内时返回时段的结束时间
+func (r QuietHoursRule) windowEnd(at time.Time, receiverTimezone string) (time.Time, bool) {
+	start, err1 := time.Parse(quietHoursLayout, r.Start)
+	end, err2 := time.Parse(quietHoursLayout, r.End)
+	if err1 != nil || err2 != nil {
+		return at, false
+	}
+	local := at.In(r.location(receiverTimezone))
+	minutes := local.Hour()*60 + local.Minute()
+	startMinutes := start.Hour()*60 + start.Minute()
+	endMinutes := end.Hour()*60 + end.Minute()
+
+	endDay := 0
+	switch {
+	case startMinutes < endMinutes && minutes >= startMinutes && minutes < endMinutes:
+	case startMinutes > endMinutes && minutes >= startMinutes:
+		// 跨天的时段在次日结束
+		endDay = 1
+	case startMinutes > endMinutes && minutes < endMinutes:
+	default:
+		return at, false
+	}
+	return time.Date(local.Year(), local.Month(), local.Day()+endDay, end.Hour(), end.Minute(), 0, 0, local.Location()), true
+}
+
+// location 接收者时区有效时优先使用
+func (r QuietHoursRule) location(receiverTimezone string) *time.Location {
+	if receiverTimezone != "" {
+		if loc, err := time.LoadLocation(receiverTimezone); err == nil {
+			return loc
+		}
+	}
+	if loc, err := time.LoadLocation(r.Timezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// MaxFrequencyCapWindow 发送频率统计窗口的最大时长，超过该时长的发送记录不再保留
+const MaxFrequencyCapWindow = 7 * 24 * time.Hour
+
+// FrequencyCapConfig 接收者发送频率上限配置
+// 发送次数按接收者、渠道和业务类型在所有业务方之间共同统计，每个业务方按自己的规则限制
+type FrequencyCapConfig struct {
+	Rules []FrequencyCapRule `json:"rules"`
+}
+
+// FrequencyCapRule 一条频率上限规则，渠道或业务类型为空时对所有渠道或业务类型生效
+// 例如 Window 为 86400，Max 为 3，表示同一接收者 24 小时内最多收到 3 条
+type FrequencyCapRule struct {
+	Channels      []Channel      `json:"channels"`
+	BusinessTypes []BusinessType `json:"businessTypes"`
+	// Window 滑动窗口时长，单位秒
+	Window int64 `json:"window"`
+	// Max 窗口内最多发送的次数
+	Max int32 `json:"max"`
+}
+
+func (c *FrequencyCapConfig) Validate() error {
+	for i, rule := range c.Rules {
+		window := rule.WindowDuration()
+		if window <= 0 || window > MaxFrequencyCapWindow {
+			return fmt.Errorf("%w: 第 %d 条频率上限规则的窗口时长应在 1 秒到 %s 之间", errs.ErrInvalidParameter, i+1, MaxFrequencyCapWindow)
+		}
+		if rule.Max <= 0 {
+			return fmt.Errorf("%w: 第 %d 条频率上限规则的最多发送次数应大于 0", errs.ErrInvalidParameter, i+1)
+		}
+		for _, channel := range rule.Channels {
+			if !channel.IsValid() {
+				return fmt.Errorf("%w: 第 %d 条频率上限规则的渠道 %q 无效", errs.ErrInvalidParameter, i+1, channel)
+			}
+		}
+		for _, businessType := range rule.BusinessTypes {
+			if !businessType.IsValid() {
+				return fmt.Errorf("%w: 第 %d 条频率上限规则的业务类型 %d 无效", errs.ErrInvalidParameter, i+1, businessType)
+			}
+		}
+	}
+	return nil
+}
+
+// Match 返回对渠道和业务类型生效的规则
+func (c *FrequencyCapConfig) Match(channel Channel, businessType BusinessType) []FrequencyCapRule {
+	if c == nil {
+		return nil
+	}
+	var rules []FrequencyCapRule
+	for _, rule := range c.Rules {
+		if (len(rule.Channels) == 0 || slices.Contains(rule.Channels, channel)) &&
+			(len(rule.BusinessTypes) == 0 || slices.Contains(rule.BusinessTypes, businessType)) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func (r FrequencyCapRule) WindowDuration() time.Duration {
+	return time.Duration(r.Window) * time.Second
+}
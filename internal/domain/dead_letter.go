@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"errors"
+	"go-notification/internal/errs"
+)
+
+// ErrCategory 最终发送失败的原因分类
+type ErrCategory string
+
+const (
+	ErrCategoryInvalidParameter ErrCategory = "INVALID_PARAMETER" // 通知参数错误
+	ErrCategoryInvalidReceiver  ErrCategory = "INVALID_RECEIVER"  // 接收者无效
+	ErrCategoryTemplate         ErrCategory = "TEMPLATE"          // 模板不存在或未审核通过
+	ErrCategoryNoProvider       ErrCategory = "NO_PROVIDER"       // 没有可用的渠道或供应商
+	ErrCategoryRateLimited      ErrCategory = "RATE_LIMITED"      // 被限流、熔断或超出供应商限额
+	ErrCategoryUnknownResult    ErrCategory = "UNKNOWN_RESULT"    // 发送超时且无法向供应商核对结果
+	ErrCategoryProviderRejected ErrCategory = "PROVIDER_REJECTED" // 供应商逐个拒绝了接收者
+	ErrCategoryProviderError    ErrCategory = "PROVIDER_ERROR"    // 调用供应商出错
+)
+
+func (c ErrCategory) String() string {
+	return string(c)
+}
+
+// errCategories 按顺序匹配，供应商错误会包装模板错误，所以具体的错误排在前面
+var errCategories = []struct {
+	category ErrCategory
+	targets  []error
+}{
+	{category: ErrCategoryInvalidReceiver, targets: []error{errs.ErrInvalidReceiver}},
+	{category: ErrCategoryInvalidParameter, targets: []error{errs.ErrInvalidParameter}},
+	{category: ErrCategoryTemplate, targets: []error{
+		errs.ErrTemplateNotFound,
+		errs.ErrTemplateVersionNotFound,
+		errs.ErrTemplateVersionNotApprovedByPlatform,
+		errs.ErrTemplateVersionNotApprovedByProvider,
+	}},
+	{category: ErrCategoryNoProvider, targets: []error{
+		errs.ErrNoAvailableProvider,
+		errs.ErrNoAvailableChannel,
+		errs.ErrChannelDisabled,
+	}},
+	{category: ErrCategoryRateLimited, targets: []error{
+		errs.ErrRateLimited,
+		errs.ErrCircuitBreaker,
+		errs.ErrProviderRateLimited,
+		errs.ErrProviderDailyLimitExceeded,
+	}},
+	{category: ErrCategoryUnknownResult, targets: []error{errs.ErrReconcileNotSupported}},
+}
+
+// DeadLetterStatus 死信状态
+type DeadLetterStatus string
+
+const (
+	DeadLetterStatusPending  DeadLetterStatus = "PENDING"  // 待处理
+	DeadLetterStatusReplayed DeadLetterStatus = "REPLAYED" // 已重放，重放后再次失败会改回待处理
+)
+
+func (s DeadLetterStatus) String() string {
+	return string(s)
+}
+
+// maxDeadLetterErrMsgLen 死信中错误描述的最大长度
+const maxDeadLetterErrMsgLen = 1024
+
+// DeadLetter 最终发送失败的通知，一条通知只有一条死信，重放沿用原通知ID
+type DeadLetter struct {
+	ID                int64
+	NotificationID    int64  // 失败的通知ID
+	BizID             int64  // 业务ID
+	Key               string // 业务内唯一标识
+	Channel           Channel
+	TemplateID        int64
+	TemplateVersionID int64
+	Provider          string      // 最后一次提交的供应商
+	ErrCategory       ErrCategory // 失败原因分类
+	ErrMsg            string      // 最后一次的错误描述
+	RetryCount        int32       // 自动重试次数
+	Attempts          []DeadLetterAttempt
+	Status            DeadLetterStatus
+	ReplayCount       int32 // 已重放次数
+	Ctime             int64
+	Utime             int64
+}
+
+// DeadLetterAttempt 失败时各接收者的提交记录
+type DeadLetterAttempt struct {
+	Receiver string         `json:"receiver"`
+	Provider string         `json:"provider"`
+	Status   DeliveryStatus `json:"status"`
+	Attempts int            `json:"attempts"` // 提交供应商的次数
+	ErrCode  string         `json:"errCode"`
+	ErrMsg   string         `json:"errMsg"`
+}
+
+// NewDeadLetter 根据失败的通知及其接收者记录生成死信，err 为最后一次发送的错误，可以为 nil
+func NewDeadLetter(n Notification, err error) DeadLetter {
+	dl := DeadLetter{
+		NotificationID:    n.ID,
+		BizID:             n.BizID,
+		Key:               n.Key,
+		Channel:           n.Channel,
+		TemplateID:        n.Template.ID,
+		TemplateVersionID: n.Template.VersionID,
+		RetryCount:        n.RetryCount,
+		Attempts:          make([]DeadLetterAttempt, 0, len(n.Deliveries)),
+		Status:            DeadLetterStatusPending,
+	}
+	var deliveryErrMsg string
+	for _, d := range n.Deliveries {
+		dl.Attempts = append(dl.Attempts, DeadLetterAttempt{
+			Receiver: d.Receiver,
+			Provider: d.Provider,
+			Status:   d.Status,
+			Attempts: d.Attempts,
+			ErrCode:  d.ErrCode,
+			ErrMsg:   d.ErrMsg,
+		})
+		if d.Provider != "" {
+			dl.Provider = d.Provider
+		}
+		if d.ErrMsg != "" {
+			deliveryErrMsg = d.ErrMsg
+		}
+	}
+
+	dl.ErrCategory = errCategoryOf(err, deliveryErrMsg != "")
+	if err != nil {
+		dl.ErrMsg = err.Error()
+	} else {
+		dl.ErrMsg = deliveryErrMsg
+	}
+	if r := []rune(dl.ErrMsg); len(r) > maxDeadLetterErrMsgLen {
+		dl.ErrMsg = string(r[:maxDeadLetterErrMsgLen])
+	}
+	return dl
+}
+
+func errCategoryOf(err error, rejected bool) ErrCategory {
+	if err == nil {
+		if rejected {
+			return ErrCategoryProviderRejected
+		}
+		return ErrCategoryUnknownResult
+	}
+	for _, c := range errCategories {
+		for _, target := range c.targets {
+			if errors.Is(err, target) {
+				return c.category
+			}
+		}
+	}
+	return ErrCategoryProviderError
+}
+
+// DeadLetterQuery 死信分页查询条件
+type DeadLetterQuery struct {
+	BizID     int64   // 业务ID
+	Channel   Channel // 渠道，为空时不限
+	StartTime int64   // 进入死信的时间下限，毫秒时间戳，0表示不限
+	EndTime   int64   // 进入死信的时间上限，毫秒时间戳，0表示不限
+	Status    DeadLetterStatus
+	Cursor    int64 // 游标，上一页最后一条死信的ID，0表示从头开始
+	Limit     int   // 每页条数
+}
+
+// ReplayOptions 重放死信时的可选修改
+type ReplayOptions struct {
+	Channel           Channel // 改用的渠道，必须是原渠道或者通知配置过的降级渠道，为空时不修改
+	TemplateVersionID int64   // 指定发送的模板版本，为 0 时使用模板当前的发布版本
+}
@@ -0,0 +1,137 @@
+package domain
+
+import "strings"
+
+// DeliveryStatus 单个接收者的发送和送达状态
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending      DeliveryStatus = "PENDING"       // 随通知创建，尚未提交供应商
+	DeliveryStatusSubmitFailed DeliveryStatus = "SUBMIT_FAILED" // 提交供应商失败，重试时会重新发送
+	DeliveryStatusSubmitted    DeliveryStatus = "SUBMITTED"     // 已提交供应商，该渠道没有回执
+	DeliveryStatusWaiting      DeliveryStatus = "WAITING"       // 已提交供应商，等待回执
+	DeliveryStatusDelivered    DeliveryStatus = "DELIVERED"     // 用户已接收
+	DeliveryStatusUndelivered  DeliveryStatus = "UNDELIVERED"   // 用户未接收或回执超时
+)
+
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
+
+// IsFinal 是否为回执终态
+func (s DeliveryStatus) IsFinal() bool {
+	return s == DeliveryStatusDelivered || s == DeliveryStatusUndelivered
+}
+
+// IsSubmitted 是否已经被供应商受理，受理过的接收者重试时不再发送
+func (s DeliveryStatus) IsSubmitted() bool {
+	return s != DeliveryStatusPending && s != DeliveryStatusSubmitFailed
+}
+
+// Delivery 通知在单个接收者上的发送和送达记录
+type Delivery struct {
+	ID             int64
+	NotificationID int64
+	BizID          int64
+	Receiver       string  // 接收者，手机号去掉+86前缀
+	Channel        Channel // 渠道
+	Provider       string  // 实际发送的供应商
+	SerialNo       string  // 供应商回执ID，阿里云为 BizId，腾讯云为 SerialNo
+	Status         DeliveryStatus
+	ErrCode        string // 供应商返回的错误码
+	ErrMsg         string // 供应商返回的错误描述
+	ReportTime     int64  // 回执时间
+	NextQueryTime  int64  // 下一次主动拉取回执的时间
+	Attempts       int    // 提交供应商的次数
+	Ctime          int64
+	Utime          int64
+}
+
+// UnsubmittedReceivers 过滤掉已经被供应商受理的接收者，没有记录的接收者视为未发送
+func UnsubmittedReceivers(receivers []string, deliveries []Delivery) []string {
+	submitted := make(map[string]struct{}, len(deliveries))
+	for i := range deliveries {
+		if deliveries[i].Status.IsSubmitted() {
+			submitted[TrimPhonePrefix(deliveries[i].Receiver)] = struct{}{}
+		}
+	}
+	res := make([]string, 0, len(receivers))
+	for _, r := range receivers {
+		if _, ok := submitted[TrimPhonePrefix(r)]; !ok {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// HasAttempted 接收者中是否有提交过供应商但没有拿到受理结果的，这些接收者重发前需要先向供应商核对
+func HasAttempted(receivers []string, deliveries []Delivery) bool {
+	attempted := make(map[string]struct{}, len(deliveries))
+	for i := range deliveries {
+		if deliveries[i].Attempts > 0 {
+			attempted[TrimPhonePrefix(deliveries[i].Receiver)] = struct{}{}
+		}
+	}
+	for _, r := range receivers {
+		if _, ok := attempted[TrimPhonePrefix(r)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeDeliveries 用新的记录覆盖同一接收者之前的记录
+func MergeDeliveries(previous, results []Delivery) []Delivery {
+	merged := make([]Delivery, 0, len(previous)+len(results))
+	updated := make(map[string]struct{}, len(results))
+	for i := range results {
+		updated[TrimPhonePrefix(results[i].Receiver)] = struct{}{}
+	}
+	for i := range previous {
+		if _, ok := updated[TrimPhonePrefix(previous[i].Receiver)]; !ok {
+			merged = append(merged, previous[i])
+		}
+	}
+	return append(merged, results...)
+}
+
+// DeliveriesSendStatus 根据各接收者的记录汇总通知的发送状态
+func DeliveriesSendStatus(deliveries []Delivery) SendStatus {
+	var submitted int
+	for i := range deliveries {
+		if deliveries[i].Status.IsSubmitted() {
+			submitted++
+		}
+	}
+	switch {
+	case submitted == 0:
+		return SendStatusFailed
+	case submitted == len(deliveries):
+		return SendStatusSucceeded
+	default:
+		return SendStatusPartiallySucceeded
+	}
+}
+
+// DeliveryReport 供应商回执，来自主动拉取或供应商推送
+type DeliveryReport struct {
+	Provider   string
+	SerialNo   string
+	Receiver   string
+	Status     DeliveryStatus
+	ErrCode    string
+	ErrMsg     string
+	ReportTime int64
+}
+
+// Match 判断回执是否属于该送达记录，腾讯云同一个 SerialNo 只对应一个号码，阿里云同一个 BizId 对应整批号码
+func (d Delivery) Match(report DeliveryReport) bool {
+	return d.Provider == report.Provider &&
+		d.SerialNo == report.SerialNo &&
+		TrimPhonePrefix(d.Receiver) == TrimPhonePrefix(report.Receiver)
+}
+
+// TrimPhonePrefix 去掉中国大陆手机号的国家码前缀
+func TrimPhonePrefix(phone string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(phone, "+86"), "0086")
+}
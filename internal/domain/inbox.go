@@ -0,0 +1,24 @@
+package domain
+
+// InboxMessage 站内信收件箱中的一条消息
+type InboxMessage struct {
+	ID             int64  // 消息ID
+	BizID          int64  // 业务ID
+	NotificationID int64  // 关联的通知ID
+	Receiver       string // 接收者
+	Title          string // 标题
+	Content        string // 渲染后的内容
+	Read           bool   // 是否已读
+	ReadTime       int64  // 阅读时间
+	Ctime          int64  // 创建时间
+	Utime          int64  // 更新时间
+}
+
+// InboxQuery 收件箱分页查询条件
+type InboxQuery struct {
+	BizID      int64  // 业务ID
+	Receiver   string // 接收者
+	Cursor     int64  // 游标，上一页最后一条消息的ID，0表示从头开始
+	Limit      int    // 每页条数
+	UnreadOnly bool   // 是否只查询未读消息
+}
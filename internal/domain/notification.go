@@ -5,6 +5,7 @@ import (
 	"fmt"
 	notificationv1 "go-notification/api/proto/gen/notification/v1"
 	"go-notification/internal/errs"
+	"go-notification/internal/pkg/retry"
 	"strconv"
 	"time"
 )
@@ -14,10 +15,20 @@ type Template struct {
 	ID        int64             `json:"id"`
 	VersionID int64             `json:"versionId"`
 	Params    map[string]string `json:"params"`
+	// VersionPinned 为 true 时按 VersionID 指定的版本发送，否则使用模板当前的发布版本
+	VersionPinned bool `json:"versionPinned"`
 
 	Version int64 `json:"version"`
 }
 
+// SendVersionID 发送时使用的模板版本，返回 0 表示使用当前的发布版本
+func (t Template) SendVersionID() int64 {
+	if t.VersionPinned {
+		return t.VersionID
+	}
+	return 0
+}
+
 // SendStatus 通知状态
 type SendStatus string
 
@@ -28,12 +39,28 @@ const (
 	SendStatusSending   SendStatus = "SENDING"   // 待发送
 	SendStatusSucceeded SendStatus = "SUCCEEDED" // 发送成功
 	SendStatusFailed    SendStatus = "FAILED"    // 发送失败
+	// 部分接收者发送成功，重试时只发送失败的接收者
+	SendStatusPartiallySucceeded SendStatus = "PARTIALLY_SUCCEEDED"
+	// 接收者的发送频率超过上限，没有发送，扣减的额度已经归还
+	SendStatusFrequencyCapped SendStatus = "FREQUENCY_CAPPED"
+	// 等待合并到汇总通知发送，汇总通知发送结束后改为汇总通知的状态
+	SendStatusDigesting SendStatus = "DIGESTING"
 )
 
 func (s SendStatus) String() string {
 	return string(s)
 }
 
+// IsFinal 发送已经结束，不会再变化
+func (s SendStatus) IsFinal() bool {
+	switch s {
+	case SendStatusSucceeded, SendStatusFailed, SendStatusPartiallySucceeded, SendStatusCanceled, SendStatusFrequencyCapped:
+		return true
+	default:
+		return false
+	}
+}
+
 type Notification struct {
 	ID                 int64              `json:"id"`             // 通知唯一标识
 	BizID              int64              `json:"bizId"`          // 业务唯一标识
@@ -46,6 +73,47 @@ type Notification struct {
 	ScheduledETime     time.Time          `json:"scheduledETime"` // 计划发送结束时间
 	Version            int                `json:"version"`        // 版本号
 	SendStrategyConfig SendStrategyConfig `json:"sendStrategyConfig"`
+	FallbackTemplates  map[Channel]int64  `json:"fallbackTemplates"` // 降级渠道 -> 模板ID
+	DeliveredChannel   Channel            `json:"deliveredChannel"`  // 实际送达的渠道
+	Deliveries         []Delivery         `json:"deliveries"`        // 各接收者的发送和送达记录
+	RetryCount         int32              `json:"retryCount"`        // 自动重试次数
+	ReceiverTimezone   string             `json:"receiverTimezone"`  // 接收者时区，免打扰时段按接收者时区计算
+	DigestID           int64              `json:"digestId"`          // 合并发送的汇总通知ID
+	Ctime              int64              `json:"ctime"`             // 创建时间
+	Utime              int64              `json:"utime"`             // 更新时间
+}
+
+// resendWindow 重新发送时允许调度发送的时间范围
+// 调度只发送没有过期的待发送通知，范围要足够覆盖调度积压，否则重试会一直停留在待发送
+const resendWindow = 24 * time.Hour
+
+// ScheduleResend 改回待发送，在 at 之后由调度重新发送
+func (n *Notification) ScheduleResend(at time.Time) {
+	n.Status = SendStatusPending
+	n.ScheduledSTime = at
+	n.ScheduledETime = at.Add(resendWindow)
+}
+
+// NextRetryInterval 根据渠道配置的重试策略计算下一次自动重试的间隔，不能再重试时第二个返回值为 false
+func (n *Notification) NextRetryInterval(cfg *ChannelConfig) (time.Duration, bool) {
+	if cfg == nil || cfg.RetryPolicy == nil {
+		return 0, false
+	}
+	s, err := retry.NewRetry(*cfg.RetryPolicy)
+	if err != nil {
+		return 0, false
+	}
+	return s.NextWithRetries(n.RetryCount + 1)
+}
+
+// minDeferWindow 推迟后允许调度发送的最短时间范围
+const minDeferWindow = time.Minute
+
+// DeferTo 保持原来的发送时间范围，推迟到 at 开始发送
+func (n *Notification) DeferTo(at time.Time) {
+	window := max(n.ScheduledETime.Sub(n.ScheduledSTime), minDeferWindow)
+	n.ScheduledSTime = at
+	n.ScheduledETime = at.Add(window)
 }
 
 func (n *Notification) SetSendTime() {
@@ -99,6 +167,15 @@ func (n *Notification) Validate() error {
 		return err
 	}
 
+	if n.SendStrategyConfig.Type == SendStrategyDigest {
+		if !n.Channel.SupportsDigest() {
+			return fmt.Errorf("%w: 渠道 %s 不支持汇总发送", errs.ErrInvalidParameter, n.Channel)
+		}
+		if len(n.Receivers) != 1 {
+			return fmt.Errorf("%w: 汇总发送的通知只能有一个接收者", errs.ErrInvalidParameter)
+		}
+	}
+
 	return nil
 }
 
@@ -109,6 +186,11 @@ func (n *Notification) IsValidBizID() error {
 	return nil
 }
 
+// IdempotencyKey 由通知ID生成的幂等标识，重试时保持不变，随请求传给供应商用于去重和核对
+func (n *Notification) IdempotencyKey() string {
+	return "ntf-" + strconv.FormatInt(n.ID, 10)
+}
+
 func (n *Notification) MarshalReceivers() (string, error) {
 	return n.marshal(n.Receivers)
 }
@@ -117,6 +199,37 @@ func (n *Notification) MarshalTemplateParms() (string, error) {
 	return n.marshal(n.Template.Params)
 }
 
+func (n *Notification) MarshalFallbackTemplates() (string, error) {
+	if len(n.FallbackTemplates) == 0 {
+		return "", nil
+	}
+	return n.marshal(n.FallbackTemplates)
+}
+
+// WithFallbackChannel 返回改用降级渠道及其模板后的通知，
+// 该渠道没有关联模板或接收者格式不能用于该渠道（如把手机号当作邮箱）时返回错误
+func (n *Notification) WithFallbackChannel(channel Channel) (Notification, error) {
+	if channel == n.Channel {
+		return *n, nil
+	}
+	tid, ok := n.FallbackTemplates[channel]
+	if !ok {
+		return Notification{}, fmt.Errorf("%w: 通知没有配置渠道 %s 的模板", errs.ErrInvalidParameter, channel)
+	}
+	for _, r := range n.Receivers {
+		if !channel.IsValidReceiver(r) {
+			return Notification{}, fmt.Errorf("%w: 接收者 %s 不能用于渠道 %s", errs.ErrInvalidParameter, r, channel)
+		}
+	}
+	fallback := *n
+	fallback.Channel = channel
+	fallback.Template.ID = tid
+	// 降级渠道使用对应模板当前的发布版本
+	fallback.Template.VersionID = 0
+	fallback.Template.VersionPinned = false
+	return fallback, nil
+}
+
 func (n *Notification) marshal(v any) (string, error) {
 	jsonBytes, err := json.Marshal(v)
 	if err != nil {
@@ -135,11 +248,22 @@ func NewNotificationFromAPI(n *notificationv1.Notification) (Notification, error
 		return Notification{}, fmt.Errorf("%w: 模板ID: %s", errs.ErrInvalidParameter, n.TemplateId)
 	}
 
-	channel, err := getDomainChannel(n)
+	channel, err := ChannelFromAPI(n.Channel)
 	if err != nil {
 		return Notification{}, err
 	}
 
+	fallbackTemplates, err := getDomainFallbackTemplates(n)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	if n.ReceiverTimezone != "" {
+		if _, err = time.LoadLocation(n.ReceiverTimezone); err != nil {
+			return Notification{}, fmt.Errorf("%w: 接收者时区: %s", errs.ErrInvalidParameter, n.ReceiverTimezone)
+		}
+	}
+
 	return Notification{
 		Key:       n.Key,
 		Receivers: n.GetReceivers(),
@@ -149,10 +273,36 @@ func NewNotificationFromAPI(n *notificationv1.Notification) (Notification, error
 			Params: n.TemplateParams,
 		},
 		SendStrategyConfig: getDomainSendStrategyConfig(n),
+		FallbackTemplates:  fallbackTemplates,
+		ReceiverTimezone:   n.ReceiverTimezone,
 	}, nil
 }
 
+func getDomainFallbackTemplates(n *notificationv1.Notification) (map[Channel]int64, error) {
+	if len(n.FallbackTemplates) == 0 {
+		return nil, nil
+	}
+	templates := make(map[Channel]int64, len(n.FallbackTemplates))
+	for _, t := range n.FallbackTemplates {
+		channel, err := ChannelFromAPI(t.GetChannel())
+		if err != nil {
+			return nil, err
+		}
+		tid, err := strconv.ParseInt(t.GetTemplateId(), 10, 64)
+		if err != nil || tid <= 0 {
+			return nil, fmt.Errorf("%w: 降级模板ID: %s", errs.ErrInvalidParameter, t.GetTemplateId())
+		}
+		templates[channel] = tid
+	}
+	return templates, nil
+}
+
 func getDomainSendStrategyConfig(n *notificationv1.Notification) SendStrategyConfig {
+	return SendStrategyConfigFromAPI(n.SendStrategy)
+}
+
+// SendStrategyConfigFromAPI 将gRPC层的发送策略转换为领域层的发送策略，未指定时立即发送
+func SendStrategyConfigFromAPI(strategy *notificationv1.SendStrategy) SendStrategyConfig {
 	// 构建发送策列
 	sendStrategyType := SendStrategyImmediate // 默认立即发送
 	var delaySeconds int64
@@ -160,10 +310,12 @@ func getDomainSendStrategyConfig(n *notificationv1.Notification) SendStrategyCon
 	var startTimeMilliseconds int64
 	var endTimeMilliseconds int64
 	var deadlineTime time.Time
+	var recurring *notificationv1.SendStrategy_RecurringStrategy
+	var digest *notificationv1.SendStrategy_DigestStrategy
 
 	// 处理发送策略
-	if n.SendStrategy != nil {
-		switch s := n.SendStrategy.StrategyType.(type) {
+	if strategy != nil {
+		switch s := strategy.StrategyType.(type) {
 		case *notificationv1.SendStrategy_Immediate:
 			sendStrategyType = SendStrategyImmediate
 		case *notificationv1.SendStrategy_Delayed:
@@ -187,9 +339,19 @@ func getDomainSendStrategyConfig(n *notificationv1.Notification) SendStrategyCon
 				deadlineTime = s.Deadline.Deadline.AsTime()
 				sendStrategyType = SendStrategyDeadline
 			}
+		case *notificationv1.SendStrategy_Recurring:
+			if s.Recurring != nil {
+				recurring = s.Recurring
+				sendStrategyType = SendStrategyRecurring
+			}
+		case *notificationv1.SendStrategy_Digest:
+			if s.Digest != nil {
+				digest = s.Digest
+				sendStrategyType = SendStrategyDigest
+			}
 		}
 	}
-	return SendStrategyConfig{
+	cfg := SendStrategyConfig{
 		Type:         sendStrategyType,
 		Delay:        time.Duration(delaySeconds) * time.Second,
 		ScheduleTime: scheduleTime,
@@ -197,16 +359,38 @@ func getDomainSendStrategyConfig(n *notificationv1.Notification) SendStrategyCon
 		EndTime:      time.Unix(endTimeMilliseconds, 0),
 		DeadlineTime: deadlineTime,
 	}
+	if recurring != nil {
+		cfg.CronExpr = recurring.CronExpression
+		cfg.Timezone = recurring.Timezone
+		cfg.MaxOccurrences = recurring.MaxOccurrences
+		if recurring.EndTime != nil {
+			cfg.RecurringEnd = recurring.EndTime.AsTime()
+		}
+	}
+	if digest != nil {
+		cfg.DigestGroup = digest.GroupKey
+		// 模板ID格式错误时为 0，校验时返回参数错误
+		cfg.DigestTemplateID, _ = strconv.ParseInt(digest.DigestTemplateId, 10, 64)
+		cfg.DigestWindow = time.Duration(digest.WindowSeconds) * time.Second
+	}
+	return cfg
 }
 
-func getDomainChannel(n *notificationv1.Notification) (Channel, error) {
-	switch n.Channel {
+// ChannelFromAPI 将gRPC层的渠道转换为领域层的渠道
+func ChannelFromAPI(channel notificationv1.Channel) (Channel, error) {
+	switch channel {
 	case notificationv1.Channel_SMS:
 		return ChannelSMS, nil
 	case notificationv1.Channel_EMAIL:
 		return ChannelEmail, nil
 	case notificationv1.Channel_IN_APP:
 		return ChannelInApp, nil
+	case notificationv1.Channel_WEBHOOK:
+		return ChannelWebhook, nil
+	case notificationv1.Channel_IM:
+		return ChannelIM, nil
+	case notificationv1.Channel_PUSH:
+		return ChannelPush, nil
 	default:
 		return "", fmt.Errorf("%w: 无效的渠道类型", errs.ErrInvalidParameter)
 	}
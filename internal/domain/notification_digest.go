@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 汇总模板可以使用的参数
+const (
+	DigestParamCount = "count" // 合并的通知数量
+	DigestParamItems = "items" // 每条通知按自己的模板渲染后的内容，每行一条
+)
+
+// digestSendWindow 汇总通知允许调度发送的时间范围
+const digestSendWindow = 30 * time.Minute
+
+// SupportsDigest 站内信和邮件支持汇总发送
+func (c Channel) SupportsDigest() bool {
+	return c.IsInApp() || c.IsEmail()
+}
+
+// DigestGroupKey 汇总分组，同一分组的通知合并为一条汇总通知发送
+type DigestGroupKey struct {
+	BizID    int64
+	Channel  Channel
+	Receiver string
+	Group    string
+}
+
+// DigestGroupKey 汇总发送的通知所在的分组
+func (n *Notification) DigestGroupKey() DigestGroupKey {
+	var receiver string
+	if len(n.Receivers) > 0 {
+		receiver = n.Receivers[0]
+	}
+	return DigestGroupKey{
+		BizID:    n.BizID,
+		Channel:  n.Channel,
+		Receiver: receiver,
+		Group:    n.SendStrategyConfig.DigestGroup,
+	}
+}
+
+// NewDigestNotification 合并同一分组的通知，contents 为每条通知渲染后的内容，由调度发送
+// 业务内唯一标识由分组和第一条通知的ID组成，重复合并同一批通知时不会重复发送
+func NewDigestNotification(items []Notification, contents []string, now time.Time) Notification {
+	first := items[0]
+	cfg := first.SendStrategyConfig
+	return Notification{
+		BizID:     first.BizID,
+		Key:       fmt.Sprintf("digest#%s#%d", cfg.DigestGroup, first.ID),
+		Receivers: first.Receivers,
+		Channel:   first.Channel,
+		Template: Template{
+			ID: cfg.DigestTemplateID,
+			Params: map[string]string{
+				DigestParamCount: strconv.Itoa(len(items)),
+				DigestParamItems: strings.Join(contents, "\n"),
+			},
+		},
+		Status:             SendStatusPending,
+		ScheduledSTime:     now,
+		ScheduledETime:     now.Add(digestSendWindow),
+		SendStrategyConfig: SendStrategyConfig{Type: SendStrategyWindow, StartTime: now, EndTime: now.Add(digestSendWindow)},
+		ReceiverTimezone:   first.ReceiverTimezone,
+	}
+}
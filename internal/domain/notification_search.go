@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"encoding/base64"
+	"fmt"
+	"go-notification/internal/errs"
+	"strconv"
+	"strings"
+)
+
+// NotificationSortField 通知检索的排序字段，相同时按ID排序
+type NotificationSortField string
+
+const (
+	NotificationSortByCtime NotificationSortField = "ctime" // 按创建时间排序
+	NotificationSortByUtime NotificationSortField = "utime" // 按更新时间排序
+)
+
+// IsValid 是否是支持的排序字段
+func (f NotificationSortField) IsValid() bool {
+	return f == NotificationSortByCtime || f == NotificationSortByUtime
+}
+
+// NotificationSearch 通知检索条件，所有条件都限定在业务ID之下，零值表示不限
+type NotificationSearch struct {
+	BizID      int64
+	Receiver   string       // 接收者，手机号带不带国家码前缀都能匹配
+	Channel    Channel      // 渠道
+	Statuses   []SendStatus // 发送状态，满足其一即可
+	TemplateID int64        // 模板ID
+	StartTime  int64        // 创建时间下限，毫秒时间戳
+	EndTime    int64        // 创建时间上限，毫秒时间戳
+	KeyPrefix  string       // 业务内唯一标识的前缀
+	SortBy     NotificationSortField
+	Ascending  bool               // 是否升序，默认倒序
+	Cursor     NotificationCursor // 上一页最后一条记录的位置，零值表示从头开始
+	Limit      int                // 每页条数
+}
+
+// NotificationCursor 检索的游标，记录上一页最后一条记录的排序字段值和ID
+type NotificationCursor struct {
+	SortValue int64
+	ID        int64
+}
+
+// IsZero 是否是首页
+func (c NotificationCursor) IsZero() bool {
+	return c.ID == 0
+}
+
+// CursorOf 以通知作为上一页最后一条记录生成游标
+func (q NotificationSearch) CursorOf(n Notification) NotificationCursor {
+	if q.SortBy == NotificationSortByUtime {
+		return NotificationCursor{SortValue: n.Utime, ID: n.ID}
+	}
+	return NotificationCursor{SortValue: n.Ctime, ID: n.ID}
+}
+
+// Encode 编码为对调用方不透明的字符串，首页游标编码为空字符串
+func (c NotificationCursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+	raw := strconv.FormatInt(c.SortValue, 10) + ":" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseNotificationCursor 解析 Encode 生成的游标，空字符串表示首页
+func ParseNotificationCursor(s string) (NotificationCursor, error) {
+	if s == "" {
+		return NotificationCursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return NotificationCursor{}, fmt.Errorf("%w: 游标格式错误", errs.ErrInvalidParameter)
+	}
+	value, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return NotificationCursor{}, fmt.Errorf("%w: 游标格式错误", errs.ErrInvalidParameter)
+	}
+	var c NotificationCursor
+	if c.SortValue, err = strconv.ParseInt(value, 10, 64); err != nil {
+		return NotificationCursor{}, fmt.Errorf("%w: 游标格式错误", errs.ErrInvalidParameter)
+	}
+	if c.ID, err = strconv.ParseInt(id, 10, 64); err != nil || c.ID <= 0 {
+		return NotificationCursor{}, fmt.Errorf("%w: 游标格式错误", errs.ErrInvalidParameter)
+	}
+	return c, nil
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"fmt"
+	"github.com/robfig/cron/v3"
+	"time"
+)
+
+// SeriesStatus 周期发送的状态
+type SeriesStatus string
+
+const (
+	SeriesStatusActive    SeriesStatus = "ACTIVE"    // 按计划发送
+	SeriesStatusPaused    SeriesStatus = "PAUSED"    // 已暂停，恢复后从恢复时刻之后的下一次开始发送
+	SeriesStatusStopped   SeriesStatus = "STOPPED"   // 业务方停止，不能再恢复
+	SeriesStatusCompleted SeriesStatus = "COMPLETED" // 达到截止时间或最多次数
+)
+
+func (s SeriesStatus) String() string {
+	return string(s)
+}
+
+// SeriesOccurrenceWindow 每次发送的通知从计划时间开始可以发送的时长，错过窗口的发送不再补发
+const SeriesOccurrenceWindow = 10 * time.Minute
+
+// NotificationSeries 按 cron 表达式周期发送的通知
+// 每到发送时间生成一条子通知，子通知的业务内唯一标识由周期发送的标识和计划发送时间组成，
+// 因此幂等、状态查询和回调都按每一次发送独立进行
+type NotificationSeries struct {
+	ID    int64
+	BizID int64
+	Key   string
+	// Notification 每次发送的通知内容，发送策略为周期发送策略
+	Notification Notification
+	Occurrences  int32     // 已经生成的子通知数量
+	NextTime     time.Time // 下一次发送时间
+	Status       SeriesStatus
+	Version      int
+	Ctime        int64
+	Utime        int64
+}
+
+// NewNotificationSeries 从周期发送的通知创建，第一次发送时间为当前时间之后的第一个触发时间
+func NewNotificationSeries(n Notification, now time.Time) (NotificationSeries, error) {
+	schedule, err := n.SendStrategyConfig.RecurringSchedule()
+	if err != nil {
+		return NotificationSeries{}, err
+	}
+	s := NotificationSeries{
+		ID:           n.ID,
+		BizID:        n.BizID,
+		Key:          n.Key,
+		Notification: n,
+		NextTime:     schedule.Next(now),
+		Status:       SeriesStatusActive,
+	}
+	s.completeIfExhausted()
+	return s, nil
+}
+
+// OccurrenceKey 计划在 at 发送的子通知的业务内唯一标识，为周期发送的标识加上计划发送时间的秒级时间戳
+// 按计划时间而不是序号生成，暂停恢复或者重复生成时同一次发送的标识不变
+func (s NotificationSeries) OccurrenceKey(at time.Time) string {
+	return fmt.Sprintf("%s#%d", s.Key, at.Unix())
+}
+
+// NextOccurrence 生成下一次发送的子通知，在下一次发送时间开始的窗口内发送
+func (s NotificationSeries) NextOccurrence() Notification {
+	n := s.Notification
+	n.ID = 0
+	n.Key = s.OccurrenceKey(s.NextTime)
+	n.Status = ""
+	n.Version = 0
+	n.SendStrategyConfig = SendStrategyConfig{
+		Type:      SendStrategyWindow,
+		StartTime: s.NextTime,
+		EndTime:   s.NextTime.Add(SeriesOccurrenceWindow),
+	}
+	return n
+}
+
+// Missed 下一次发送时间已经错过发送窗口，例如任务长时间没有运行或者暂停期间
+func (s NotificationSeries) Missed(now time.Time) bool {
+	return s.NextTime.Add(SeriesOccurrenceWindow).Before(now)
+}
+
+// Advance 计算下一次发送时间，occurred 表示本次是否生成了子通知，达到截止时间或最多次数时结束
+// 错过发送窗口的触发时间直接跳过
+func (s *NotificationSeries) Advance(schedule cron.Schedule, occurred bool, now time.Time) {
+	if occurred {
+		s.Occurrences++
+	}
+	from := s.NextTime
+	if earliest := now.Add(-SeriesOccurrenceWindow); from.Before(earliest) {
+		from = earliest
+	}
+	s.NextTime = schedule.Next(from)
+	s.completeIfExhausted()
+}
+
+// Resume 从恢复时刻之后的下一个触发时间继续发送
+func (s *NotificationSeries) Resume(schedule cron.Schedule, now time.Time) {
+	s.Status = SeriesStatusActive
+	s.NextTime = schedule.Next(now)
+	s.completeIfExhausted()
+}
+
+// IsFinished 已经停止或者完成，不会再发送
+func (s NotificationSeries) IsFinished() bool {
+	return s.Status == SeriesStatusStopped || s.Status == SeriesStatusCompleted
+}
+
+func (s *NotificationSeries) completeIfExhausted() {
+	cfg := s.Notification.SendStrategyConfig
+	if s.NextTime.IsZero() ||
+		(cfg.MaxOccurrences > 0 && s.Occurrences >= cfg.MaxOccurrences) ||
+		(!cfg.RecurringEnd.IsZero() && s.NextTime.After(cfg.RecurringEnd)) {
+		s.Status = SeriesStatusCompleted
+	}
+}
@@ -0,0 +1,65 @@
+package domain
+
+import "context"
+
+// TransitionActor 触发通知状态变化的一方
+type TransitionActor string
+
+const (
+	TransitionActorAPI         TransitionActor = "API"          // 业务方通过接口触发
+	TransitionActorScheduler   TransitionActor = "SCHEDULER"    // 调度发送
+	TransitionActorTxCheck     TransitionActor = "TX_CHECK"     // 事务消息回查
+	TransitionActorTimeoutTask TransitionActor = "TIMEOUT_TASK" // 发送超时核对
+	TransitionActorReplay      TransitionActor = "REPLAY"       // 死信重放
+	TransitionActorSeries      TransitionActor = "SERIES"       // 周期发送生成子通知
+	TransitionActorDigest      TransitionActor = "DIGEST"       // 汇总发送
+	TransitionActorCampaign    TransitionActor = "CAMPAIGN"     // 活动生成通知
+)
+
+func (a TransitionActor) String() string {
+	return string(a)
+}
+
+// NotificationTransition 通知的一次状态变化，只追加不修改
+type NotificationTransition struct {
+	ID             int64
+	NotificationID int64
+	BizID          int64
+	Key            string
+	FromStatus     SendStatus // 创建时为空
+	ToStatus       SendStatus
+	Actor          TransitionActor
+	Provider       string // 最近一次提交的供应商
+	ErrMsg         string
+	Ctime          int64
+}
+
+type transitionCtxKey struct{}
+
+type transitionCtx struct {
+	actor TransitionActor
+	err   error
+}
+
+// CtxWithTransitionActor 标记后续状态变化的触发方
+func CtxWithTransitionActor(ctx context.Context, actor TransitionActor) context.Context {
+	tc, _ := ctx.Value(transitionCtxKey{}).(transitionCtx)
+	tc.actor = actor
+	return context.WithValue(ctx, transitionCtxKey{}, tc)
+}
+
+// CtxWithTransitionError 记录导致状态变化的错误，保留已经标记的触发方
+func CtxWithTransitionError(ctx context.Context, err error) context.Context {
+	tc, _ := ctx.Value(transitionCtxKey{}).(transitionCtx)
+	tc.err = err
+	return context.WithValue(ctx, transitionCtxKey{}, tc)
+}
+
+// TransitionFromCtx 取出触发方和错误，没有标记触发方时视为业务方通过接口触发
+func TransitionFromCtx(ctx context.Context) (TransitionActor, error) {
+	tc, _ := ctx.Value(transitionCtxKey{}).(transitionCtx)
+	if tc.actor == "" {
+		return TransitionActorAPI, tc.err
+	}
+	return tc.actor, tc.err
+}
@@ -1,17 +1,30 @@
 package domain
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"go-notification/internal/errs"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
 )
 
+var mobilePattern = regexp.MustCompile(`^\+?\d{6,15}$`)
+
+// minPushTokenLen 设备推送令牌的最小长度，APNs 与 FCM 的令牌都不短于该值
+const minPushTokenLen = 32
+
 // Channel 发送渠道
 type Channel string
 
 const (
-	ChannelSMS   Channel = "SMS"    // 短信
-	ChannelEmail Channel = "EMAIL"  // 邮件
-	ChannelInApp Channel = "IN_APP" // 站内信
+	ChannelSMS     Channel = "SMS"     // 短信
+	ChannelEmail   Channel = "EMAIL"   // 邮件
+	ChannelInApp   Channel = "IN_APP"  // 站内信
+	ChannelWebhook Channel = "WEBHOOK" // 回调业务方HTTP接口
+	ChannelIM      Channel = "IM"      // 企业IM群机器人
+	ChannelPush    Channel = "PUSH"    // 移动端推送
 )
 
 func (c Channel) String() string {
@@ -19,7 +32,7 @@ func (c Channel) String() string {
 }
 
 func (c Channel) IsValid() bool {
-	return c == ChannelSMS || c == ChannelEmail || c == ChannelInApp
+	return c == ChannelSMS || c == ChannelEmail || c == ChannelInApp || c == ChannelWebhook || c == ChannelIM || c == ChannelPush
 }
 
 func (c Channel) IsSMS() bool {
@@ -34,6 +47,38 @@ func (c Channel) IsInApp() bool {
 	return c == ChannelInApp
 }
 
+func (c Channel) IsWebhook() bool {
+	return c == ChannelWebhook
+}
+
+func (c Channel) IsIM() bool {
+	return c == ChannelIM
+}
+
+func (c Channel) IsPush() bool {
+	return c == ChannelPush
+}
+
+// IsValidReceiver 接收者格式是否能用于该渠道，站内信与IM的接收者是业务方用户ID，不做格式校验
+func (c Channel) IsValidReceiver(receiver string) bool {
+	switch c {
+	case ChannelSMS:
+		return mobilePattern.MatchString(receiver)
+	case ChannelEmail:
+		addr, err := mail.ParseAddress(receiver)
+		return err == nil && addr.Address == receiver
+	case ChannelWebhook:
+		u, err := url.Parse(receiver)
+		return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+	case ChannelPush:
+		return len(receiver) >= minPushTokenLen && !strings.ContainsAny(receiver, " @/")
+	case ChannelInApp, ChannelIM:
+		return receiver != ""
+	default:
+		return false
+	}
+}
+
 // ProviderStatus 供应商状态
 type ProviderStatus string
 
@@ -63,9 +108,18 @@ type Provider struct {
 	DailyLimit int // 每日请求数限制
 
 	AuditCallbackURL string         // 审核回调地址
+	ReceiptToken     string         // 回执推送令牌，配置在供应商控制台的回执地址中
 	Status           ProviderStatus // 供应商状态
 }
 
+// AuthenticateReceipt 校验回执推送携带的令牌，没有配置令牌的供应商不接受回执推送
+func (p *Provider) AuthenticateReceipt(token string) bool {
+	if p.ReceiptToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(p.ReceiptToken), []byte(token)) == 1
+}
+
 func (p *Provider) Validate() error {
 	if p.Name == "" {
 		return fmt.Errorf("%w: 供应商名称不能为空", errs.ErrInvalidParameter)
@@ -0,0 +1,12 @@
+package domain
+
+// InvalidPushToken 推送时被厂商判定为无效或已注销的设备令牌，业务方据此清理
+type InvalidPushToken struct {
+	ID       int64  // 记录ID
+	BizID    int64  // 业务ID
+	Provider string // 判定无效的供应商
+	Token    string // 设备令牌
+	Reason   string // 厂商返回的原因
+	Ctime    int64  // 首次标记时间
+	Utime    int64  // 最近一次标记时间
+}
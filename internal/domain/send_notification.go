@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"github.com/robfig/cron/v3"
 	"go-notification/internal/errs"
 	"time"
 )
@@ -15,6 +16,8 @@ const (
 	SendStrategyScheduled SendStrategyType = "SCHEDULED" // 定时发送
 	SendStrategyWindow    SendStrategyType = "WINDOW"    // 窗口发送
 	SendStrategyDeadline  SendStrategyType = "DEADLINE"  // 截止日期发送
+	SendStrategyRecurring SendStrategyType = "RECURRING" // 按 cron 表达式周期发送
+	SendStrategyDigest    SendStrategyType = "DIGEST"    // 同一接收者的多条通知合并汇总发送
 )
 
 // SendStrategyConfig 发送策略配置
@@ -25,8 +28,26 @@ type SendStrategyConfig struct {
 	StartTime    time.Time        `json:"startTime"`    // 窗口发送开始时间
 	EndTime      time.Time        `json:"endTime"`      // 窗口发送结束时间
 	DeadlineTime time.Time        `json:"deadlineTime"` // 截止日期
+
+	CronExpr       string    `json:"cronExpr"`       // 周期发送的 cron 表达式
+	Timezone       string    `json:"timezone"`       // 周期发送的时区，为空时使用 UTC
+	RecurringEnd   time.Time `json:"recurringEnd"`   // 周期发送的截止时间，零值表示不限
+	MaxOccurrences int32     `json:"maxOccurrences"` // 周期发送的最多次数，0 表示不限
+
+	DigestGroup      string        `json:"digestGroup"`      // 汇总分组，同一业务、接收者和分组的通知合并发送
+	DigestTemplateID int64         `json:"digestTemplateId"` // 汇总发送使用的模板
+	DigestWindow     time.Duration `json:"digestWindow"`     // 分组中第一条通知到达后等待合并的时长
 }
 
+// minRecurringInterval 周期发送两次之间的最小间隔
+const minRecurringInterval = time.Minute
+
+const (
+	minDigestWindow      = time.Minute
+	maxDigestWindow      = 24 * time.Hour
+	maxDigestGroupLength = 128
+)
+
 // SendTimeWindow 计算最早发送时间和最晚发送时间
 func (e SendStrategyConfig) SendTimeWindow() (stime, etime time.Time) {
 	switch e.Type {
@@ -45,6 +66,10 @@ func (e SendStrategyConfig) SendTimeWindow() (stime, etime time.Time) {
 	case SendStrategyScheduled:
 		const scheduledTimeTolerance = 3 * time.Second
 		return e.ScheduleTime.Add(-scheduledTimeTolerance), e.ScheduleTime
+	case SendStrategyDigest:
+		// 等待合并结束的时间，到时由汇总任务合并发送
+		flushTime := time.Now().Add(e.DigestWindow)
+		return flushTime, flushTime
 	default:
 		now := time.Now()
 		return now, now
@@ -72,14 +97,70 @@ func (e SendStrategyConfig) Validate() error {
 		if e.ScheduleTime.IsZero() || e.ScheduleTime.Before(time.Now()) {
 			return fmt.Errorf("%w: 定时发送策略需要指定未来的发送时间", errs.ErrInvalidParameter)
 		}
+	case SendStrategyRecurring:
+		return e.validateRecurring()
+	case SendStrategyDigest:
+		if e.DigestGroup == "" || len(e.DigestGroup) > maxDigestGroupLength {
+			return fmt.Errorf("%w: 汇总发送策略需要指定不超过 %d 个字符的分组", errs.ErrInvalidParameter, maxDigestGroupLength)
+		}
+		if e.DigestTemplateID <= 0 {
+			return fmt.Errorf("%w: 汇总发送策略需要指定汇总模板", errs.ErrInvalidParameter)
+		}
+		if e.DigestWindow < minDigestWindow || e.DigestWindow > maxDigestWindow {
+			return fmt.Errorf("%w: 汇总发送的等待时长应在 %s 到 %s 之间", errs.ErrInvalidParameter, minDigestWindow, maxDigestWindow)
+		}
 	}
 	return nil
 }
 
+func (e SendStrategyConfig) validateRecurring() error {
+	schedule, err := e.RecurringSchedule()
+	if err != nil {
+		return err
+	}
+	if e.MaxOccurrences < 0 {
+		return fmt.Errorf("%w: 周期发送的最多次数不能为负数", errs.ErrInvalidParameter)
+	}
+	first := schedule.Next(time.Now())
+	if first.IsZero() || (!e.RecurringEnd.IsZero() && first.After(e.RecurringEnd)) {
+		return fmt.Errorf("%w: 周期发送在截止时间之前没有发送时间", errs.ErrInvalidParameter)
+	}
+	if second := schedule.Next(first); !second.IsZero() && second.Sub(first) < minRecurringInterval {
+		return fmt.Errorf("%w: 周期发送的间隔不能小于 %s", errs.ErrInvalidParameter, minRecurringInterval)
+	}
+	return nil
+}
+
+// RecurringSchedule 解析周期发送的 cron 表达式，按指定的时区计算发送时间
+func (e SendStrategyConfig) RecurringSchedule() (cron.Schedule, error) {
+	if e.CronExpr == "" {
+		return nil, fmt.Errorf("%w: 周期发送策略需要指定 cron 表达式", errs.ErrInvalidParameter)
+	}
+	loc := time.UTC
+	if e.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(e.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("%w: 时区 %s", errs.ErrInvalidParameter, e.Timezone)
+		}
+	}
+	schedule, err := cron.ParseStandard(e.CronExpr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cron 表达式 %s: %w", errs.ErrInvalidParameter, e.CronExpr, err)
+	}
+	// 表达式中用 CRON_TZ 指定了时区并且没有单独指定时区时以表达式为准
+	if spec, ok := schedule.(*cron.SpecSchedule); ok && (e.Timezone != "" || spec.Location == time.Local) {
+		spec.Location = loc
+	}
+	return schedule, nil
+}
+
 // SendResponse 发送响应
 type SendResponse struct {
-	NotificationID int64
-	Status         SendStatus
+	NotificationID   int64
+	Status           SendStatus
+	DeliveredChannel Channel    // 实际送达的渠道
+	Deliveries       []Delivery // 各接收者的发送结果，供应商不区分接收者时为空
 }
 
 // BatchSendResponse 批量发送响应
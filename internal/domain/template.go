@@ -3,6 +3,7 @@ package domain
 import (
 	"fmt"
 	"go-notification/internal/errs"
+	"regexp"
 )
 
 // AuditStatus 审核状态
@@ -201,6 +202,7 @@ type ChannelTemplateVersion struct {
 	ChannelTemplateID        int64       // 模板id
 	Name                     string      // 版本名称
 	Signature                string      // 签名
+	Subject                  string      // 主题，邮件渠道为邮件主题，站内信渠道为标题
 	Content                  string      // 模板内容
 	Remark                   string      // 申请说明
 	AuditId                  int64       // 审核记录ID
@@ -215,6 +217,30 @@ type ChannelTemplateVersion struct {
 	Providers []ChannelTemplateProvider // 关联的所有供应商
 }
 
+// templateParamPattern 平台统一的模板变量格式，如 ${code}
+var templateParamPattern = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// RenderSubject 使用模板参数渲染主题
+func (v *ChannelTemplateVersion) RenderSubject(params map[string]string) string {
+	return renderTemplate(v.Subject, params)
+}
+
+// RenderContent 使用模板参数渲染模板内容
+func (v *ChannelTemplateVersion) RenderContent(params map[string]string) string {
+	return renderTemplate(v.Content, params)
+}
+
+// renderTemplate 替换内容中的变量，未提供参数的变量保持原样
+func renderTemplate(content string, params map[string]string) string {
+	return templateParamPattern.ReplaceAllStringFunc(content, func(placeholder string) string {
+		name := templateParamPattern.FindStringSubmatch(placeholder)[1]
+		if val, ok := params[name]; ok {
+			return val
+		}
+		return placeholder
+	})
+}
+
 // ChannelTemplateProvider 渠道模板供应商关联
 type ChannelTemplateProvider struct {
 	ID                       int64       // 关联ID
@@ -26,6 +26,15 @@ var (
 	ErrProviderNotFound                     = errors.New("供应商记录不存在")
 	ErrUnknownChannel                       = errors.New("未知渠道类型")
 	ErrInvalidOperation                     = errors.New("无效的操作")
+	ErrProviderRateLimited                  = errors.New("供应商限流，可稍后重试")
+	ErrProviderDailyLimitExceeded           = errors.New("供应商当日发送量已达上限")
+	ErrReconcileNotSupported                = errors.New("供应商不支持核对发送结果")
+	ErrInvalidReceiver                      = errors.New("接收者无效")
+	ErrNotificationSeriesNotFound           = errors.New("周期发送不存在")
+	ErrQuietHours                           = errors.New("免打扰时段内推迟发送")
+	ErrFrequencyCapped                      = errors.New("接收者的发送频率超过上限")
+	ErrCampaignNotFound                     = errors.New("活动不存在")
+	ErrReceiptUnauthorized                  = errors.New("回执推送未通过供应商令牌校验")
 
 	ErrCreateTemplateFailed                    = errors.New("创建模版失败")
 	ErrUpdateTemplateFailed                    = errors.New("更新模版失败")
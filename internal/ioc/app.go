@@ -2,6 +2,7 @@ package ioc
 
 import (
 	"context"
+	"github.com/gin-gonic/gin"
 	"github.com/robfig/cron/v3"
 	"go-notification/internal/pkg/grpcx"
 	"go-notification/internal/pkg/task"
@@ -9,6 +10,7 @@ import (
 
 type App struct {
 	GrpcServer *grpcx.Server
+	WebServer  *gin.Engine
 	Tasks      []task.Task
 	Cron       *cron.Cron
 }
@@ -8,7 +8,10 @@ import (
 	"google.golang.org/grpc"
 )
 
-func InitGRPCServer(notifiServer *igrpc.NotificationServer, logger logger.Logger) *grpcx.Server {
+func InitGRPCServer(notifiServer *igrpc.NotificationServer, inboxServer *igrpc.InboxServer,
+	deadLetterServer *igrpc.DeadLetterServer, campaignServer *igrpc.CampaignServer, pushTokenServer *igrpc.PushTokenServer,
+	logger logger.Logger,
+) *grpcx.Server {
 	type Config struct {
 		Port      int      `yaml:"port"`
 		EtcdAddrs []string `yaml:"etcdAddrs"`
@@ -20,6 +23,10 @@ func InitGRPCServer(notifiServer *igrpc.NotificationServer, logger logger.Logger
 	}
 	server := grpc.NewServer(grpc.ChainUnaryInterceptor())
 	notifiServer.Register(server)
+	inboxServer.Register(server)
+	deadLetterServer.Register(server)
+	campaignServer.Register(server)
+	pushTokenServer.Register(server)
 
 	return &grpcx.Server{
 		Server:    server,
@@ -0,0 +1,37 @@
+package ioc
+
+import (
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	"go-notification/internal/api/grpc/interceptor/jwt"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/service/inbox"
+	inboxweb "go-notification/internal/web/inbox"
+	"time"
+)
+
+func InitInboxBroker(client *redis.Client, log logger.Logger) *inbox.RedisBroker {
+	type Config struct {
+		Channel string `yaml:"channel"`
+	}
+	cfg := Config{
+		Channel: "notification:inbox:push",
+	}
+	if err := viper.UnmarshalKey("inbox.push", &cfg); err != nil {
+		panic(err)
+	}
+	return inbox.NewRedisBroker(client, cfg.Channel, log)
+}
+
+func InitInboxGateway(svc inbox.Service, broker *inbox.RedisBroker, log logger.Logger) *inboxweb.Gateway {
+	type Config struct {
+		HeartbeatInterval time.Duration `yaml:"heartbeatInterval"`
+	}
+	cfg := Config{
+		HeartbeatInterval: 30 * time.Second,
+	}
+	if err := viper.UnmarshalKey("inbox.gateway", &cfg); err != nil {
+		panic(err)
+	}
+	return inboxweb.NewGateway(svc, broker, jwt.NewJwtAuth(viper.GetString("jwt.key")), cfg.HeartbeatInterval, log)
+}
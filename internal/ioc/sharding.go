@@ -0,0 +1,78 @@
+package ioc
+
+import (
+	"github.com/spf13/viper"
+	"go-notification/internal/pkg/database/metrics"
+	"go-notification/internal/pkg/database/tracing"
+	"go-notification/internal/pkg/sharding"
+	"go-notification/internal/repository/dao"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type shardingConfig struct {
+	DBPrefix      string `yaml:"dbPrefix"`
+	TablePrefix   string `yaml:"tablePrefix"`
+	DBSharding    int64  `yaml:"dbSharding"`
+	TableSharding int64  `yaml:"tableSharding"`
+	// DSNs 分库名 -> 连接串，分库名为 dbPrefix_<编号>
+	DSNs map[string]string `yaml:"dsns"`
+}
+
+func loadShardingConfig() shardingConfig {
+	cfg := shardingConfig{
+		DBPrefix:      "notification",
+		TablePrefix:   "notification",
+		DBSharding:    1,
+		TableSharding: 1,
+	}
+	if err := viper.UnmarshalKey("sharding.notification", &cfg); err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+// InitShardingStrategy 通知表的分库分表规则
+func InitShardingStrategy() sharding.ShardingStrategy {
+	cfg := loadShardingConfig()
+	return *sharding.NewShardingStrategy(cfg.DBPrefix, cfg.TablePrefix, cfg.TableSharding, cfg.DBSharding)
+}
+
+// InitShardingDBs 连接所有分库并创建分表，没有配置分库时返回空
+func InitShardingDBs(strategy sharding.ShardingStrategy) map[string]*gorm.DB {
+	cfg := loadShardingConfig()
+	tables := make(map[string][]string, len(cfg.DSNs))
+	for _, dst := range strategy.Broadcast() {
+		tables[dst.DB] = append(tables[dst.DB], dst.Table)
+	}
+	dbs := make(map[string]*gorm.DB, len(cfg.DSNs))
+	for name, dsn := range cfg.DSNs {
+		if len(tables[name]) == 0 {
+			panic("分库 " + name + " 不在分片规则中")
+		}
+		db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+		if err != nil {
+			panic("failed to connect database " + name)
+		}
+		if err = db.Use(tracing.NewGormTracingPlugin()); err != nil {
+			panic(err)
+		}
+		if err = db.Use(metrics.NewGormMetricsPlugin()); err != nil {
+			panic(err)
+		}
+		if err = dao.InitShardingTables(db, tables[name]); err != nil {
+			panic(err)
+		}
+		dbs[name] = db
+	}
+	return dbs
+}
+
+// InitNotificationDAO 配置了分库时检索通知需要查询所有分片，其余操作仍然走默认库
+func InitNotificationDAO(db *gorm.DB, dbs map[string]*gorm.DB, strategy sharding.ShardingStrategy) dao.NotificationDAO {
+	base := dao.NewNotificationDAO(db)
+	if len(dbs) == 0 {
+		return base
+	}
+	return dao.NewShardingNotificationDAO(base, dbs, strategy)
+}
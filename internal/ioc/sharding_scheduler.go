@@ -20,6 +20,7 @@ import (
 func InitShardingScheduler(
 	repo repository.NotificationRepository,
 	notificationSender sender.NotificationSender,
+	quietHours *scheduler.QuietHours,
 	dclient dlock.Client,
 	shardingStrategy sharding.ShardingStrategy,
 	etcdClient *clientv3.Client,
@@ -72,6 +73,7 @@ func InitShardingScheduler(
 	return scheduler.NewShardingScheduler(
 		repo,
 		notificationSender,
+		quietHours,
 		dclient,
 		shardingStrategy,
 		sem,
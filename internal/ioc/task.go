@@ -2,9 +2,15 @@ package ioc
 
 import (
 	"go-notification/internal/pkg/task"
+	"go-notification/internal/service/campaign"
+	"go-notification/internal/service/delivery"
+	"go-notification/internal/service/digest"
 	"go-notification/internal/service/notification"
 	"go-notification/internal/service/notification/callback"
 	"go-notification/internal/service/scheduler"
+	"go-notification/internal/service/series"
+	"go-notification/internal/service/watch"
+	inboxweb "go-notification/internal/web/inbox"
 )
 
 func InitTasks(
@@ -12,11 +18,23 @@ func InitTasks(
 	t2 scheduler.NotificationScheduler,
 	t3 *notification.SendingTimeoutTask,
 	t4 *notification.TxCheckTask,
+	t5 *inboxweb.Gateway,
+	t6 *delivery.ReceiptTask,
+	t7 *watch.Hub,
+	t8 *series.Task,
+	t9 *digest.Task,
+	t10 *campaign.Task,
 ) []task.Task {
 	var tasks = make([]task.Task, 0)
 	tasks = append(tasks, t1)
 	tasks = append(tasks, t2)
 	tasks = append(tasks, t3)
 	tasks = append(tasks, t4)
+	tasks = append(tasks, t5)
+	tasks = append(tasks, t6)
+	tasks = append(tasks, t7)
+	tasks = append(tasks, t8)
+	tasks = append(tasks, t9)
+	tasks = append(tasks, t10)
 	return tasks
 }
@@ -0,0 +1,28 @@
+package ioc
+
+import (
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/watch"
+	"time"
+)
+
+func InitWatchHub(client *redis.Client, repo repository.NotificationTransitionRepository, log logger.Logger) *watch.Hub {
+	type Config struct {
+		Channel      string        `yaml:"channel"`
+		PollInterval time.Duration `yaml:"pollInterval"`
+		Lookback     time.Duration `yaml:"lookback"`
+	}
+	cfg := Config{
+		Channel:      "notification:status:watch",
+		PollInterval: 30 * time.Second,
+		Lookback:     10 * time.Second,
+	}
+	if err := viper.UnmarshalKey("watch", &cfg); err != nil {
+		panic(err)
+	}
+	broker := watch.NewRedisBroker(client, cfg.Channel, log)
+	return watch.NewHub(repo, broker, broker, cfg.PollInterval, cfg.Lookback, log)
+}
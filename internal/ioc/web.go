@@ -0,0 +1,18 @@
+package ioc
+
+import (
+	"github.com/gin-gonic/gin"
+	"go-notification/internal/pkg/ginx"
+	inboxweb "go-notification/internal/web/inbox"
+	receiptweb "go-notification/internal/web/receipt"
+)
+
+func InitWebServer(gateway *inboxweb.Gateway, receipt *receiptweb.Handler) *gin.Engine {
+	server := gin.Default()
+	handlers := []ginx.Handler{gateway, receipt}
+	for _, h := range handlers {
+		h.PublicRoutes(server)
+		h.PrivateRoutes(server)
+	}
+	return server
+}
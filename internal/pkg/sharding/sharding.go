@@ -55,12 +55,12 @@ func (s ShardingStrategy) Broadcast() []Dst {
 	// 求笛卡尔积
 	ans := make([]Dst, 0, s.tableSharding*s.dbSharding)
 	for i := 0; i < int(s.dbSharding); i++ {
-		for j := 0; j < int(s.dbSharding); j++ {
+		for j := 0; j < int(s.tableSharding); j++ {
 			ans = append(ans, Dst{
 				TableSuffix: int64(j),
 				Table:       fmt.Sprintf("%s_%d", s.tablePrefix, j),
-				DBSuffix:    int64(j),
-				DB:          fmt.Sprintf("%s_%d", s.dbPrefix, j),
+				DBSuffix:    int64(i),
+				DB:          fmt.Sprintf("%s_%d", s.dbPrefix, i),
 			})
 		}
 	}
@@ -0,0 +1,26 @@
+package sharding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardingStrategy_Broadcast(t *testing.T) {
+	t.Parallel()
+
+	s := NewShardingStrategy("notification", "notification", 3, 2)
+	dsts := s.Broadcast()
+
+	assert.Len(t, dsts, 6)
+	seen := make(map[string]struct{}, len(dsts))
+	for _, dst := range dsts {
+		seen[dst.DB+"."+dst.Table] = struct{}{}
+	}
+	// 所有分片都要覆盖，且 Shard 的结果一定在其中
+	assert.Len(t, seen, 6)
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		dst := s.Shard(1, key)
+		assert.Contains(t, seen, dst.DB+"."+dst.Table)
+	}
+}
@@ -0,0 +1,23 @@
+package cache
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"time"
+)
+
+// FrequencyCapKey 发送次数的统计维度，每个接收者单独统计
+type FrequencyCapKey struct {
+	Receivers    []string
+	Channel      domain.Channel
+	BusinessType domain.BusinessType
+}
+
+// FrequencyCapCache 按接收者、渠道和业务类型记录滑动窗口内的发送，全集群共享
+type FrequencyCapCache interface {
+	// Acquire 所有接收者在每条规则的窗口内都没有达到上限时记录本次发送并返回 true，否则不记录并返回 false
+	// 已经记录过的 member 直接返回 true，不重复计入
+	Acquire(ctx context.Context, key FrequencyCapKey, member string, rules []domain.FrequencyCapRule, now time.Time) (bool, error)
+	// Release 撤销 Acquire 记录的发送
+	Release(ctx context.Context, key FrequencyCapKey, member string) error
+}
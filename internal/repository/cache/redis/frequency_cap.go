@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"go-notification/internal/domain"
+	"go-notification/internal/repository/cache"
+	"time"
+)
+
+//go:embed lua/frequency_cap.lua
+var frequencyCapScript string
+
+type frequencyCapCache struct {
+	client redis.Cmdable
+}
+
+func NewFrequencyCapCache(client redis.Cmdable) cache.FrequencyCapCache {
+	return &frequencyCapCache{client: client}
+}
+
+func (f *frequencyCapCache) Acquire(ctx context.Context, key cache.FrequencyCapKey, member string, rules []domain.FrequencyCapRule, now time.Time) (bool, error) {
+	if len(key.Receivers) == 0 || len(rules) == 0 {
+		return true, nil
+	}
+	args := make([]interface{}, 0, 3+2*len(rules))
+	args = append(args, now.UnixMilli(), member, domain.MaxFrequencyCapWindow.Milliseconds())
+	for _, rule := range rules {
+		args = append(args, rule.WindowDuration().Milliseconds(), rule.Max)
+	}
+	// 每个接收者的记录在不同的 slot 中，逐个记录，任何一个超过上限时撤销已经记录的接收者
+	keys := f.keys(key)
+	for i, k := range keys {
+		res, err := f.client.Eval(ctx, frequencyCapScript, []string{k}, args...).Int()
+		if err == nil && res == 1 {
+			continue
+		}
+		if rerr := f.release(ctx, keys[:i], member); rerr != nil && err == nil {
+			err = rerr
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (f *frequencyCapCache) Release(ctx context.Context, key cache.FrequencyCapKey, member string) error {
+	return f.release(ctx, f.keys(key), member)
+}
+
+func (f *frequencyCapCache) release(ctx context.Context, keys []string, member string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	pipe := f.client.Pipeline()
+	for _, k := range keys {
+		pipe.ZRem(ctx, k, member)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (f *frequencyCapCache) keys(key cache.FrequencyCapKey) []string {
+	keys := make([]string, 0, len(key.Receivers))
+	for _, receiver := range key.Receivers {
+		keys = append(keys, fmt.Sprintf("frequency_cap:{%s:%d:%s}", key.Channel, key.BusinessType, receiver))
+	}
+	return keys
+}
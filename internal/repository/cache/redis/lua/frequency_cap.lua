@@ -0,0 +1,24 @@
+-- KEYS[1] 接收者的发送记录，有序集合，成员为发送标识，分数为发送时间
+-- ARGV[1] 当前时间（毫秒），ARGV[2] 本次发送的标识，ARGV[3] 发送记录的保留时长（毫秒）
+-- 之后每两个参数为一条规则：窗口时长（毫秒）和窗口内最多发送的次数
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local member = ARGV[2]
+local retention = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - retention)
+-- 同一条通知重新发送时已经计入过，不再检查也不再重复计入
+if redis.call('ZSCORE', key, member) then
+    return 1
+end
+for i = 4, #ARGV, 2 do
+    local window = tonumber(ARGV[i])
+    local max = tonumber(ARGV[i + 1])
+    if redis.call('ZCOUNT', key, '(' .. (now - window), '+inf') >= max then
+        return 0
+    end
+end
+
+redis.call('ZADD', key, now, member)
+redis.call('PEXPIRE', key, retention)
+return 1
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"go-notification/internal/domain"
+	"go-notification/internal/repository/dao"
+)
+
+type CampaignRepository interface {
+	Create(ctx context.Context, campaign domain.Campaign) (domain.Campaign, error)
+	GetByKey(ctx context.Context, bizID int64, key string) (domain.Campaign, error)
+	// AppendReceivers 给草稿状态的活动追加接收者，返回追加后的活动
+	AppendReceivers(ctx context.Context, campaign domain.Campaign, receivers []domain.CampaignReceiver) (domain.Campaign, error)
+	// FindRunning 查找发送中的活动
+	FindRunning(ctx context.Context, limit int) ([]domain.Campaign, error)
+	// FindReceivers 按序号查找序号大于 afterSeq 的接收者
+	FindReceivers(ctx context.Context, campaignID, afterSeq int64, limit int) ([]domain.CampaignReceiver, error)
+	// CASUpdate 按版本号更新进度和状态，版本号不匹配时返回 errs.ErrNotificationVersionMismatch
+	CASUpdate(ctx context.Context, campaign domain.Campaign) error
+}
+
+type campaignRepository struct {
+	dao dao.CampaignDAO
+}
+
+func NewCampaignRepository(dao dao.CampaignDAO) CampaignRepository {
+	return &campaignRepository{dao: dao}
+}
+
+func (r *campaignRepository) Create(ctx context.Context, campaign domain.Campaign) (domain.Campaign, error) {
+	created, err := r.dao.Create(ctx, r.toEntity(campaign))
+	if err != nil {
+		return domain.Campaign{}, err
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *campaignRepository) GetByKey(ctx context.Context, bizID int64, key string) (domain.Campaign, error) {
+	found, err := r.dao.GetByKey(ctx, bizID, key)
+	if err != nil {
+		return domain.Campaign{}, err
+	}
+	return r.toDomain(found), nil
+}
+
+func (r *campaignRepository) AppendReceivers(ctx context.Context, campaign domain.Campaign, receivers []domain.CampaignReceiver) (domain.Campaign, error) {
+	entities := make([]dao.CampaignReceiver, 0, len(receivers))
+	for i := range receivers {
+		var params string
+		if len(receivers[i].Params) > 0 {
+			b, _ := json.Marshal(receivers[i].Params)
+			params = string(b)
+		}
+		entities = append(entities, dao.CampaignReceiver{
+			Receiver: receivers[i].Receiver,
+			Params:   params,
+		})
+	}
+	updated, err := r.dao.AppendReceivers(ctx, campaign.ID, entities)
+	if err != nil {
+		return domain.Campaign{}, err
+	}
+	return r.toDomain(updated), nil
+}
+
+func (r *campaignRepository) FindRunning(ctx context.Context, limit int) ([]domain.Campaign, error) {
+	entities, err := r.dao.FindRunning(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]domain.Campaign, 0, len(entities))
+	for i := range entities {
+		result = append(result, r.toDomain(entities[i]))
+	}
+	return result, nil
+}
+
+func (r *campaignRepository) FindReceivers(ctx context.Context, campaignID, afterSeq int64, limit int) ([]domain.CampaignReceiver, error) {
+	entities, err := r.dao.FindReceivers(ctx, campaignID, afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]domain.CampaignReceiver, 0, len(entities))
+	for i := range entities {
+		var params map[string]string
+		if entities[i].Params != "" {
+			_ = json.Unmarshal([]byte(entities[i].Params), &params)
+		}
+		result = append(result, domain.CampaignReceiver{
+			Seq:      entities[i].Seq,
+			Receiver: entities[i].Receiver,
+			Params:   params,
+		})
+	}
+	return result, nil
+}
+
+func (r *campaignRepository) CASUpdate(ctx context.Context, campaign domain.Campaign) error {
+	return r.dao.CASUpdate(ctx, r.toEntity(campaign))
+}
+
+func (r *campaignRepository) toEntity(c domain.Campaign) dao.Campaign {
+	n := c.Notification
+	templateParams, _ := n.MarshalTemplateParms()
+	fallbackTemplates, _ := n.MarshalFallbackTemplates()
+	sendStrategy, _ := json.Marshal(n.SendStrategyConfig)
+	return dao.Campaign{
+		ID:                c.ID,
+		BizID:             c.BizID,
+		Key:               c.Key,
+		Name:              c.Name,
+		Channel:           n.Channel.String(),
+		TemplateID:        n.Template.ID,
+		TemplateVersionID: n.Template.VersionID,
+		TemplatePinned:    n.Template.VersionPinned,
+		TemplateParams:    templateParams,
+		FallbackTemplates: fallbackTemplates,
+		ReceiverTimezone:  n.ReceiverTimezone,
+		SendStrategy:      string(sendStrategy),
+		SendRate:          c.SendRate,
+		Total:             c.Total,
+		Dispatched:        c.Dispatched,
+		Failed:            c.Failed,
+		Status:            c.Status.String(),
+		Version:           c.Version,
+	}
+}
+
+func (r *campaignRepository) toDomain(c dao.Campaign) domain.Campaign {
+	var templateParams map[string]string
+	_ = json.Unmarshal([]byte(c.TemplateParams), &templateParams)
+
+	var fallbackTemplates map[domain.Channel]int64
+	if c.FallbackTemplates != "" {
+		_ = json.Unmarshal([]byte(c.FallbackTemplates), &fallbackTemplates)
+	}
+
+	var sendStrategy domain.SendStrategyConfig
+	_ = json.Unmarshal([]byte(c.SendStrategy), &sendStrategy)
+
+	return domain.Campaign{
+		ID:    c.ID,
+		BizID: c.BizID,
+		Key:   c.Key,
+		Name:  c.Name,
+		Notification: domain.Notification{
+			BizID:   c.BizID,
+			Key:     c.Key,
+			Channel: domain.Channel(c.Channel),
+			Template: domain.Template{
+				ID:            c.TemplateID,
+				VersionID:     c.TemplateVersionID,
+				Params:        templateParams,
+				VersionPinned: c.TemplatePinned,
+			},
+			SendStrategyConfig: sendStrategy,
+			FallbackTemplates:  fallbackTemplates,
+			ReceiverTimezone:   c.ReceiverTimezone,
+		},
+		SendRate:   c.SendRate,
+		Total:      c.Total,
+		Dispatched: c.Dispatched,
+		Failed:     c.Failed,
+		Status:     domain.CampaignStatus(c.Status),
+		Version:    c.Version,
+		Ctime:      c.Ctime,
+		Utime:      c.Utime,
+	}
+}
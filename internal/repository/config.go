@@ -261,6 +261,15 @@ func (b *businessConfigRepository) toDomain(config dao.BusinessConfig) domain.Bu
 	if config.CallbackConfig.Valid {
 		domainCfg.CallbackConfig = &config.CallbackConfig.Val
 	}
+	if config.WebhookConfig.Valid {
+		domainCfg.WebhookConfig = &config.WebhookConfig.Val
+	}
+	if config.QuietHours.Valid {
+		domainCfg.QuietHours = &config.QuietHours.Val
+	}
+	if config.FrequencyCap.Valid {
+		domainCfg.FrequencyCap = &config.FrequencyCap.Val
+	}
 	return domainCfg
 }
 
@@ -311,5 +320,26 @@ func (b *businessConfigRepository) toEntity(config domain.BusinessConfig) dao.Bu
 			Valid: true,
 		}
 	}
+
+	if config.WebhookConfig != nil {
+		businessCfg.WebhookConfig = sqlx.JsonColumn[domain.WebhookConfig]{
+			Val:   *config.WebhookConfig,
+			Valid: true,
+		}
+	}
+
+	if config.QuietHours != nil {
+		businessCfg.QuietHours = sqlx.JsonColumn[domain.QuietHoursConfig]{
+			Val:   *config.QuietHours,
+			Valid: true,
+		}
+	}
+
+	if config.FrequencyCap != nil {
+		businessCfg.FrequencyCap = sqlx.JsonColumn[domain.FrequencyCapConfig]{
+			Val:   *config.FrequencyCap,
+			Valid: true,
+		}
+	}
 	return businessCfg
 }
@@ -0,0 +1,173 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-sql-driver/mysql"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// Campaign 活动，按发送速率为上传的每个接收者生成一条通知
+type Campaign struct {
+	ID                int64  `gorm:"primaryKey;comment:'雪花算法ID'"`
+	BizID             int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_biz_id_key,priority:1;comment:'业务配置ID'"`
+	Key               string `gorm:"type:VARCHAR(200);NOT NULL;uniqueIndex:idx_biz_id_key,priority:2;comment:'业务内唯一标识，生成的通知的标识以其为前缀'"`
+	Name              string `gorm:"type:VARCHAR(128);NOT NULL;DEFAULT:'';comment:'活动名称'"`
+	Channel           string `gorm:"type:ENUM('SMS', 'EMAIL', 'IN_APP', 'WEBHOOK', 'IM', 'PUSH');NOT NULL;comment:'发送渠道'"`
+	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;comment:'关联的模版ID'"`
+	TemplateVersionID int64  `gorm:"type:BIGINT;NOT NULL;comment:'关联的模版版本ID'"`
+	TemplatePinned    bool   `gorm:"NOT NULL;DEFAULT:false;comment:'是否按关联的模版版本发送，否则使用模版当前的发布版本'"`
+	TemplateParams    string `gorm:"NOT NULL;comment:'所有接收者共用的模板参数'"`
+	FallbackTemplates string `gorm:"type:TEXT;comment:'降级渠道模板，JSON对象，渠道 -> 模板ID'"`
+	ReceiverTimezone  string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'接收者时区，免打扰时段按接收者时区计算'"`
+	SendStrategy      string `gorm:"type:TEXT;NOT NULL;comment:'每个接收者的通知的发送策略，JSON对象'"`
+	SendRate          int32  `gorm:"type:INT;NOT NULL;comment:'每秒最多生成的通知数量'"`
+	Total             int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'已上传的接收者数量'"`
+	Dispatched        int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'已经处理的接收者数量'"`
+	Failed            int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'生成通知失败的接收者数量'"`
+	Status            string `gorm:"type:ENUM('DRAFT', 'RUNNING', 'PAUSED', 'ABORTED', 'COMPLETED');NOT NULL;DEFAULT:'DRAFT';index:idx_status;comment:'活动状态'"`
+	Version           int    `gorm:"type:INT;NOT NULL;DEFAULT:1;comment:'版本号'"`
+	Ctime             int64
+	Utime             int64
+}
+
+func (Campaign) TableName() string {
+	return "campaign"
+}
+
+// CampaignReceiver 活动的接收者
+type CampaignReceiver struct {
+	ID         int64  `gorm:"primaryKey;autoIncrement"`
+	CampaignID int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_campaign_id_seq,priority:1;comment:'活动ID'"`
+	Seq        int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_campaign_id_seq,priority:2;comment:'从 1 开始的序号'"`
+	Receiver   string `gorm:"type:VARCHAR(256);NOT NULL;comment:'接收者(手机/邮箱/用户ID)'"`
+	Params     string `gorm:"type:TEXT;comment:'该接收者的模板参数，JSON对象'"`
+	Ctime      int64
+}
+
+func (CampaignReceiver) TableName() string {
+	return "campaign_receiver"
+}
+
+type CampaignDAO interface {
+	Create(ctx context.Context, data Campaign) (Campaign, error)
+	GetByKey(ctx context.Context, bizID int64, key string) (Campaign, error)
+	// AppendReceivers 给草稿状态的活动追加接收者，按上传顺序分配序号，返回追加后的活动
+	AppendReceivers(ctx context.Context, id int64, receivers []CampaignReceiver) (Campaign, error)
+	// FindRunning 查找发送中的活动
+	FindRunning(ctx context.Context, limit int) ([]Campaign, error)
+	// FindReceivers 按序号升序查找序号大于 afterSeq 的接收者
+	FindReceivers(ctx context.Context, campaignID, afterSeq int64, limit int) ([]CampaignReceiver, error)
+	// CASUpdate 按版本号更新进度和状态
+	CASUpdate(ctx context.Context, data Campaign) error
+}
+
+type campaignDAO struct {
+	db *gorm.DB
+}
+
+func NewCampaignDAO(db *gorm.DB) CampaignDAO {
+	return &campaignDAO{db: db}
+}
+
+func (d *campaignDAO) Create(ctx context.Context, data Campaign) (Campaign, error) {
+	now := time.Now().UnixMilli()
+	data.Ctime, data.Utime = now, now
+	data.Version = 1
+	err := d.db.WithContext(ctx).Create(&data).Error
+	if err != nil {
+		me := new(mysql.MySQLError)
+		const uniqueIndexErrorCode = 1062
+		if errors.As(err, &me) && me.Number == uniqueIndexErrorCode {
+			return Campaign{}, fmt.Errorf("%w", errs.ErrNotificationDuplicate)
+		}
+		return Campaign{}, err
+	}
+	return data, nil
+}
+
+func (d *campaignDAO) GetByKey(ctx context.Context, bizID int64, key string) (Campaign, error) {
+	var res Campaign
+	err := d.db.WithContext(ctx).Where("biz_id = ? AND `key` = ?", bizID, key).First(&res).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Campaign{}, fmt.Errorf("%w: key=%s", errs.ErrCampaignNotFound, key)
+	}
+	return res, err
+}
+
+func (d *campaignDAO) AppendReceivers(ctx context.Context, id int64, receivers []CampaignReceiver) (Campaign, error) {
+	var res Campaign
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁住活动，并发上传的接收者依次分配序号
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&res).Error
+		if err != nil {
+			return err
+		}
+		if res.Status != domain.CampaignStatusDraft.String() {
+			return fmt.Errorf("%w: 活动状态为 %s，只能给草稿状态的活动添加接收者", errs.ErrInvalidOperation, res.Status)
+		}
+		now := time.Now().UnixMilli()
+		for i := range receivers {
+			receivers[i].CampaignID = id
+			receivers[i].Seq = res.Total + int64(i) + 1
+			receivers[i].Ctime = now
+		}
+		const batchSize = 500
+		if err = tx.CreateInBatches(receivers, batchSize).Error; err != nil {
+			return err
+		}
+		res.Total += int64(len(receivers))
+		res.Version++
+		res.Utime = now
+		return tx.Model(&Campaign{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"total":   res.Total,
+			"version": res.Version,
+			"utime":   res.Utime,
+		}).Error
+	})
+	return res, err
+}
+
+func (d *campaignDAO) FindRunning(ctx context.Context, limit int) ([]Campaign, error) {
+	var res []Campaign
+	err := d.db.WithContext(ctx).
+		Where("status = ?", domain.CampaignStatusRunning.String()).
+		Order("id ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *campaignDAO) FindReceivers(ctx context.Context, campaignID, afterSeq int64, limit int) ([]CampaignReceiver, error) {
+	var res []CampaignReceiver
+	err := d.db.WithContext(ctx).
+		Where("campaign_id = ? AND seq > ?", campaignID, afterSeq).
+		Order("seq ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *campaignDAO) CASUpdate(ctx context.Context, data Campaign) error {
+	res := d.db.WithContext(ctx).Model(&Campaign{}).
+		Where("id = ? AND version = ?", data.ID, data.Version).
+		Updates(map[string]interface{}{
+			"dispatched": data.Dispatched,
+			"failed":     data.Failed,
+			"status":     data.Status,
+			"version":    gorm.Expr("version + 1"),
+			"utime":      time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: 活动 %d 已被修改", errs.ErrNotificationVersionMismatch, data.ID)
+	}
+	return nil
+}
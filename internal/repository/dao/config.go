@@ -10,14 +10,17 @@ import (
 )
 
 type BusinessConfig struct {
-	ID             int64                                  `gorm:"primaryKey;type:BIGINT;comment:'业务方标识'"`
-	OwnerID        int64                                  `gorm:"type:BIGINT;comment:'业务方ID'"`
-	OwnerType      string                                 `gorm:"type:ENUM('person', 'organization');comment:'业务方类型: 个人/组织'"`
-	ChannelConfig  sqlx.JsonColumn[domain.ChannelConfig]  `gorm:"type:JSON;comment:'{\"channels\":[{\"channel\":\"SMS\", \"priority\":\"1\",\"enabled\":\"true\"},{\"channel\":\"EMAIL\", \"priority\":\"2\",\"enabled\":\"true\"}]}'"`
-	TxnConfig      sqlx.JsonColumn[domain.TxnConfig]      `gorm:"type:JSON;comment:'事务配置'"`
-	RateLimit      int                                    `gorm:"type:INT;DEFAULT:1000;comment:'速率限制'"`
-	Quota          sqlx.JsonColumn[domain.QuotaConfig]    `gorm:"type:JSON;comment:'配额配置'"`
-	CallbackConfig sqlx.JsonColumn[domain.CallbackConfig] `gorm:"type:JSON;comment:'回调配置，通知平台回调业务通知异步请求结果'"`
+	ID             int64                                      `gorm:"primaryKey;type:BIGINT;comment:'业务方标识'"`
+	OwnerID        int64                                      `gorm:"type:BIGINT;comment:'业务方ID'"`
+	OwnerType      string                                     `gorm:"type:ENUM('person', 'organization');comment:'业务方类型: 个人/组织'"`
+	ChannelConfig  sqlx.JsonColumn[domain.ChannelConfig]      `gorm:"type:JSON;comment:'{\"channels\":[{\"channel\":\"SMS\", \"priority\":\"1\",\"enabled\":\"true\"},{\"channel\":\"EMAIL\", \"priority\":\"2\",\"enabled\":\"true\"}]}'"`
+	TxnConfig      sqlx.JsonColumn[domain.TxnConfig]          `gorm:"type:JSON;comment:'事务配置'"`
+	RateLimit      int                                        `gorm:"type:INT;DEFAULT:1000;comment:'速率限制'"`
+	Quota          sqlx.JsonColumn[domain.QuotaConfig]        `gorm:"type:JSON;comment:'配额配置'"`
+	CallbackConfig sqlx.JsonColumn[domain.CallbackConfig]     `gorm:"type:JSON;comment:'回调配置，通知平台回调业务通知异步请求结果'"`
+	WebhookConfig  sqlx.JsonColumn[domain.WebhookConfig]      `gorm:"type:JSON;comment:'WEBHOOK渠道配置，签名密钥和超时时间'"`
+	QuietHours     sqlx.JsonColumn[domain.QuietHoursConfig]   `gorm:"type:JSON;comment:'免打扰配置，按渠道和业务类型配置的免打扰时段'"`
+	FrequencyCap   sqlx.JsonColumn[domain.FrequencyCapConfig] `gorm:"type:JSON;comment:'接收者发送频率上限配置'"`
 	Ctime          int64
 	Utime          int64
 }
@@ -94,6 +97,9 @@ func (b businessConfigDAO) SaveConfig(ctx context.Context, config BusinessConfig
 			"rate_limit",
 			"quota",
 			"callback_config",
+			"webhook_config",
+			"quiet_hours",
+			"frequency_cap",
 			"utime",
 		}), // 只更新制定的非空列
 	}).Create(&config)
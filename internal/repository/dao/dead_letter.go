@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// DeadLetter 最终发送失败的通知
+type DeadLetter struct {
+	ID                int64  `gorm:"primaryKey;AUTO_INCREMENT;comment:'死信ID'"`
+	NotificationID    int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_notification_id;comment:'失败的通知ID，重放沿用该ID'"`
+	BizID             int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_channel_ctime,priority:1;comment:'业务配置ID'"`
+	Key               string `gorm:"type:VARCHAR(256);NOT NULL;comment:'业务内唯一标识'"`
+	Channel           string `gorm:"type:VARCHAR(16);NOT NULL;index:idx_biz_id_channel_ctime,priority:2;comment:'发送渠道'"`
+	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;comment:'模版ID'"`
+	TemplateVersionID int64  `gorm:"type:BIGINT;NOT NULL;comment:'模版版本ID'"`
+	Provider          string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'最后一次提交的供应商'"`
+	ErrCategory       string `gorm:"type:VARCHAR(32);NOT NULL;comment:'失败原因分类'"`
+	ErrMsg            string `gorm:"type:VARCHAR(1024);NOT NULL;DEFAULT:'';comment:'最后一次的错误描述'"`
+	RetryCount        int32  `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'自动重试次数'"`
+	Attempts          string `gorm:"type:TEXT;comment:'各接收者的提交记录，JSON数组'"`
+	Status            string `gorm:"type:ENUM('PENDING', 'REPLAYED');NOT NULL;DEFAULT:'PENDING';comment:'死信状态'"`
+	ReplayCount       int32  `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'已重放次数'"`
+	Ctime             int64  `gorm:"index:idx_biz_id_channel_ctime,priority:3"`
+	Utime             int64
+}
+
+func (DeadLetter) TableName() string {
+	return "dead_letters"
+}
+
+type DeadLetterDAO interface {
+	// Upsert 写入死信，重放后再次失败时覆盖失败信息并改回待处理，保留重放次数
+	Upsert(ctx context.Context, data DeadLetter) error
+	// List 按ID倒序分页，cursor为上一页最后一条的ID
+	List(ctx context.Context, bizID int64, channel, status string, startTime, endTime, cursor int64, limit int) ([]DeadLetter, error)
+	FindByIDs(ctx context.Context, bizID int64, ids []int64) ([]DeadLetter, error)
+	// MarkReplayed 待处理的死信标记为已重放，RowsAffected 为 0 说明已经被重放
+	MarkReplayed(ctx context.Context, id int64) (int64, error)
+}
+
+type deadLetterDAO struct {
+	db *gorm.DB
+}
+
+func NewDeadLetterDAO(db *gorm.DB) DeadLetterDAO {
+	return &deadLetterDAO{db: db}
+}
+
+func (d *deadLetterDAO) Upsert(ctx context.Context, data DeadLetter) error {
+	now := time.Now().UnixMilli()
+	data.Ctime, data.Utime = now, now
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "notification_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"channel", "template_id", "template_version_id", "provider", "err_category",
+			"err_msg", "retry_count", "attempts", "status", "utime",
+		}),
+	}).Create(&data).Error
+}
+
+func (d *deadLetterDAO) List(ctx context.Context, bizID int64, channel, status string, startTime, endTime, cursor int64, limit int) ([]DeadLetter, error) {
+	var res []DeadLetter
+	query := d.db.WithContext(ctx).Where("biz_id = ?", bizID)
+	if channel != "" {
+		query = query.Where("channel = ?", channel)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if startTime > 0 {
+		query = query.Where("ctime >= ?", startTime)
+	}
+	if endTime > 0 {
+		query = query.Where("ctime <= ?", endTime)
+	}
+	if cursor > 0 {
+		query = query.Where("id < ?", cursor)
+	}
+	err := query.Order("id DESC").Limit(limit).Find(&res).Error
+	return res, err
+}
+
+func (d *deadLetterDAO) FindByIDs(ctx context.Context, bizID int64, ids []int64) ([]DeadLetter, error) {
+	var res []DeadLetter
+	err := d.db.WithContext(ctx).
+		Where("biz_id = ? AND id IN (?)", bizID, ids).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *deadLetterDAO) MarkReplayed(ctx context.Context, id int64) (int64, error) {
+	res := d.db.WithContext(ctx).Model(&DeadLetter{}).
+		Where("id = ? AND status = ?", id, domain.DeadLetterStatusPending.String()).
+		Updates(map[string]any{
+			"status":       domain.DeadLetterStatusReplayed.String(),
+			"replay_count": gorm.Expr("replay_count + 1"),
+			"utime":        time.Now().UnixMilli(),
+		})
+	return res.RowsAffected, res.Error
+}
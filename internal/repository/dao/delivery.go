@@ -0,0 +1,204 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"go-notification/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// Delivery 接收者发送记录表，随通知创建，每个接收者一行
+type Delivery struct {
+	ID             int64  `gorm:"primaryKey;AUTO_INCREMENT;comment:'记录ID'"`
+	NotificationID int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:1;comment:'通知ID'"`
+	BizID          int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_receiver,priority:1;comment:'业务配置ID'"`
+	Receiver       string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:2;index:idx_biz_id_receiver,priority:2;comment:'接收者'"`
+	Channel        string `gorm:"type:ENUM('SMS','EMAIL','IN_APP','WEBHOOK','IM','PUSH');NOT NULL;comment:'发送渠道'"`
+	Provider       string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';index:idx_provider_serial_no,priority:1;comment:'供应商'"`
+	SerialNo       string `gorm:"type:VARCHAR(128);NOT NULL;DEFAULT:'';index:idx_provider_serial_no,priority:2;comment:'供应商回执ID'"`
+	Status         string `gorm:"type:ENUM('PENDING','SUBMIT_FAILED','SUBMITTED','WAITING','DELIVERED','UNDELIVERED');NOT NULL;DEFAULT:'PENDING';index:idx_status_next_query_time,priority:1;comment:'送达状态'"`
+	ErrCode        string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'供应商错误码'"`
+	ErrMsg         string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'供应商错误描述'"`
+	ReportTime     int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'回执时间'"`
+	NextQueryTime  int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;index:idx_status_next_query_time,priority:2;comment:'下一次主动拉取回执的时间'"`
+	Attempts       int    `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'提交供应商的次数'"`
+	Ctime          int64
+	Utime          int64
+}
+
+func (Delivery) TableName() string {
+	return "deliveries"
+}
+
+type DeliveryDAO interface {
+	// BatchCreate 创建或覆盖接收者的发送记录
+	BatchCreate(ctx context.Context, deliveries []Delivery) error
+	// FindWaiting 查找到了拉取时间仍在等待回执的记录
+	FindWaiting(ctx context.Context, now int64, limit int) ([]Delivery, error)
+	// FindBySerialNos 根据供应商回执ID查找记录
+	FindBySerialNos(ctx context.Context, provider string, serialNos []string) ([]Delivery, error)
+	// FindByNotificationIDs 查找通知的全部送达记录
+	FindByNotificationIDs(ctx context.Context, notificationIDs []int64) ([]Delivery, error)
+	// UpdateStatus 将等待回执的记录更新为终态，返回是否更新成功
+	UpdateStatus(ctx context.Context, delivery Delivery) (bool, error)
+	// UpdateNextQueryTime 推迟下一次拉取时间
+	UpdateNextQueryTime(ctx context.Context, ids []int64, nextQueryTime int64) error
+	// CountWaiting 统计通知还在等待回执的记录数
+	CountWaiting(ctx context.Context, notificationID int64) (int64, error)
+	// IncrAttempts 提交供应商前累加接收者的提交次数
+	IncrAttempts(ctx context.Context, notificationID int64, receivers []string) error
+	// RecordProvider 调用供应商之前记录使用的供应商，只更新还没有被受理的接收者
+	RecordProvider(ctx context.Context, notificationID int64, receivers []string, provider string) error
+}
+
+type deliveryDAO struct {
+	db *gorm.DB
+}
+
+func NewDeliveryDAO(db *gorm.DB) DeliveryDAO {
+	return &deliveryDAO{db: db}
+}
+
+// pendingDeliveries 根据通知的接收者生成待发送记录
+func pendingDeliveries(notifications []Notification, now int64) ([]Delivery, error) {
+	var deliveries []Delivery
+	for i := range notifications {
+		var receivers []string
+		if err := json.Unmarshal([]byte(notifications[i].Receivers), &receivers); err != nil {
+			return nil, err
+		}
+		seen := make(map[string]struct{}, len(receivers))
+		for _, receiver := range receivers {
+			// 同一个接收者只发送一次
+			if _, ok := seen[receiver]; ok {
+				continue
+			}
+			seen[receiver] = struct{}{}
+			deliveries = append(deliveries, Delivery{
+				NotificationID: notifications[i].ID,
+				BizID:          notifications[i].BizID,
+				Receiver:       receiver,
+				Channel:        notifications[i].Channel,
+				Status:         domain.DeliveryStatusPending.String(),
+				Ctime:          now,
+				Utime:          now,
+			})
+		}
+	}
+	return deliveries, nil
+}
+
+func (d *deliveryDAO) BatchCreate(ctx context.Context, deliveries []Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	for i := range deliveries {
+		deliveries[i].Ctime = now
+		deliveries[i].Utime = now
+	}
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "notification_id"}, {Name: "receiver"}},
+		DoUpdates: append(clause.AssignmentColumns([]string{
+			"channel", "serial_no", "status", "err_code", "err_msg", "report_time", "next_query_time", "utime",
+		}), clause.Assignment{
+			// 提交失败时不知道供应商，保留发送前记录的供应商
+			Column: clause.Column{Name: "provider"},
+			Value:  gorm.Expr("IF(VALUES(provider) = '', provider, VALUES(provider))"),
+		}),
+	}).Create(&deliveries).Error
+}
+
+func (d *deliveryDAO) FindWaiting(ctx context.Context, now int64, limit int) ([]Delivery, error) {
+	var deliveries []Delivery
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND next_query_time <= ?", domain.DeliveryStatusWaiting.String(), now).
+		Order("next_query_time ASC").
+		Limit(limit).
+		Find(&deliveries).Error
+	return deliveries, err
+}
+
+func (d *deliveryDAO) FindBySerialNos(ctx context.Context, provider string, serialNos []string) ([]Delivery, error) {
+	var deliveries []Delivery
+	if len(serialNos) == 0 {
+		return deliveries, nil
+	}
+	err := d.db.WithContext(ctx).
+		Where("provider = ? AND serial_no IN ?", provider, serialNos).
+		Find(&deliveries).Error
+	return deliveries, err
+}
+
+func (d *deliveryDAO) FindByNotificationIDs(ctx context.Context, notificationIDs []int64) ([]Delivery, error) {
+	var deliveries []Delivery
+	if len(notificationIDs) == 0 {
+		return deliveries, nil
+	}
+	err := d.db.WithContext(ctx).
+		Where("notification_id IN ?", notificationIDs).
+		Order("id ASC").
+		Find(&deliveries).Error
+	return deliveries, err
+}
+
+func (d *deliveryDAO) UpdateStatus(ctx context.Context, delivery Delivery) (bool, error) {
+	// 只允许从 WAITING 流转到终态，重复回执或乱序回执直接忽略
+	res := d.db.WithContext(ctx).Model(&Delivery{}).
+		Where("id = ? AND status = ?", delivery.ID, domain.DeliveryStatusWaiting.String()).
+		Updates(map[string]any{
+			"status":      delivery.Status,
+			"err_code":    delivery.ErrCode,
+			"err_msg":     delivery.ErrMsg,
+			"report_time": delivery.ReportTime,
+			"utime":       time.Now().UnixMilli(),
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (d *deliveryDAO) UpdateNextQueryTime(ctx context.Context, ids []int64, nextQueryTime int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return d.db.WithContext(ctx).Model(&Delivery{}).
+		Where("id IN ?", ids).
+		Updates(map[string]any{
+			"next_query_time": nextQueryTime,
+			"utime":           time.Now().UnixMilli(),
+		}).Error
+}
+
+func (d *deliveryDAO) CountWaiting(ctx context.Context, notificationID int64) (int64, error) {
+	var cnt int64
+	err := d.db.WithContext(ctx).Model(&Delivery{}).
+		Where("notification_id = ? AND status = ?", notificationID, domain.DeliveryStatusWaiting.String()).
+		Count(&cnt).Error
+	return cnt, err
+}
+
+func (d *deliveryDAO) RecordProvider(ctx context.Context, notificationID int64, receivers []string, provider string) error {
+	if len(receivers) == 0 {
+		return nil
+	}
+	return d.db.WithContext(ctx).Model(&Delivery{}).
+		Where("notification_id = ? AND receiver IN ? AND status IN ?", notificationID, receivers,
+			[]string{domain.DeliveryStatusPending.String(), domain.DeliveryStatusSubmitFailed.String()}).
+		Updates(map[string]any{
+			"provider": provider,
+			"utime":    time.Now().UnixMilli(),
+		}).Error
+}
+
+func (d *deliveryDAO) IncrAttempts(ctx context.Context, notificationID int64, receivers []string) error {
+	if len(receivers) == 0 {
+		return nil
+	}
+	return d.db.WithContext(ctx).Model(&Delivery{}).
+		Where("notification_id = ? AND receiver IN ?", notificationID, receivers).
+		Updates(map[string]any{
+			"attempts": gorm.Expr("attempts + 1"),
+			"utime":    time.Now().UnixMilli(),
+		}).Error
+}
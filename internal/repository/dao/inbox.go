@@ -0,0 +1,144 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// InboxMessage 站内信收件箱表
+type InboxMessage struct {
+	ID             int64  `gorm:"primaryKey;AUTO_INCREMENT;comment:'站内信ID'"`
+	BizID          int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_receiver_read,priority:1;comment:'业务配置ID'"`
+	NotificationID int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:1;comment:'关联的通知ID'"`
+	Receiver       string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:2;index:idx_biz_id_receiver_read,priority:2;comment:'接收者'"`
+	Title          string `gorm:"type:VARCHAR(256);NOT NULL;DEFAULT:'';comment:'标题'"`
+	Content        string `gorm:"type:TEXT;NOT NULL;comment:'渲染后的内容'"`
+	IsRead         bool   `gorm:"NOT NULL;DEFAULT:false;index:idx_biz_id_receiver_read,priority:3;comment:'是否已读'"`
+	ReadTime       int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'阅读时间'"`
+	Ctime          int64
+	Utime          int64
+}
+
+func (InboxMessage) TableName() string {
+	return "inbox_messages"
+}
+
+type InboxDAO interface {
+	// BatchCreate 写入收件箱，同一通知的同一接收者只会写入一次
+	BatchCreate(ctx context.Context, messages []InboxMessage) error
+	// List 按ID倒序分页，cursor为上一页最后一条的ID
+	List(ctx context.Context, bizID int64, receiver string, cursor int64, limit int, unreadOnly bool) ([]InboxMessage, error)
+	// ListSince 按ID正序返回ID大于afterID的消息，用于实时推送和断线重放
+	ListSince(ctx context.Context, bizID int64, receiver string, afterID int64, limit int) ([]InboxMessage, error)
+	// LatestID 收件箱中最新一条消息的ID，没有消息时返回0
+	LatestID(ctx context.Context, bizID int64, receiver string) (int64, error)
+	CountUnread(ctx context.Context, bizID int64, receiver string) (int64, error)
+	UpdateReadStatus(ctx context.Context, bizID int64, receiver string, ids []int64, read bool) (int64, error)
+	MarkAllRead(ctx context.Context, bizID int64, receiver string) (int64, error)
+	Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+}
+
+type inboxDAO struct {
+	db *gorm.DB
+}
+
+func NewInboxDAO(db *gorm.DB) InboxDAO {
+	return &inboxDAO{db: db}
+}
+
+func (d *inboxDAO) BatchCreate(ctx context.Context, messages []InboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	for i := range messages {
+		messages[i].Ctime = now
+		messages[i].Utime = now
+	}
+	// 重试发送时不重复写入
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&messages).Error
+}
+
+func (d *inboxDAO) List(ctx context.Context, bizID int64, receiver string, cursor int64, limit int, unreadOnly bool) ([]InboxMessage, error) {
+	var messages []InboxMessage
+	query := d.db.WithContext(ctx).
+		Where("biz_id = ? AND receiver = ?", bizID, receiver)
+	if cursor > 0 {
+		query = query.Where("id < ?", cursor)
+	}
+	if unreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+	err := query.Order("id DESC").Limit(limit).Find(&messages).Error
+	return messages, err
+}
+
+func (d *inboxDAO) ListSince(ctx context.Context, bizID int64, receiver string, afterID int64, limit int) ([]InboxMessage, error) {
+	var messages []InboxMessage
+	err := d.db.WithContext(ctx).
+		Where("biz_id = ? AND receiver = ? AND id > ?", bizID, receiver, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&messages).Error
+	return messages, err
+}
+
+func (d *inboxDAO) LatestID(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	var id int64
+	err := d.db.WithContext(ctx).Model(&InboxMessage{}).
+		Select("COALESCE(MAX(id), 0)").
+		Where("biz_id = ? AND receiver = ?", bizID, receiver).
+		Scan(&id).Error
+	return id, err
+}
+
+func (d *inboxDAO) CountUnread(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	var cnt int64
+	err := d.db.WithContext(ctx).Model(&InboxMessage{}).
+		Where("biz_id = ? AND receiver = ? AND is_read = ?", bizID, receiver, false).
+		Count(&cnt).Error
+	return cnt, err
+}
+
+func (d *inboxDAO) UpdateReadStatus(ctx context.Context, bizID int64, receiver string, ids []int64, read bool) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	now := time.Now().UnixMilli()
+	readTime := int64(0)
+	if read {
+		readTime = now
+	}
+	res := d.db.WithContext(ctx).Model(&InboxMessage{}).
+		Where("biz_id = ? AND receiver = ? AND id IN (?) AND is_read = ?", bizID, receiver, ids, !read).
+		Updates(map[string]any{
+			"is_read":   read,
+			"read_time": readTime,
+			"utime":     now,
+		})
+	return res.RowsAffected, res.Error
+}
+
+func (d *inboxDAO) MarkAllRead(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	now := time.Now().UnixMilli()
+	res := d.db.WithContext(ctx).Model(&InboxMessage{}).
+		Where("biz_id = ? AND receiver = ? AND is_read = ?", bizID, receiver, false).
+		Updates(map[string]any{
+			"is_read":   true,
+			"read_time": now,
+			"utime":     now,
+		})
+	return res.RowsAffected, res.Error
+}
+
+func (d *inboxDAO) Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	res := d.db.WithContext(ctx).
+		Where("biz_id = ? AND receiver = ? AND id IN (?)", bizID, receiver, ids).
+		Delete(&InboxMessage{})
+	return res.RowsAffected, res.Error
+}
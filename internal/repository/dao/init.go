@@ -2,6 +2,16 @@ package dao
 
 import "gorm.io/gorm"
 
+// InitShardingTables 在分库中创建通知分表，按接收者检索需要分库中有发送记录表
+func InitShardingTables(db *gorm.DB, tables []string) error {
+	for _, table := range tables {
+		if err := db.Table(table).AutoMigrate(&Notification{}); err != nil {
+			return err
+		}
+	}
+	return db.AutoMigrate(&Delivery{})
+}
+
 func InitTables(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&BusinessConfig{},
@@ -13,5 +23,13 @@ func InitTables(db *gorm.DB) error {
 		&ChannelTemplateVersion{},
 		&ChannelTemplateProvider{},
 		&Quota{},
+		&InboxMessage{},
+		&InvalidPushToken{},
+		&Delivery{},
+		&DeadLetter{},
+		&NotificationTransition{},
+		&NotificationSeries{},
+		&Campaign{},
+		&CampaignReceiver{},
 	)
 }
@@ -8,24 +8,33 @@ import (
 	"go-notification/internal/domain"
 	"go-notification/internal/errs"
 	"gorm.io/gorm"
+	"strings"
 	"time"
 )
 
 type Notification struct {
 	ID                int64  `gorm:"primaryKey;comment:'雪花算法ID'"`
-	BizID             int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_status,proority:1;uniqueIndex:idx_biz_id_key,priority:1;comment:'业务方配表ID，业务方可能有多个业务每个业务配置不同'"`
-	Key               string `gorm:"type:VARCHAR(256);NOT NULL;quiqueIndex:idx_biz_id_key,priority:2;comment:'业务内唯一标识'"`
+	BizID             int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_status,priority:1;uniqueIndex:idx_biz_id_key,priority:1;index:idx_biz_id_ctime,priority:1;index:idx_biz_id_utime,priority:1;index:idx_biz_id_channel_ctime,priority:1;index:idx_biz_id_template_id_ctime,priority:1;comment:'业务方配表ID，业务方可能有多个业务每个业务配置不同'"`
+	Key               string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_biz_id_key,priority:2;comment:'业务内唯一标识'"`
 	Receivers         string `gorm:"type:TEXT;NOT NULL;comment:'接收者(手机/邮箱/用户ID)，JSON数组'"`
-	Channel           string `gorm:"type:ENUM('SMS', 'EMAIL', 'IN_APP');NOT NULL;comment:'发送渠道'"`
-	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;comment:'关联的模版ID'"`
+	Channel           string `gorm:"type:ENUM('SMS', 'EMAIL', 'IN_APP', 'WEBHOOK', 'IM', 'PUSH');NOT NULL;index:idx_biz_id_channel_ctime,priority:2;comment:'发送渠道'"`
+	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_template_id_ctime,priority:2;comment:'关联的模版ID'"`
 	TemplateVersionID int64  `gorm:"type:BIGINT;NOT NULL;comment:'关联的模版版本ID'"`
+	TemplatePinned    bool   `gorm:"NOT NULL;DEFAULT:false;comment:'是否按关联的模版版本发送，否则使用模版当前的发布版本'"`
 	TemplateParams    string `gorm:"NOT NULL;comment:'模板参数'"`
-	Status            string `gorm:"type:ENUM('PREPARE', 'CANCELED', 'PENDING', 'SENDING', 'SUCCEEDED', 'FAILED');DEFAULT:'PENDING';index:idx_biz_id_status,priority:2;comment:'发送状态'"`
-	ScheduledSTime    int64  `gorm:"column:scheduled_time;index:idx_scheuled,priority:1;comment:'计划发送开始时间'"`
-	ScheduledETime    int64  `gorm:"column:scheduled_time;index:idx_scheuled,priority:2;comment:'计划发送结束时间'"`
+	Status            string `gorm:"type:ENUM('PREPARE', 'CANCELED', 'PENDING', 'SENDING', 'SUCCEEDED', 'FAILED', 'PARTIALLY_SUCCEEDED', 'FREQUENCY_CAPPED', 'DIGESTING');DEFAULT:'PENDING';index:idx_biz_id_status,priority:2;index:idx_status_digest_id,priority:1;comment:'发送状态'"`
+	ScheduledSTime    int64  `gorm:"column:scheduled_stime;index:idx_scheuled,priority:1;comment:'计划发送开始时间'"`
+	ScheduledETime    int64  `gorm:"column:scheduled_etime;index:idx_scheuled,priority:2;comment:'计划发送结束时间'"`
 	Version           int    `gorm:"type:INT;NOT NULL;DEFAULT:1;comment:'版本号'"`
-	Ctime             int64
-	Utime             int64
+	FallbackTemplates string `gorm:"type:TEXT;comment:'降级渠道模板，JSON对象，渠道 -> 模板ID'"`
+	DeliveredChannel  string `gorm:"type:VARCHAR(16);NOT NULL;DEFAULT:'';comment:'实际送达的渠道'"`
+	RetryCount        int32  `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'自动重试次数'"`
+	ReceiverTimezone  string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'接收者时区，免打扰时段按接收者时区计算'"`
+	DigestGroup       string `gorm:"type:VARCHAR(128);NOT NULL;DEFAULT:'';comment:'汇总分组，为空表示不汇总发送'"`
+	DigestTemplateID  int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'汇总模板ID'"`
+	DigestID          int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;index:idx_status_digest_id,priority:2;comment:'合并发送的汇总通知ID'"`
+	Ctime             int64  `gorm:"index:idx_biz_id_ctime,priority:2;index:idx_biz_id_channel_ctime,priority:3;index:idx_biz_id_template_id_ctime,priority:3"`
+	Utime             int64  `gorm:"index:idx_biz_id_utime,priority:2"`
 }
 
 type NotificationDAO interface {
@@ -44,12 +53,48 @@ type NotificationDAO interface {
 	CASStatus(ctx context.Context, notification Notification) error
 	UpdateStatus(ctx context.Context, notification Notification) error
 
-	BatchUpdateStatusSucceedOrFailed(ctx context.Context, succededNotifications, failedNotifications []Notification) error
+	// BatchUpdateStatusSucceedOrFailed 按版本号把发送中的通知批量更新为成功或失败，返回实际更新的通知ID
+	// 已经被超时核对等其他路径更新过的通知不会被覆盖
+	BatchUpdateStatusSucceedOrFailed(ctx context.Context, succededNotifications, failedNotifications []Notification) ([]int64, error)
 
 	FindReadyNotifications(ctx context.Context, offset, limit int) ([]Notification, error)
+	// MarkSuccess 将发送中的通知标记为成功，发送结果和超时核对都可能更新，以先到的为准
+	// 通知已经不是发送中时返回 errs.ErrNotificationVersionMismatch
 	MarkSuccess(ctx context.Context, notification Notification) error
+	// MarkFailed 将发送中的通知标记为失败，通知不是发送中时返回 errs.ErrNotificationVersionMismatch
 	MarkFailed(ctx context.Context, notification Notification) error
-	MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) (int64, error)
+	// FindTimeoutSending 查找发送中超过一分钟没有结果的通知
+	FindTimeoutSending(ctx context.Context, batchSize int) ([]Notification, error)
+	// Requeue 将发送中的通知改回待发送，由调度重新发送
+	Requeue(ctx context.Context, notification Notification) error
+	// MarkRetry 发送失败等待自动重试，改回待发送并更新重试次数和下一次发送时间，不触发回调
+	// 通知已经不是发送中时返回 errs.ErrNotificationVersionMismatch
+	MarkRetry(ctx context.Context, notification Notification) error
+	// Replay 重放发送失败的通知，按版本号将失败改回待发送，同时更新渠道和模板并清零重试次数
+	Replay(ctx context.Context, notification Notification) error
+	// Search 按条件检索业务下的通知，按排序字段和ID排序，最多返回 q.Limit 条
+	Search(ctx context.Context, q domain.NotificationSearch) ([]Notification, error)
+	// MarkSending 调度发送前按版本号把待发送的通知改为发送中，返回抢占成功的通知ID
+	MarkSending(ctx context.Context, notifications []Notification) ([]int64, error)
+	// Cancel 按版本号取消待发送的通知，同时标记为可以发送回调
+	Cancel(ctx context.Context, notification Notification) error
+	// Reschedule 按版本号修改待发送通知的计划发送时间
+	Reschedule(ctx context.Context, notification Notification) error
+	// Defer 按版本号推迟待发送通知的计划发送时间，并追加一条待发送到待发送的流转记录
+	Defer(ctx context.Context, notification Notification) error
+	// FindDueDigestItems 查找等待合并并且已经到了合并时间的通知
+	FindDueDigestItems(ctx context.Context, limit int) ([]Notification, error)
+	// FindDigestItems 按ID顺序查找同一分组中等待合并的通知
+	FindDigestItems(ctx context.Context, item Notification, limit int) ([]Notification, error)
+	// CreateDigest 创建汇总通知，并把合并的通知关联到汇总通知
+	// 合并的通知已经被关联到其他汇总通知时返回 errs.ErrNotificationVersionMismatch
+	CreateDigest(ctx context.Context, digest Notification, itemIDs []int64) (Notification, error)
+	// FindDigestedItems 查找已经合并、等待汇总通知发送结果的通知
+	FindDigestedItems(ctx context.Context, limit int) ([]Notification, error)
+	// SettleDigestItems 汇总通知发送结束后，把合并的通知改为汇总通知的状态
+	SettleDigestItems(ctx context.Context, digest Notification, itemIDs []int64) error
+	// CountByKeyPrefix 按状态统计业务下标识以 keyPrefix 开头的通知数量
+	CountByKeyPrefix(ctx context.Context, bizID int64, keyPrefix string) (map[string]int64, error)
 }
 
 type notificationDAO struct {
@@ -120,73 +165,93 @@ func (d *notificationDAO) GetByKeys(ctx context.Context, BizID int64, keys ...st
 
 // CASStatus 更新通知状态
 func (d *notificationDAO) CASStatus(ctx context.Context, notification Notification) error {
-	updates := map[string]interface{}{
-		"status":  notification.Status,
-		"version": gorm.Expr("version + 1"),
-		"utime":   time.Now().UnixMilli(),
-	}
-
-	result := d.db.WithContext(ctx).Model(&Notification{}).
-		Where("id = ? AND version = ?", notification.ID, notification.Version).
-		Updates(updates)
-
-	if result.Error != nil {
-		return result.Error
-	}
-
-	if result.RowsAffected < 1 {
-		return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
-	}
-	return nil
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		affected, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ? AND version = ?", notification.ID, notification.Version)
+		}, notification.Status, map[string]interface{}{
+			"version": gorm.Expr("version + 1"),
+			"utime":   time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		if affected < 1 {
+			return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		return nil
+	})
 }
 
 func (d *notificationDAO) UpdateStatus(ctx context.Context, notification Notification) error {
-	return d.db.WithContext(ctx).Model(&Notification{}).
-		Where("id = ?", notification.ID).
-		Updates(map[string]interface{}{
-			"status":  notification.Status,
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ?", notification.ID)
+		}, notification.Status, map[string]interface{}{
 			"version": gorm.Expr("version + 1"),
 			"utime":   time.Now().UnixMilli(),
-		}).Error
+		})
+		return err
+	})
 }
 
 // BatchUpdateStatusSucceedOrFailed 批量更新通知状态为成功或失败，使用乐观锁控制并发
 // successNotifications：更新为成功状态的通知列表，包含ID、Version和重试次数
 // failedNotifications：更新为失败状态的通知列表，包含ID、Version和重试次数
-func (d *notificationDAO) BatchUpdateStatusSucceedOrFailed(ctx context.Context, succededNotifications, failedNotifications []Notification) error {
+func (d *notificationDAO) BatchUpdateStatusSucceedOrFailed(ctx context.Context, succededNotifications, failedNotifications []Notification) ([]int64, error) {
 	if len(succededNotifications) == 0 && len(failedNotifications) == 0 {
-		return nil
+		return nil, nil
 	}
 
-	successIDs := make([]int64, 0, len(succededNotifications))
-	for _, notification := range succededNotifications {
-		successIDs = append(successIDs, notification.ID)
+	// 成功和部分成功的通知按状态和实际送达渠道分组更新
+	type successKey struct {
+		status           string
+		deliveredChannel string
 	}
-	failedIDs := make([]int64, 0, len(failedNotifications))
-	for _, notification := range failedNotifications {
-		failedIDs = append(failedIDs, notification.ID)
+	successGroups := make(map[successKey][]Notification)
+	for _, notification := range succededNotifications {
+		key := successKey{status: notification.Status, deliveredChannel: notification.DeliveredChannel}
+		successGroups[key] = append(successGroups[key], notification)
 	}
 
+	var affected []int64
 	// 开启事务
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if len(successIDs) != 0 {
-			err := d.batchMarkSuccess(tx, successIDs)
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		affected = affected[:0]
+		for key, notifications := range successGroups {
+			ids, err := d.batchMarkSuccess(ctx, tx, notifications, key.status, key.deliveredChannel)
 			if err != nil {
 				return err
 			}
+			affected = append(affected, ids...)
 		}
-		if len(failedIDs) != 0 {
-			now := time.Now().UnixMilli()
-			return tx.Model(&Notification{}).
-				Where("id in (?)", successIDs).
-				Updates(map[string]interface{}{
-					"status":  domain.SendStatusFailed.String(),
+		if len(failedNotifications) != 0 {
+			ids, err := updateStatusReturningIDs(ctx, tx, sendingWithVersions(failedNotifications),
+				domain.SendStatusFailed.String(), map[string]interface{}{
 					"version": gorm.Expr("version + 1"),
-					"utime":   now,
-				}).Error
+					"utime":   time.Now().UnixMilli(),
+				})
+			if err != nil {
+				return err
+			}
+			affected = append(affected, ids...)
 		}
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return affected, nil
+}
+
+// sendingWithVersions 只选中版本号没有变化并且仍在发送中的通知
+func sendingWithVersions(notifications []Notification) func(db *gorm.DB) *gorm.DB {
+	idVersions := make([][]interface{}, 0, len(notifications))
+	for i := range notifications {
+		idVersions = append(idVersions, []interface{}{notifications[i].ID, notifications[i].Version})
+	}
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("(id, version) IN ? AND status = ?", idVersions, domain.SendStatusSending.String())
+	}
 }
 
 func (d *notificationDAO) FindReadyNotifications(ctx context.Context, offset, limit int) ([]Notification, error) {
@@ -201,16 +266,19 @@ func (d *notificationDAO) FindReadyNotifications(ctx context.Context, offset, li
 func (d *notificationDAO) MarkSuccess(ctx context.Context, notification Notification) error {
 	now := time.Now().UnixMilli()
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.Model(&Notification{}).
-			Where("id = ?", notification.ID).
-			Updates(map[string]interface{}{
-				"status":  notification.Status,
-				"version": gorm.Expr("version + 1"),
-				"utime":   now,
-			}).Error
+		affected, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ? AND status = ?", notification.ID, domain.SendStatusSending.String())
+		}, notification.Status, map[string]interface{}{
+			"delivered_channel": notification.DeliveredChannel,
+			"version":           gorm.Expr("version + 1"),
+			"utime":             now,
+		})
 		if err != nil {
 			return err
 		}
+		if affected == 0 {
+			return fmt.Errorf("%w: 通知 %d 不是发送中状态", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
 		return tx.Model(&CallbackLog{}).Where("notification_id = ?", notification.ID).Updates(map[string]interface{}{
 			// 标记为可以发送回调
 			"status": domain.CallbackLogStatusPending,
@@ -220,55 +288,355 @@ func (d *notificationDAO) MarkSuccess(ctx context.Context, notification Notifica
 }
 
 func (d *notificationDAO) MarkFailed(ctx context.Context, notification Notification) error {
-	now := time.Now().UnixMilli()
-	return d.db.WithContext(ctx).Model(&Notification{}).
-		Where("id = ?", notification.ID).
-		Updates(map[string]interface{}{
-			"status":  notification.Status,
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		affected, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ? AND status = ?", notification.ID, domain.SendStatusSending.String())
+		}, notification.Status, map[string]interface{}{
 			"version": gorm.Expr("version + 1"),
-			"utime":   now,
-		}).Error
+			"utime":   time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return fmt.Errorf("%w: 通知 %d 不是发送中状态", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		return nil
+	})
 }
 
-func (d *notificationDAO) MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) (int64, error) {
-	now := time.Now()
-	ddl := now.Add(-time.Minute).UnixMilli()
-	var rowsAffected int64
+func (d *notificationDAO) FindTimeoutSending(ctx context.Context, batchSize int) ([]Notification, error) {
+	ddl := time.Now().Add(-time.Minute).UnixMilli()
+	var result []Notification
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND utime <= ?", domain.SendStatusSending.String(), ddl).
+		Order("utime ASC").
+		Limit(batchSize).
+		Find(&result).Error
+	return result, err
+}
+
+func (d *notificationDAO) Requeue(ctx context.Context, notification Notification) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		affected, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ? AND status = ?", notification.ID, domain.SendStatusSending.String())
+		}, domain.SendStatusPending.String(), map[string]interface{}{
+			"scheduled_stime": notification.ScheduledSTime,
+			"scheduled_etime": notification.ScheduledETime,
+			"version":         gorm.Expr("version + 1"),
+			"utime":           time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return fmt.Errorf("%w: 通知 %d 不是发送中状态", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		return nil
+	})
+}
+
+func (d *notificationDAO) MarkRetry(ctx context.Context, notification Notification) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		affected, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ? AND status = ?", notification.ID, domain.SendStatusSending.String())
+		}, notification.Status, map[string]interface{}{
+			"retry_count":     notification.RetryCount,
+			"scheduled_stime": notification.ScheduledSTime,
+			"scheduled_etime": notification.ScheduledETime,
+			"version":         gorm.Expr("version + 1"),
+			"utime":           time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return fmt.Errorf("%w: 通知 %d 不是发送中状态", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		return nil
+	})
+}
+
+func (d *notificationDAO) Replay(ctx context.Context, notification Notification) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		affected, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ? AND version = ? AND status = ?", notification.ID, notification.Version, domain.SendStatusFailed.String())
+		}, domain.SendStatusPending.String(), map[string]interface{}{
+			"channel":             notification.Channel,
+			"template_id":         notification.TemplateID,
+			"template_version_id": notification.TemplateVersionID,
+			"template_pinned":     notification.TemplatePinned,
+			"retry_count":         0,
+			"scheduled_stime":     notification.ScheduledSTime,
+			"scheduled_etime":     notification.ScheduledETime,
+			"version":             gorm.Expr("version + 1"),
+			"utime":               time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return fmt.Errorf("%w: 通知 %d 不是发送失败状态或已被修改", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		return nil
+	})
+}
 
+func (d *notificationDAO) MarkSending(ctx context.Context, notifications []Notification) ([]int64, error) {
+	claimed := make([]int64, 0, len(notifications))
 	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var idsToUpdate []int64
+		now := time.Now().UnixMilli()
+		for i := range notifications {
+			affected, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+				return db.Where("id = ? AND version = ? AND status = ?",
+					notifications[i].ID, notifications[i].Version, domain.SendStatusPending.String())
+			}, domain.SendStatusSending.String(), map[string]interface{}{
+				"version": gorm.Expr("version + 1"),
+				"utime":   now,
+			})
+			if err != nil {
+				return err
+			}
+			// 已被取消、改期或者其他实例抢占
+			if affected > 0 {
+				claimed = append(claimed, notifications[i].ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
 
-		// 查询需要更新的 ID
-		err := tx.Model(&Notification{}).
-			Select("id").
-			Where("status = ? AND utime <= ?", domain.SendStatusPending.String(), ddl).
-			Limit(batchSize).
-			Find(&idsToUpdate).Error
+func (d *notificationDAO) Cancel(ctx context.Context, notification Notification) error {
+	now := time.Now().UnixMilli()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		affected, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ? AND version = ? AND status = ?", notification.ID, notification.Version, domain.SendStatusPending.String())
+		}, domain.SendStatusCanceled.String(), map[string]interface{}{
+			"version": gorm.Expr("version + 1"),
+			"utime":   now,
+		})
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return fmt.Errorf("%w: 通知 %d 不是待发送状态或已被修改", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		return tx.Model(&CallbackLog{}).Where("notification_id = ?", notification.ID).Updates(map[string]interface{}{
+			// 标记为可以发送回调
+			"status": domain.CallbackLogStatusPending,
+			"utime":  now,
+		}).Error
+	})
+}
 
-		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+func (d *notificationDAO) Reschedule(ctx context.Context, notification Notification) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		affected, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ? AND version = ? AND status = ?", notification.ID, notification.Version, domain.SendStatusPending.String())
+		}, domain.SendStatusPending.String(), map[string]interface{}{
+			"scheduled_stime": notification.ScheduledSTime,
+			"scheduled_etime": notification.ScheduledETime,
+			"version":         gorm.Expr("version + 1"),
+			"utime":           time.Now().UnixMilli(),
+		})
+		if err != nil {
 			return err
 		}
+		if affected == 0 {
+			return fmt.Errorf("%w: 通知 %d 不是待发送状态或已被修改", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		return nil
+	})
+}
 
-		// 没有找到需要更新的记录，直接成功返回（事务将提交）
-		if len(idsToUpdate) == 0 {
-			rowsAffected = 0
-			return nil
+func (d *notificationDAO) Defer(ctx context.Context, notification Notification) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		affected, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ? AND version = ? AND status = ?", notification.ID, notification.Version, domain.SendStatusPending.String())
+		}, domain.SendStatusPending.String(), map[string]interface{}{
+			"scheduled_stime": notification.ScheduledSTime,
+			"scheduled_etime": notification.ScheduledETime,
+			"version":         gorm.Expr("version + 1"),
+			"utime":           time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return fmt.Errorf("%w: 通知 %d 不是待发送状态或已被修改", errs.ErrNotificationVersionMismatch, notification.ID)
 		}
+		// 状态没有变化，单独记录推迟
+		return createTransitions(ctx, tx, []Notification{{
+			ID:     notification.ID,
+			BizID:  notification.BizID,
+			Key:    notification.Key,
+			Status: domain.SendStatusPending.String(),
+		}}, domain.SendStatusPending.String())
+	})
+}
+
+func (d *notificationDAO) FindDueDigestItems(ctx context.Context, limit int) ([]Notification, error) {
+	var result []Notification
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND digest_id = 0 AND scheduled_stime <= ?", domain.SendStatusDigesting.String(), time.Now().UnixMilli()).
+		Limit(limit).Find(&result).Error
+	return result, err
+}
 
-		// 根据查询到的 ID 集合更新记录
-		res := tx.Model(&CallbackLog{}).
-			Where("id IN (?)", idsToUpdate).
+func (d *notificationDAO) FindDigestItems(ctx context.Context, item Notification, limit int) ([]Notification, error) {
+	var result []Notification
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND digest_id = 0 AND biz_id = ? AND channel = ? AND digest_group = ? AND receivers = ?",
+			domain.SendStatusDigesting.String(), item.BizID, item.Channel, item.DigestGroup, item.Receivers).
+		Order("id").Limit(limit).Find(&result).Error
+	return result, err
+}
+
+func (d *notificationDAO) CreateDigest(ctx context.Context, digest Notification, itemIDs []int64) (Notification, error) {
+	var created Notification
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		created, err = d.create(ctx, tx, digest, false)
+		if err != nil {
+			return err
+		}
+		res := tx.Model(&Notification{}).
+			Where("id IN ? AND status = ? AND digest_id = 0", itemIDs, domain.SendStatusDigesting.String()).
 			Updates(map[string]interface{}{
-				"status":  domain.CallbackLogStatusFailed.String(),
-				"version": gorm.Expr("version + 1"),
-				"utime":   now.UnixMilli(),
+				"digest_id": created.ID,
+				"version":   gorm.Expr("version + 1"),
+				"utime":     time.Now().UnixMilli(),
 			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected != int64(len(itemIDs)) {
+			return fmt.Errorf("%w: 部分通知已经被合并", errs.ErrNotificationVersionMismatch)
+		}
+		return nil
+	})
+	return created, err
+}
+
+func (d *notificationDAO) FindDigestedItems(ctx context.Context, limit int) ([]Notification, error) {
+	var result []Notification
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND digest_id <> 0", domain.SendStatusDigesting.String()).
+		Limit(limit).Find(&result).Error
+	return result, err
+}
 
-		rowsAffected = res.RowsAffected
-		return res.Error
+func (d *notificationDAO) SettleDigestItems(ctx context.Context, digest Notification, itemIDs []int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, err := updateStatusWithTransitions(ctx, tx, func(db *gorm.DB) *gorm.DB {
+			return db.Where("id IN ? AND digest_id = ? AND status = ?", itemIDs, digest.ID, domain.SendStatusDigesting.String())
+		}, digest.Status, map[string]interface{}{
+			"delivered_channel": digest.DeliveredChannel,
+			"version":           gorm.Expr("version + 1"),
+			"utime":             time.Now().UnixMilli(),
+		})
+		return err
 	})
+}
 
-	return rowsAffected, err
+func (d *notificationDAO) Search(ctx context.Context, q domain.NotificationSearch) ([]Notification, error) {
+	var result []Notification
+	err := searchNotifications(d.db.WithContext(ctx).Model(&Notification{}), q).Find(&result).Error
+	return result, err
+}
+
+// searchNotifications 在 db 上拼接检索条件，分库分表时 db 已经指定了具体的表
+func searchNotifications(db *gorm.DB, q domain.NotificationSearch) *gorm.DB {
+	db = db.Where("biz_id = ?", q.BizID)
+	if q.Channel != "" {
+		db = db.Where("channel = ?", q.Channel.String())
+	}
+	if len(q.Statuses) > 0 {
+		statuses := make([]string, 0, len(q.Statuses))
+		for _, st := range q.Statuses {
+			statuses = append(statuses, st.String())
+		}
+		db = db.Where("status IN ?", statuses)
+	}
+	if q.TemplateID > 0 {
+		db = db.Where("template_id = ?", q.TemplateID)
+	}
+	if q.StartTime > 0 {
+		db = db.Where("ctime >= ?", q.StartTime)
+	}
+	if q.EndTime > 0 {
+		db = db.Where("ctime <= ?", q.EndTime)
+	}
+	if q.KeyPrefix != "" {
+		db = db.Where("`key` LIKE ?", escapeLike(q.KeyPrefix)+"%")
+	}
+	if q.Receiver != "" {
+		// 接收者存放在发送记录中，通过 idx_biz_id_receiver 找到通知ID
+		db = db.Where("id IN (?)", db.Session(&gorm.Session{NewDB: true}).
+			Model(&Delivery{}).Select("notification_id").
+			Where("biz_id = ? AND receiver IN ?", q.BizID, receiverVariants(q.Receiver)))
+	}
+
+	column := string(q.SortBy)
+	order := "DESC"
+	op := "<"
+	if q.Ascending {
+		order = "ASC"
+		op = ">"
+	}
+	if !q.Cursor.IsZero() {
+		db = db.Where(fmt.Sprintf("((%s %s ?) OR (%s = ? AND id %s ?))", column, op, column, op),
+			q.Cursor.SortValue, q.Cursor.SortValue, q.Cursor.ID)
+	}
+	return db.Order(fmt.Sprintf("%s %s, id %s", column, order, order)).Limit(q.Limit)
+}
+
+// receiverVariants 发送记录中保存的是请求里的原始接收者，手机号带不带国家码前缀都要能匹配
+func receiverVariants(receiver string) []string {
+	trimmed := domain.TrimPhonePrefix(receiver)
+	if trimmed == receiver && !isDigits(receiver) {
+		return []string{receiver}
+	}
+	return []string{trimmed, "+86" + trimmed, "0086" + trimmed}
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// CountByKeyPrefix 按状态分组统计，key 前缀中的通配符按普通字符匹配
+func (d *notificationDAO) CountByKeyPrefix(ctx context.Context, bizID int64, keyPrefix string) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Cnt    int64
+	}
+	err := d.db.WithContext(ctx).Model(&Notification{}).
+		Select("status, COUNT(*) AS cnt").
+		Where("biz_id = ? AND `key` LIKE ?", bizID, escapeLike(keyPrefix)+"%").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		res[row.Status] = row.Cnt
+	}
+	return res, nil
+}
+
+// escapeLike 转义 LIKE 中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
 }
 
 // isUniqueConstraintError 检查是否是唯一约束错误
@@ -296,6 +664,12 @@ func (d *notificationDAO) create(ctx context.Context, db *gorm.DB, data Notifica
 			}
 			return err
 		}
+		if err := d.createPendingDeliveries(tx, []Notification{data}, now); err != nil {
+			return err
+		}
+		if err := createInitialTransitions(ctx, tx, []Notification{data}); err != nil {
+			return err
+		}
 		if createCallbackLog {
 			if err := tx.Create(&CallbackLog{
 				NotificationID: data.ID,
@@ -333,6 +707,12 @@ func (d *notificationDAO) batchCreate(ctx context.Context, dataList []Notificati
 			}
 			return err
 		}
+		if err := d.createPendingDeliveries(tx, dataList, now); err != nil {
+			return err
+		}
+		if err := createInitialTransitions(ctx, tx, dataList); err != nil {
+			return err
+		}
 
 		if createCallbackLog {
 			// 创建回调记录
@@ -355,22 +735,36 @@ func (d *notificationDAO) batchCreate(ctx context.Context, dataList []Notificati
 	return dataList, err
 }
 
-func (d *notificationDAO) batchMarkSuccess(tx *gorm.DB, successIDs []int64) error {
-	now := time.Now().UnixMilli()
-	err := tx.Model(&Notification{}).
-		Where("id in (?)", successIDs).
-		Updates(map[string]interface{}{
-			"version": gorm.Expr("version + 1"),
-			"utime":   now,
-			"status":  domain.SendStatusSucceeded.String(),
-		}).Error
+// createPendingDeliveries 为每个接收者创建待发送记录，后续发送和重试都以这些记录为准
+func (d *notificationDAO) createPendingDeliveries(tx *gorm.DB, dataList []Notification, now int64) error {
+	const batchSize = 500
+	deliveries, err := pendingDeliveries(dataList, now)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: 接收者格式错误 %w", errs.ErrCreateNotificationFailed, err)
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return tx.CreateInBatches(deliveries, batchSize).Error
+}
+
+func (d *notificationDAO) batchMarkSuccess(ctx context.Context, tx *gorm.DB, notifications []Notification, status, deliveredChannel string) ([]int64, error) {
+	if status != domain.SendStatusPartiallySucceeded.String() {
+		status = domain.SendStatusSucceeded.String()
+	}
+	now := time.Now().UnixMilli()
+	ids, err := updateStatusReturningIDs(ctx, tx, sendingWithVersions(notifications), status, map[string]interface{}{
+		"version":           gorm.Expr("version + 1"),
+		"utime":             now,
+		"delivered_channel": deliveredChannel,
+	})
+	if err != nil || len(ids) == 0 {
+		return nil, err
 	}
 
 	// 更新 callback log
-	return tx.Model(&Notification{}).
-		Where("notification_id in (?)", successIDs).
+	return ids, tx.Model(&CallbackLog{}).
+		Where("notification_id in (?)", ids).
 		Updates(map[string]interface{}{
 			"status": domain.CallbackLogStatusPending.String(),
 			"utime":  now,
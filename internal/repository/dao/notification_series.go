@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-sql-driver/mysql"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"gorm.io/gorm"
+	"time"
+)
+
+// NotificationSeries 周期发送，每到发送时间生成一条子通知
+type NotificationSeries struct {
+	ID                int64  `gorm:"primaryKey;comment:'雪花算法ID'"`
+	BizID             int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_biz_id_key,priority:1;comment:'业务配置ID'"`
+	Key               string `gorm:"type:VARCHAR(200);NOT NULL;uniqueIndex:idx_biz_id_key,priority:2;comment:'业务内唯一标识，子通知的标识以其为前缀'"`
+	Receivers         string `gorm:"type:TEXT;NOT NULL;comment:'接收者(手机/邮箱/用户ID)，JSON数组'"`
+	Channel           string `gorm:"type:ENUM('SMS', 'EMAIL', 'IN_APP', 'WEBHOOK', 'IM', 'PUSH');NOT NULL;comment:'发送渠道'"`
+	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;comment:'关联的模版ID'"`
+	TemplateVersionID int64  `gorm:"type:BIGINT;NOT NULL;comment:'关联的模版版本ID'"`
+	TemplatePinned    bool   `gorm:"NOT NULL;DEFAULT:false;comment:'是否按关联的模版版本发送，否则使用模版当前的发布版本'"`
+	TemplateParams    string `gorm:"NOT NULL;comment:'模板参数'"`
+	FallbackTemplates string `gorm:"type:TEXT;comment:'降级渠道模板，JSON对象，渠道 -> 模板ID'"`
+	ReceiverTimezone  string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'接收者时区，免打扰时段按接收者时区计算'"`
+	CronExpr          string `gorm:"type:VARCHAR(128);NOT NULL;comment:'cron 表达式'"`
+	Timezone          string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'时区，为空时使用 UTC'"`
+	EndTime           int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'截止时间，0 表示不限'"`
+	MaxOccurrences    int32  `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'最多发送次数，0 表示不限'"`
+	Occurrences       int32  `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'已经生成的子通知数量'"`
+	NextTime          int64  `gorm:"type:BIGINT;NOT NULL;index:idx_status_next_time,priority:2;comment:'下一次发送时间'"`
+	Status            string `gorm:"type:ENUM('ACTIVE', 'PAUSED', 'STOPPED', 'COMPLETED');NOT NULL;DEFAULT:'ACTIVE';index:idx_status_next_time,priority:1;comment:'周期发送状态'"`
+	Version           int    `gorm:"type:INT;NOT NULL;DEFAULT:1;comment:'版本号'"`
+	Ctime             int64
+	Utime             int64
+}
+
+func (NotificationSeries) TableName() string {
+	return "notification_series"
+}
+
+type NotificationSeriesDAO interface {
+	Create(ctx context.Context, data NotificationSeries) (NotificationSeries, error)
+	GetByKey(ctx context.Context, bizID int64, key string) (NotificationSeries, error)
+	// FindDue 按下一次发送时间升序查找到了发送时间的周期发送
+	FindDue(ctx context.Context, now int64, limit int) ([]NotificationSeries, error)
+	// CASUpdate 按版本号更新已发送次数、下一次发送时间和状态
+	CASUpdate(ctx context.Context, data NotificationSeries) error
+}
+
+type notificationSeriesDAO struct {
+	db *gorm.DB
+}
+
+func NewNotificationSeriesDAO(db *gorm.DB) NotificationSeriesDAO {
+	return &notificationSeriesDAO{db: db}
+}
+
+func (d *notificationSeriesDAO) Create(ctx context.Context, data NotificationSeries) (NotificationSeries, error) {
+	now := time.Now().UnixMilli()
+	data.Ctime, data.Utime = now, now
+	data.Version = 1
+	err := d.db.WithContext(ctx).Create(&data).Error
+	if err != nil {
+		me := new(mysql.MySQLError)
+		const uniqueIndexErrorCode = 1062
+		if errors.As(err, &me) && me.Number == uniqueIndexErrorCode {
+			return NotificationSeries{}, fmt.Errorf("%w", errs.ErrNotificationDuplicate)
+		}
+		return NotificationSeries{}, err
+	}
+	return data, nil
+}
+
+func (d *notificationSeriesDAO) GetByKey(ctx context.Context, bizID int64, key string) (NotificationSeries, error) {
+	var res NotificationSeries
+	err := d.db.WithContext(ctx).Where("biz_id = ? AND `key` = ?", bizID, key).First(&res).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return NotificationSeries{}, fmt.Errorf("%w: key=%s", errs.ErrNotificationSeriesNotFound, key)
+	}
+	return res, err
+}
+
+func (d *notificationSeriesDAO) FindDue(ctx context.Context, now int64, limit int) ([]NotificationSeries, error) {
+	var res []NotificationSeries
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND next_time <= ?", domain.SeriesStatusActive.String(), now).
+		Order("next_time ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *notificationSeriesDAO) CASUpdate(ctx context.Context, data NotificationSeries) error {
+	res := d.db.WithContext(ctx).Model(&NotificationSeries{}).
+		Where("id = ? AND version = ?", data.ID, data.Version).
+		Updates(map[string]interface{}{
+			"occurrences": data.Occurrences,
+			"next_time":   data.NextTime,
+			"status":      data.Status,
+			"version":     gorm.Expr("version + 1"),
+			"utime":       time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: 周期发送 %d 已被修改", errs.ErrNotificationVersionMismatch, data.ID)
+	}
+	return nil
+}
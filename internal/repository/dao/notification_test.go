@@ -0,0 +1,67 @@
+//go:build e2e
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+const testDSN = "root:root@tcp(localhost:13316)/notification?charset=utf8mb4&collation=utf8mb4_general_ci&parseTime=True&loc=Local"
+
+type NotificationDAOSuite struct {
+	suite.Suite
+	db  *gorm.DB
+	dao NotificationDAO
+}
+
+func (s *NotificationDAOSuite) SetupSuite() {
+	db, err := gorm.Open(mysql.Open(testDSN), &gorm.Config{})
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), InitTables(db))
+	s.db = db
+	s.dao = NewNotificationDAO(db)
+}
+
+func (s *NotificationDAOSuite) TearDownTest() {
+	for _, table := range []string{"notifications", "deliveries", "notification_transitions"} {
+		require.NoError(s.T(), s.db.Exec("TRUNCATE TABLE "+table).Error)
+	}
+}
+
+func (s *NotificationDAOSuite) TestScheduledTimeRoundTrip() {
+	t := s.T()
+	start := time.Now().Add(time.Hour).UnixMilli()
+	end := time.Now().Add(2 * time.Hour).UnixMilli()
+
+	created, err := s.dao.Create(t.Context(), Notification{
+		ID:                1,
+		BizID:             1,
+		Key:               "scheduled-time",
+		Receivers:         `["13800000000"]`,
+		Channel:           domain.ChannelSMS.String(),
+		TemplateID:        1,
+		TemplateVersionID: 1,
+		TemplateParams:    "{}",
+		Status:            domain.SendStatusPending.String(),
+		ScheduledSTime:    start,
+		ScheduledETime:    end,
+	})
+	require.NoError(t, err)
+
+	found, err := s.dao.GetByID(t.Context(), created.ID)
+	require.NoError(t, err)
+	s.Equal(start, found.ScheduledSTime)
+	s.Equal(end, found.ScheduledETime)
+}
+
+func TestNotificationDAO(t *testing.T) {
+	suite.Run(t, new(NotificationDAOSuite))
+}
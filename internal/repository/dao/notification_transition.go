@@ -0,0 +1,201 @@
+package dao
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// NotificationTransition 通知状态流转记录，与状态更新在同一个事务中写入，只追加不修改
+type NotificationTransition struct {
+	ID             int64  `gorm:"primaryKey;AUTO_INCREMENT;comment:'记录ID'"`
+	NotificationID int64  `gorm:"type:BIGINT;NOT NULL;index:idx_notification_id;comment:'通知ID'"`
+	BizID          int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id;comment:'业务配置ID'"`
+	Key            string `gorm:"type:VARCHAR(256);NOT NULL;DEFAULT:'';comment:'业务内唯一标识'"`
+	FromStatus     string `gorm:"type:VARCHAR(32);NOT NULL;DEFAULT:'';comment:'变化前的状态，创建时为空'"`
+	ToStatus       string `gorm:"type:VARCHAR(32);NOT NULL;comment:'变化后的状态'"`
+	Actor          string `gorm:"type:VARCHAR(32);NOT NULL;comment:'触发方'"`
+	Provider       string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'最近一次提交的供应商'"`
+	ErrMsg         string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'错误信息'"`
+	Ctime          int64
+}
+
+func (NotificationTransition) TableName() string {
+	return "notification_transitions"
+}
+
+type NotificationTransitionDAO interface {
+	// FindByNotificationID 按发生顺序返回通知的状态流转记录
+	FindByNotificationID(ctx context.Context, notificationID int64) ([]NotificationTransition, error)
+	// FindAfter 按ID升序查找业务下ID大于 afterID 的记录，notificationIDs 和 keys 都为空时不限通知，否则满足其一即可
+	FindAfter(ctx context.Context, bizID, afterID int64, notificationIDs []int64, keys []string, limit int) ([]NotificationTransition, error)
+	// LatestID 业务下最新一条记录的ID，没有记录时返回 0
+	LatestID(ctx context.Context, bizID int64) (int64, error)
+}
+
+type notificationTransitionDAO struct {
+	db *gorm.DB
+}
+
+func NewNotificationTransitionDAO(db *gorm.DB) NotificationTransitionDAO {
+	return &notificationTransitionDAO{db: db}
+}
+
+func (d *notificationTransitionDAO) FindByNotificationID(ctx context.Context, notificationID int64) ([]NotificationTransition, error) {
+	var res []NotificationTransition
+	err := d.db.WithContext(ctx).
+		Where("notification_id = ?", notificationID).
+		Order("id ASC").
+		Find(&res).Error
+	return res, err
+}
+
+func (d *notificationTransitionDAO) FindAfter(ctx context.Context, bizID, afterID int64, notificationIDs []int64, keys []string, limit int) ([]NotificationTransition, error) {
+	db := d.db.WithContext(ctx).Where("biz_id = ? AND id > ?", bizID, afterID)
+	switch {
+	case len(notificationIDs) > 0 && len(keys) > 0:
+		db = db.Where("(notification_id IN ? OR `key` IN ?)", notificationIDs, keys)
+	case len(notificationIDs) > 0:
+		db = db.Where("notification_id IN ?", notificationIDs)
+	case len(keys) > 0:
+		db = db.Where("`key` IN ?", keys)
+	}
+	var res []NotificationTransition
+	err := db.Order("id ASC").Limit(limit).Find(&res).Error
+	return res, err
+}
+
+func (d *notificationTransitionDAO) LatestID(ctx context.Context, bizID int64) (int64, error) {
+	var id int64
+	err := d.db.WithContext(ctx).Model(&NotificationTransition{}).
+		Select("COALESCE(MAX(id), 0)").
+		Where("biz_id = ?", bizID).
+		Scan(&id).Error
+	return id, err
+}
+
+// maxTransitionErrMsgLen 与 err_msg 列的长度一致
+const maxTransitionErrMsgLen = 512
+
+// updateStatusWithTransitions 在事务 tx 中把 scope 选中的通知更新为 status，并为状态发生变化的通知追加流转记录
+// 先加锁读出原状态，再按ID更新，返回更新的行数
+func updateStatusWithTransitions(ctx context.Context, tx *gorm.DB, scope func(db *gorm.DB) *gorm.DB,
+	status string, updates map[string]interface{},
+) (int64, error) {
+	ids, err := updateStatusReturningIDs(ctx, tx, scope, status, updates)
+	return int64(len(ids)), err
+}
+
+// updateStatusReturningIDs 与 updateStatusWithTransitions 相同，返回被更新的通知ID
+func updateStatusReturningIDs(ctx context.Context, tx *gorm.DB, scope func(db *gorm.DB) *gorm.DB,
+	status string, updates map[string]interface{},
+) ([]int64, error) {
+	var olds []Notification
+	err := scope(tx.Model(&Notification{})).
+		Select("id", "biz_id", "key", "status").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Find(&olds).Error
+	if err != nil || len(olds) == 0 {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(olds))
+	for i := range olds {
+		ids = append(ids, olds[i].ID)
+	}
+	updates["status"] = status
+	if err = tx.Model(&Notification{}).Where("id IN ?", ids).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	changed := make([]Notification, 0, len(olds))
+	for i := range olds {
+		if olds[i].Status != status {
+			changed = append(changed, olds[i])
+		}
+	}
+	return ids, createTransitions(ctx, tx, changed, status)
+}
+
+// createTransitions 为 notifications 追加变为 status 的流转记录，notifications 中的状态为变化前的状态
+// 供应商和错误信息取自接收者最近一次的发送记录，上下文中记录的错误优先
+func createTransitions(ctx context.Context, tx *gorm.DB, notifications []Notification, status string) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	actor, cause := domain.TransitionFromCtx(ctx)
+
+	ids := make([]int64, 0, len(notifications))
+	for i := range notifications {
+		ids = append(ids, notifications[i].ID)
+	}
+	var deliveries []Delivery
+	err := tx.Model(&Delivery{}).
+		Select("notification_id", "provider", "err_msg", "utime").
+		Where("notification_id IN ? AND provider <> ''", ids).
+		Find(&deliveries).Error
+	if err != nil {
+		return err
+	}
+	latest := make(map[int64]Delivery, len(deliveries))
+	for i := range deliveries {
+		if d, ok := latest[deliveries[i].NotificationID]; !ok || deliveries[i].Utime >= d.Utime {
+			latest[deliveries[i].NotificationID] = deliveries[i]
+		}
+	}
+
+	now := time.Now().UnixMilli()
+	transitions := make([]NotificationTransition, 0, len(notifications))
+	for i := range notifications {
+		d := latest[notifications[i].ID]
+		errMsg := d.ErrMsg
+		if cause != nil {
+			errMsg = cause.Error()
+		}
+		if status == domain.SendStatusSucceeded.String() {
+			errMsg = ""
+		}
+		if r := []rune(errMsg); len(r) > maxTransitionErrMsgLen {
+			errMsg = string(r[:maxTransitionErrMsgLen])
+		}
+		transitions = append(transitions, NotificationTransition{
+			NotificationID: notifications[i].ID,
+			BizID:          notifications[i].BizID,
+			Key:            notifications[i].Key,
+			FromStatus:     notifications[i].Status,
+			ToStatus:       status,
+			Actor:          actor.String(),
+			Provider:       d.Provider,
+			ErrMsg:         errMsg,
+			Ctime:          now,
+		})
+	}
+	return tx.Create(&transitions).Error
+}
+
+// createInitialTransitions 创建通知时追加从空状态开始的流转记录
+func createInitialTransitions(ctx context.Context, tx *gorm.DB, notifications []Notification) error {
+	actor, _ := domain.TransitionFromCtx(ctx)
+	now := time.Now().UnixMilli()
+	transitions := make([]NotificationTransition, 0, len(notifications))
+	for i := range notifications {
+		status := notifications[i].Status
+		if status == "" {
+			status = domain.SendStatusPending.String()
+		}
+		transitions = append(transitions, NotificationTransition{
+			NotificationID: notifications[i].ID,
+			BizID:          notifications[i].BizID,
+			Key:            notifications[i].Key,
+			ToStatus:       status,
+			Actor:          actor.String(),
+			Ctime:          now,
+		})
+	}
+	if len(transitions) == 0 {
+		return nil
+	}
+	return tx.Create(&transitions).Error
+}
@@ -19,18 +19,19 @@ const (
 type Provider struct {
 	ID      int64  `gorm:"primaryKey;autoIncrement;comment:'供应商ID'"`
 	Name    string `gorm:"type:varchar(64);NOT NULL;uniqueIndex:idx_name_channel;comment:'供应商名称'"`
-	Channel string `gorm:"type:ENUM('SMS', 'EMAIL', 'IN_APP');NOT NULL;uniqueIndex:idx_name_channel;comment:'支持的渠道'"`
+	Channel string `gorm:"type:ENUM('SMS', 'EMAIL', 'IN_APP', 'WEBHOOK', 'IM', 'PUSH');NOT NULL;uniqueIndex:idx_name_channel;comment:'支持的渠道'"`
 
 	Endpoint  string `gorm:"type:varchar(255);NOT NULL;comment:'API入口地址'"`
-	RegionID  string
+	RegionID  string `gorm:"type:varchar(64);NOT NULL;DEFAULT:'';comment:'区域ID，APNs 为 TeamID'"`
 	APIKey    string `gorm:"type:varchar(255);NOT NULL;comment:'API密钥，明文'"`
-	APISecret string `gorm:"type:varchar(512);NOT NULL;comment:'API密钥，加密'"`
-	APPID     string `gorm:"type:varchar(255);NOT NULL;comment:'APPID，仅腾讯云'"`
+	APISecret string `gorm:"type:TEXT;NOT NULL;comment:'API密钥，加密，推送渠道为PEM格式私钥'"`
+	APPID     string `gorm:"type:varchar(255);NOT NULL;comment:'APPID，腾讯云为应用ID，APNs 为 Bundle ID，FCM 为项目ID'"`
 
 	Weight           int    `gorm:"type:INT;NOT NULL;comment:'权重'"`
 	QPSLimit         int    `gorm:"type:INT;NOT NULL;comment:'每秒请求数限制'"`
 	DailyLimit       int    `gorm:"type:INT;NOT NULL;comment:'每日请求数限制'"`
 	AuditCallbackURL string `gorm:"type:varchar(256);comment:'回调URL，供应商通知审核结果'"`
+	ReceiptToken     string `gorm:"type:varchar(64);NOT NULL;DEFAULT:'';comment:'回执推送令牌，为空时拒绝回执推送'"`
 	Status           string `gorm:"type:ENUM('ACTIVE', 'INACTIVE');NOT NULL;DEFAULT:'ACTIVE';comment:'状态，ACTIVE-启用，INACTIVE-禁用')"`
 	Ctime            int64
 	Utime            int64
@@ -98,6 +99,7 @@ func (p *providerDAO) Update(ctx context.Context, provider Provider) error {
 		"qps_limit":          provider.QPSLimit,
 		"daily_limit":        provider.DailyLimit,
 		"audit_callback_url": provider.AuditCallbackURL,
+		"receipt_token":      provider.ReceiptToken,
 		"status":             provider.Status,
 		"utime":              provider.Utime,
 	}
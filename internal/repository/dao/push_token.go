@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// InvalidPushToken 无效设备令牌表
+type InvalidPushToken struct {
+	ID       int64  `gorm:"primaryKey;AUTO_INCREMENT;comment:'记录ID'"`
+	BizID    int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_biz_id_token,priority:1;comment:'业务配置ID'"`
+	Provider string `gorm:"type:VARCHAR(64);NOT NULL;comment:'判定无效的供应商'"`
+	Token    string `gorm:"type:VARCHAR(512);NOT NULL;uniqueIndex:idx_biz_id_token,priority:2;comment:'设备令牌'"`
+	Reason   string `gorm:"type:VARCHAR(128);NOT NULL;DEFAULT:'';comment:'厂商返回的原因'"`
+	Ctime    int64
+	Utime    int64
+}
+
+func (InvalidPushToken) TableName() string {
+	return "invalid_push_tokens"
+}
+
+type PushTokenDAO interface {
+	// MarkInvalid 标记无效令牌，重复标记时更新原因和时间
+	MarkInvalid(ctx context.Context, tokens []InvalidPushToken) error
+	// FindInvalid 按ID正序分页查询业务方的无效令牌
+	FindInvalid(ctx context.Context, bizID, startID int64, limit int) ([]InvalidPushToken, error)
+}
+
+type pushTokenDAO struct {
+	db *gorm.DB
+}
+
+func NewPushTokenDAO(db *gorm.DB) PushTokenDAO {
+	return &pushTokenDAO{db: db}
+}
+
+func (d *pushTokenDAO) MarkInvalid(ctx context.Context, tokens []InvalidPushToken) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	for i := range tokens {
+		tokens[i].Ctime = now
+		tokens[i].Utime = now
+	}
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "biz_id"}, {Name: "token"}},
+		DoUpdates: clause.AssignmentColumns([]string{"provider", "reason", "utime"}),
+	}).Create(&tokens).Error
+}
+
+func (d *pushTokenDAO) FindInvalid(ctx context.Context, bizID, startID int64, limit int) ([]InvalidPushToken, error) {
+	var tokens []InvalidPushToken
+	err := d.db.WithContext(ctx).
+		Where("biz_id = ? AND id > ?", bizID, startID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&tokens).Error
+	return tokens, err
+}
@@ -0,0 +1,82 @@
+package dao
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/sharding"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+	"slices"
+)
+
+// ShardingNotificationDAO 分库分表下的通知DAO
+// 同一个业务的通知按业务内唯一标识散落在所有分片中，检索时需要查询所有分片再归并
+type ShardingNotificationDAO struct {
+	NotificationDAO
+
+	strategy sharding.ShardingStrategy
+	// searchShard 检索单个分片
+	searchShard func(ctx context.Context, dst sharding.Dst, q domain.NotificationSearch) ([]Notification, error)
+}
+
+// NewShardingNotificationDAO dbs 的 key 为分库名，其余操作交给 base
+func NewShardingNotificationDAO(base NotificationDAO, dbs map[string]*gorm.DB, strategy sharding.ShardingStrategy) *ShardingNotificationDAO {
+	return &ShardingNotificationDAO{
+		NotificationDAO: base,
+		strategy:        strategy,
+		searchShard: func(ctx context.Context, dst sharding.Dst, q domain.NotificationSearch) ([]Notification, error) {
+			db, ok := dbs[dst.DB]
+			if !ok {
+				return nil, fmt.Errorf("分库 %s 没有配置数据库连接", dst.DB)
+			}
+			var res []Notification
+			err := searchNotifications(db.WithContext(ctx).Table(dst.Table), q).Find(&res).Error
+			return res, err
+		},
+	}
+}
+
+// Search 每个分片各取 q.Limit 条，归并排序后取前 q.Limit 条
+// 每个分片都按游标过滤，归并后的前 q.Limit 条一定是全局的前 q.Limit 条
+func (d *ShardingNotificationDAO) Search(ctx context.Context, q domain.NotificationSearch) ([]Notification, error) {
+	dsts := d.strategy.Broadcast()
+	results := make([][]Notification, len(dsts))
+	var eg errgroup.Group
+	for i := range dsts {
+		eg.Go(func() error {
+			var err error
+			results[i], err = d.searchShard(ctx, dsts[i], q)
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	merged := slices.Concat(results...)
+	slices.SortFunc(merged, func(a, b Notification) int {
+		res := compareNotification(a, b, q.SortBy)
+		if !q.Ascending {
+			res = -res
+		}
+		return res
+	})
+	if len(merged) > q.Limit {
+		merged = merged[:q.Limit]
+	}
+	return merged, nil
+}
+
+// compareNotification 按排序字段升序比较，相同时按ID比较
+func compareNotification(a, b Notification, sortBy domain.NotificationSortField) int {
+	av, bv := a.Ctime, b.Ctime
+	if sortBy == domain.NotificationSortByUtime {
+		av, bv = a.Utime, b.Utime
+	}
+	if res := cmp.Compare(av, bv); res != 0 {
+		return res
+	}
+	return cmp.Compare(a.ID, b.ID)
+}
@@ -0,0 +1,128 @@
+//go:build e2e
+
+package dao
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/sharding"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// ShardingNotificationDAOSuite 在两个分库、每库两张分表中检索
+type ShardingNotificationDAOSuite struct {
+	suite.Suite
+	strategy sharding.ShardingStrategy
+	dbs      map[string]*gorm.DB
+	dao      *ShardingNotificationDAO
+}
+
+func (s *ShardingNotificationDAOSuite) SetupSuite() {
+	t := s.T()
+	base, err := gorm.Open(mysql.Open(testDSN), &gorm.Config{})
+	require.NoError(t, err)
+
+	s.strategy = *sharding.NewShardingStrategy("notification", "notification", 2, 2)
+	tables := make(map[string][]string)
+	for _, dst := range s.strategy.Broadcast() {
+		tables[dst.DB] = append(tables[dst.DB], dst.Table)
+	}
+	s.dbs = make(map[string]*gorm.DB, len(tables))
+	for name := range tables {
+		require.NoError(t, base.Exec(fmt.Sprintf("CREATE DATABASE IF NOT EXISTS `%s`", name)).Error)
+		db, err := gorm.Open(mysql.Open(strings.Replace(testDSN, "/notification?", "/"+name+"?", 1)), &gorm.Config{})
+		require.NoError(t, err)
+		require.NoError(t, InitShardingTables(db, tables[name]))
+		s.dbs[name] = db
+	}
+	s.dao = NewShardingNotificationDAO(NewNotificationDAO(base), s.dbs, s.strategy)
+}
+
+func (s *ShardingNotificationDAOSuite) TearDownTest() {
+	for _, dst := range s.strategy.Broadcast() {
+		db := s.dbs[dst.DB]
+		require.NoError(s.T(), db.Exec("TRUNCATE TABLE "+dst.Table).Error)
+		require.NoError(s.T(), db.Exec("TRUNCATE TABLE deliveries").Error)
+	}
+}
+
+// createInShard 把通知和接收者的发送记录写入第 i 个分片
+func (s *ShardingNotificationDAOSuite) createInShard(i int, n Notification, receiver string) {
+	dst := s.strategy.Broadcast()[i]
+	db := s.dbs[dst.DB]
+	n.Key = fmt.Sprintf("key-%d", n.ID)
+	n.Receivers = fmt.Sprintf(`[%q]`, receiver)
+	n.Channel = domain.ChannelSMS.String()
+	n.TemplateParams = "{}"
+	n.Status = domain.SendStatusSucceeded.String()
+	require.NoError(s.T(), db.Table(dst.Table).Create(&n).Error)
+	require.NoError(s.T(), db.Create(&Delivery{
+		NotificationID: n.ID,
+		BizID:          n.BizID,
+		Receiver:       receiver,
+		Channel:        n.Channel,
+	}).Error)
+}
+
+func (s *ShardingNotificationDAOSuite) TestSearch() {
+	// 四个分片的创建时间交错，并且有创建时间相同的通知
+	s.createInShard(0, Notification{ID: 1, BizID: 1, Ctime: 100}, "13800000001")
+	s.createInShard(1, Notification{ID: 2, BizID: 1, Ctime: 200}, "13800000002")
+	s.createInShard(2, Notification{ID: 3, BizID: 1, Ctime: 300}, "13800000001")
+	s.createInShard(3, Notification{ID: 4, BizID: 1, Ctime: 300}, "13800000002")
+	s.createInShard(0, Notification{ID: 5, BizID: 1, Ctime: 500}, "13800000002")
+	s.createInShard(1, Notification{ID: 6, BizID: 1, Ctime: 600}, "13800000001")
+	s.createInShard(2, Notification{ID: 7, BizID: 2, Ctime: 700}, "13800000001")
+
+	testCases := []struct {
+		name     string
+		receiver string
+		wantIDs  []int64
+	}{
+		{
+			name:    "按创建时间倒序翻页",
+			wantIDs: []int64{6, 5, 4, 3, 2, 1},
+		},
+		{
+			name:     "按接收者检索",
+			receiver: "+8613800000001",
+			wantIDs:  []int64{6, 3, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			q := domain.NotificationSearch{
+				BizID:    1,
+				Receiver: tc.receiver,
+				SortBy:   domain.NotificationSortByCtime,
+				Limit:    2,
+			}
+			var gotIDs []int64
+			for range len(tc.wantIDs) {
+				page, err := s.dao.Search(s.T().Context(), q)
+				s.Require().NoError(err)
+				if len(page) == 0 {
+					break
+				}
+				for _, n := range page {
+					gotIDs = append(gotIDs, n.ID)
+				}
+				last := page[len(page)-1]
+				q.Cursor = domain.NotificationCursor{SortValue: last.Ctime, ID: last.ID}
+			}
+			s.Equal(tc.wantIDs, gotIDs)
+		})
+	}
+}
+
+func TestShardingNotificationDAO(t *testing.T) {
+	suite.Run(t, new(ShardingNotificationDAOSuite))
+}
@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/sharding"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeShards 按分库保存通知，按 searchNotifications 的条件过滤、排序和截断
+type fakeShards map[string][]Notification
+
+func (f fakeShards) search(_ context.Context, dst sharding.Dst, q domain.NotificationSearch) ([]Notification, error) {
+	res := make([]Notification, 0, len(f[dst.DB]))
+	for _, n := range f[dst.DB] {
+		if n.BizID != q.BizID {
+			continue
+		}
+		if !q.Cursor.IsZero() {
+			res1 := compareNotification(n, Notification{ID: q.Cursor.ID, Ctime: q.Cursor.SortValue, Utime: q.Cursor.SortValue}, q.SortBy)
+			if (q.Ascending && res1 <= 0) || (!q.Ascending && res1 >= 0) {
+				continue
+			}
+		}
+		res = append(res, n)
+	}
+	slices.SortFunc(res, func(a, b Notification) int {
+		if q.Ascending {
+			return compareNotification(a, b, q.SortBy)
+		}
+		return compareNotification(b, a, q.SortBy)
+	})
+	return res[:min(len(res), q.Limit)], nil
+}
+
+func TestShardingNotificationDAO_Search(t *testing.T) {
+	t.Parallel()
+
+	// 两个分片的创建时间交错，并且有创建时间相同的通知
+	shards := fakeShards{
+		"notification_0": {
+			{ID: 1, BizID: 1, Ctime: 100},
+			{ID: 3, BizID: 1, Ctime: 300},
+			{ID: 4, BizID: 1, Ctime: 300},
+			{ID: 7, BizID: 1, Ctime: 700},
+			{ID: 9, BizID: 2, Ctime: 500},
+		},
+		"notification_1": {
+			{ID: 2, BizID: 1, Ctime: 200},
+			{ID: 5, BizID: 1, Ctime: 300},
+			{ID: 6, BizID: 1, Ctime: 600},
+			{ID: 8, BizID: 1, Ctime: 800},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		ascending bool
+		wantIDs   []int64
+	}{
+		{
+			name:    "按创建时间倒序翻页",
+			wantIDs: []int64{8, 7, 6, 5, 4, 3, 2, 1},
+		},
+		{
+			name:      "按创建时间升序翻页",
+			ascending: true,
+			wantIDs:   []int64{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := &ShardingNotificationDAO{
+				strategy:    *sharding.NewShardingStrategy("notification", "notification", 1, 2),
+				searchShard: shards.search,
+			}
+			q := domain.NotificationSearch{
+				BizID:     1,
+				SortBy:    domain.NotificationSortByCtime,
+				Ascending: tc.ascending,
+				Limit:     3,
+			}
+
+			var gotIDs []int64
+			for range len(tc.wantIDs) {
+				page, err := d.Search(t.Context(), q)
+				require.NoError(t, err)
+				if len(page) == 0 {
+					break
+				}
+				assert.LessOrEqual(t, len(page), q.Limit)
+				for _, n := range page {
+					gotIDs = append(gotIDs, n.ID)
+				}
+				last := page[len(page)-1]
+				q.Cursor = domain.NotificationCursor{SortValue: last.Ctime, ID: last.ID}
+			}
+			assert.Equal(t, tc.wantIDs, gotIDs)
+		})
+	}
+}
@@ -17,7 +17,7 @@ type ChannelTemplate struct {
 	OwnerType       string `gorm:"type:ENUM('person', 'organization');NOT NULL;comment:'业务方类型：person-个人，organization-组织'"`
 	Name            string `gorm:"type:VARCHAR(128);NOT NULL;comment:'模板名称'"`
 	Description     string `gorm:"type:VARCHAR(512);NOT NULL;comment:'模版描述'"`
-	Channel         string `gorm:"type:ENUM('SMS', 'EMAIL', 'IN_APP');NOT NULL;comment:'渠道类型'"`
+	Channel         string `gorm:"type:ENUM('SMS', 'EMAIL', 'IN_APP', 'WEBHOOK', 'IM', 'PUSH');NOT NULL;comment:'渠道类型'"`
 	BusinessType    int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:1;comment:'业务类型：1-推广营销、2-通知、3-验证码等'"`
 	ActiveVersionID int64  `gorm:"type:BIGINT;DEFAULT:0;index:idx_active_version;comment:'当前启用的版本ID，0表示无活跃版本'"`
 	Ctime           int64
@@ -34,6 +34,7 @@ type ChannelTemplateVersion struct {
 	ChannelTemplateID int64  `gorm:"type:BIGINT;NOT NULL;index:idx_channel_template_id;comment:'关联渠道模板ID'"`
 	Name              string `gorm:"type:VARCHAR(32);NOT NULL;comment:'版本名称，如v1.0.1'"`
 	Signature         string `gorm:"type:VARCHAR(64);comment:'已通过所有供应商审核的短信签名/邮件发件人'"`
+	Subject           string `gorm:"type:VARCHAR(256);comment:'邮件主题或站内信标题，支持平台统一变量格式'"`
 	Content           string `gorm:"type:TEXT;NOT NULL;comment:'原始模版内容，使用平台统一变量格式，如${bane}'"`
 	Remark            string `gorm:"type:TEXT;NOT NULL;comment:'申请说明，描述使用短信的业务场景，并提供短信完整示例（填入变量内容），短信完整有助于提高模版审核通过率'"`
 	// 审核相关信息，AuditID之后的为冗余的信息
@@ -57,7 +58,7 @@ type ChannelTemplateProvider struct {
 	TemplateVersionID         int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_template_version_provider,priority:2;unqiueIndex:idx_temp_ver_name_chan,priority:2;comment:'渠道模板版本ID'"`
 	ProviderID                int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_template_version_provider,priority:3;comment:'供应商ID'"`
 	ProviderName              string `gorm:"type:VARCHAR(64);NOT NULL;unqiueIndex:idx_temp_ver_name_chan,priority:3;comment:'供应商名称'"`
-	ProviderChannel           string `gorm:"type:ENUM('SMS', 'EMAIL', 'IN_APP');NOT NULL;unqiueIndex:idx_temp_ver_name_chan,priority:4;comment:'渠道类型')"`
+	ProviderChannel           string `gorm:"type:ENUM('SMS', 'EMAIL', 'IN_APP', 'WEBHOOK', 'IM', 'PUSH');NOT NULL;unqiueIndex:idx_temp_ver_name_chan,priority:4;comment:'渠道类型')"`
 	RequestID                 string `gorm:"type:VARCHAR(256);index:idx_request_id;comment:'审核请求在供应商侧的ID，用于排查问题'"`
 	ProviderTemplateID        string `gorm:"type:VARCHAR(256);comment:'当前版本模板在供应商侧的ID，审核通过后才会有值'"`
 	AuditStatus               string `gorm:"type:ENUM('PENDING', 'IN_REVIEW', 'REJECTED', 'APPROVED');NOT NULL;DEFAULT:'PENDING';index:idx_audit_status;comment:'供应商侧模板审核状态，PENDING表示未提交审核；IN_REVIEW表示未提交审核；APPROVED表示审核通过；REJECTED表示审核未通过'"`
@@ -266,6 +267,7 @@ func (c *channelTemplateDAO) ForkTemplateVersion(ctx context.Context, versionID
 			ChannelTemplateID:         old.ChannelTemplateID,
 			Name:                      "Forked" + old.Name,
 			Signature:                 old.Signature,
+			Subject:                   old.Subject,
 			Content:                   old.Content,
 			Remark:                    old.Remark,
 			AuditID:                   0,
@@ -387,6 +389,7 @@ func (c *channelTemplateDAO) UpdateTemplateVersion(ctx context.Context, version
 	updateData := map[string]interface{}{
 		"name":      version.Name,
 		"signature": version.Signature,
+		"subject":   version.Subject,
 		"content":   version.Content,
 		"remark":    version.Remark,
 		"utime":     time.Now().UnixMilli(),
@@ -100,8 +100,10 @@ func (t *txNotificationDAO) UpdateCheckStatus(ctx context.Context, txNotificatio
 				return err
 			}
 			if status != domain.SendStatusPrepare {
-				return tx.WithContext(ctx).Model(&Notification{}).Where("id in ?", notificationIDs).
-					Update("status", status).Error
+				_, err = updateStatusWithTransitions(ctx, tx.WithContext(ctx), func(db *gorm.DB) *gorm.DB {
+					return db.Where("id in ?", notificationIDs)
+				}, status.String(), map[string]interface{}{})
+				return err
 			}
 			return nil
 		})
@@ -183,8 +185,9 @@ func (t *txNotificationDAO) UpdateStatus(ctx context.Context, bizID int64, key s
 		if res.RowsAffected == 0 {
 			return ErrUpdateStatusFailed
 		}
-		return tx.WithContext(ctx).Model(&Notification{}).
-			Where("biz_id = ? AND `key` = ?", bizID, key).
-			Update("status", notificationStatus).Error
+		_, err := updateStatusWithTransitions(ctx, tx.WithContext(ctx), func(db *gorm.DB) *gorm.DB {
+			return db.Where("biz_id = ? AND `key` = ?", bizID, key)
+		}, notificationStatus.String(), map[string]interface{}{})
+		return err
 	})
 }
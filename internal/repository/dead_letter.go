@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"go-notification/internal/domain"
+	"go-notification/internal/repository/dao"
+)
+
+// DeadLetterRepository 死信存储
+type DeadLetterRepository interface {
+	Save(ctx context.Context, deadLetter domain.DeadLetter) error
+	List(ctx context.Context, query domain.DeadLetterQuery) ([]domain.DeadLetter, error)
+	FindByIDs(ctx context.Context, bizID int64, ids []int64) ([]domain.DeadLetter, error)
+	// MarkReplayed 标记为已重放，返回 false 表示已经被重放过
+	MarkReplayed(ctx context.Context, id int64) (bool, error)
+}
+
+type deadLetterRepository struct {
+	dao dao.DeadLetterDAO
+}
+
+func NewDeadLetterRepository(dao dao.DeadLetterDAO) DeadLetterRepository {
+	return &deadLetterRepository{dao: dao}
+}
+
+func (r *deadLetterRepository) Save(ctx context.Context, deadLetter domain.DeadLetter) error {
+	return r.dao.Upsert(ctx, r.toEntity(deadLetter))
+}
+
+func (r *deadLetterRepository) List(ctx context.Context, query domain.DeadLetterQuery) ([]domain.DeadLetter, error) {
+	entities, err := r.dao.List(ctx, query.BizID, query.Channel.String(), query.Status.String(),
+		query.StartTime, query.EndTime, query.Cursor, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(entities), nil
+}
+
+func (r *deadLetterRepository) FindByIDs(ctx context.Context, bizID int64, ids []int64) ([]domain.DeadLetter, error) {
+	entities, err := r.dao.FindByIDs(ctx, bizID, ids)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(entities), nil
+}
+
+func (r *deadLetterRepository) MarkReplayed(ctx context.Context, id int64) (bool, error) {
+	affected, err := r.dao.MarkReplayed(ctx, id)
+	return affected > 0, err
+}
+
+func (r *deadLetterRepository) toDomains(entities []dao.DeadLetter) []domain.DeadLetter {
+	res := make([]domain.DeadLetter, 0, len(entities))
+	for i := range entities {
+		res = append(res, r.toDomain(entities[i]))
+	}
+	return res
+}
+
+func (r *deadLetterRepository) toDomain(entity dao.DeadLetter) domain.DeadLetter {
+	var attempts []domain.DeadLetterAttempt
+	if entity.Attempts != "" {
+		_ = json.Unmarshal([]byte(entity.Attempts), &attempts)
+	}
+	return domain.DeadLetter{
+		ID:                entity.ID,
+		NotificationID:    entity.NotificationID,
+		BizID:             entity.BizID,
+		Key:               entity.Key,
+		Channel:           domain.Channel(entity.Channel),
+		TemplateID:        entity.TemplateID,
+		TemplateVersionID: entity.TemplateVersionID,
+		Provider:          entity.Provider,
+		ErrCategory:       domain.ErrCategory(entity.ErrCategory),
+		ErrMsg:            entity.ErrMsg,
+		RetryCount:        entity.RetryCount,
+		Attempts:          attempts,
+		Status:            domain.DeadLetterStatus(entity.Status),
+		ReplayCount:       entity.ReplayCount,
+		Ctime:             entity.Ctime,
+		Utime:             entity.Utime,
+	}
+}
+
+func (r *deadLetterRepository) toEntity(deadLetter domain.DeadLetter) dao.DeadLetter {
+	attempts, _ := json.Marshal(deadLetter.Attempts)
+	return dao.DeadLetter{
+		ID:                deadLetter.ID,
+		NotificationID:    deadLetter.NotificationID,
+		BizID:             deadLetter.BizID,
+		Key:               deadLetter.Key,
+		Channel:           deadLetter.Channel.String(),
+		TemplateID:        deadLetter.TemplateID,
+		TemplateVersionID: deadLetter.TemplateVersionID,
+		Provider:          deadLetter.Provider,
+		ErrCategory:       deadLetter.ErrCategory.String(),
+		ErrMsg:            deadLetter.ErrMsg,
+		RetryCount:        deadLetter.RetryCount,
+		Attempts:          string(attempts),
+		Status:            deadLetter.Status.String(),
+		ReplayCount:       deadLetter.ReplayCount,
+		Ctime:             deadLetter.Ctime,
+		Utime:             deadLetter.Utime,
+	}
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"go-notification/internal/repository/dao"
+)
+
+// DeliveryRepository 送达回执存储
+type DeliveryRepository interface {
+	BatchCreate(ctx context.Context, deliveries []domain.Delivery) error
+	FindWaiting(ctx context.Context, now int64, limit int) ([]domain.Delivery, error)
+	FindBySerialNos(ctx context.Context, provider string, serialNos []string) ([]domain.Delivery, error)
+	FindByNotificationIDs(ctx context.Context, notificationIDs []int64) (map[int64][]domain.Delivery, error)
+	UpdateStatus(ctx context.Context, delivery domain.Delivery) (bool, error)
+	UpdateNextQueryTime(ctx context.Context, ids []int64, nextQueryTime int64) error
+	CountWaiting(ctx context.Context, notificationID int64) (int64, error)
+	IncrAttempts(ctx context.Context, notificationID int64, receivers []string) error
+	RecordProvider(ctx context.Context, notificationID int64, receivers []string, provider string) error
+}
+
+type deliveryRepository struct {
+	dao dao.DeliveryDAO
+}
+
+func NewDeliveryRepository(dao dao.DeliveryDAO) DeliveryRepository {
+	return &deliveryRepository{dao: dao}
+}
+
+func (r *deliveryRepository) BatchCreate(ctx context.Context, deliveries []domain.Delivery) error {
+	entities := make([]dao.Delivery, 0, len(deliveries))
+	for i := range deliveries {
+		entities = append(entities, r.toEntity(deliveries[i]))
+	}
+	return r.dao.BatchCreate(ctx, entities)
+}
+
+func (r *deliveryRepository) FindWaiting(ctx context.Context, now int64, limit int) ([]domain.Delivery, error) {
+	entities, err := r.dao.FindWaiting(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(entities), nil
+}
+
+func (r *deliveryRepository) FindBySerialNos(ctx context.Context, provider string, serialNos []string) ([]domain.Delivery, error) {
+	entities, err := r.dao.FindBySerialNos(ctx, provider, serialNos)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(entities), nil
+}
+
+func (r *deliveryRepository) FindByNotificationIDs(ctx context.Context, notificationIDs []int64) (map[int64][]domain.Delivery, error) {
+	entities, err := r.dao.FindByNotificationIDs(ctx, notificationIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64][]domain.Delivery, len(notificationIDs))
+	for i := range entities {
+		result[entities[i].NotificationID] = append(result[entities[i].NotificationID], r.toDomain(entities[i]))
+	}
+	return result, nil
+}
+
+func (r *deliveryRepository) UpdateStatus(ctx context.Context, delivery domain.Delivery) (bool, error) {
+	return r.dao.UpdateStatus(ctx, r.toEntity(delivery))
+}
+
+func (r *deliveryRepository) UpdateNextQueryTime(ctx context.Context, ids []int64, nextQueryTime int64) error {
+	return r.dao.UpdateNextQueryTime(ctx, ids, nextQueryTime)
+}
+
+func (r *deliveryRepository) CountWaiting(ctx context.Context, notificationID int64) (int64, error) {
+	return r.dao.CountWaiting(ctx, notificationID)
+}
+
+func (r *deliveryRepository) IncrAttempts(ctx context.Context, notificationID int64, receivers []string) error {
+	return r.dao.IncrAttempts(ctx, notificationID, receivers)
+}
+
+func (r *deliveryRepository) RecordProvider(ctx context.Context, notificationID int64, receivers []string, provider string) error {
+	return r.dao.RecordProvider(ctx, notificationID, receivers, provider)
+}
+
+func (r *deliveryRepository) toDomains(entities []dao.Delivery) []domain.Delivery {
+	deliveries := make([]domain.Delivery, 0, len(entities))
+	for i := range entities {
+		deliveries = append(deliveries, r.toDomain(entities[i]))
+	}
+	return deliveries
+}
+
+func (r *deliveryRepository) toDomain(d dao.Delivery) domain.Delivery {
+	return domain.Delivery{
+		ID:             d.ID,
+		NotificationID: d.NotificationID,
+		BizID:          d.BizID,
+		Receiver:       d.Receiver,
+		Channel:        domain.Channel(d.Channel),
+		Provider:       d.Provider,
+		SerialNo:       d.SerialNo,
+		Status:         domain.DeliveryStatus(d.Status),
+		ErrCode:        d.ErrCode,
+		ErrMsg:         d.ErrMsg,
+		ReportTime:     d.ReportTime,
+		NextQueryTime:  d.NextQueryTime,
+		Attempts:       d.Attempts,
+		Ctime:          d.Ctime,
+		Utime:          d.Utime,
+	}
+}
+
+func (r *deliveryRepository) toEntity(d domain.Delivery) dao.Delivery {
+	return dao.Delivery{
+		ID:             d.ID,
+		NotificationID: d.NotificationID,
+		BizID:          d.BizID,
+		Receiver:       d.Receiver,
+		Channel:        d.Channel.String(),
+		Provider:       d.Provider,
+		SerialNo:       d.SerialNo,
+		Status:         d.Status.String(),
+		ErrCode:        d.ErrCode,
+		ErrMsg:         d.ErrMsg,
+		ReportTime:     d.ReportTime,
+		NextQueryTime:  d.NextQueryTime,
+	}
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"go-notification/internal/repository/dao"
+)
+
+// InboxRepository 站内信收件箱存储
+type InboxRepository interface {
+	BatchCreate(ctx context.Context, messages []domain.InboxMessage) error
+	List(ctx context.Context, query domain.InboxQuery) ([]domain.InboxMessage, error)
+	ListSince(ctx context.Context, bizID int64, receiver string, afterID int64, limit int) ([]domain.InboxMessage, error)
+	LatestID(ctx context.Context, bizID int64, receiver string) (int64, error)
+	CountUnread(ctx context.Context, bizID int64, receiver string) (int64, error)
+	MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+	MarkUnread(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+	MarkAllRead(ctx context.Context, bizID int64, receiver string) (int64, error)
+	Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+}
+
+type inboxRepository struct {
+	dao dao.InboxDAO
+}
+
+func NewInboxRepository(dao dao.InboxDAO) InboxRepository {
+	return &inboxRepository{dao: dao}
+}
+
+func (r *inboxRepository) BatchCreate(ctx context.Context, messages []domain.InboxMessage) error {
+	entities := make([]dao.InboxMessage, 0, len(messages))
+	for i := range messages {
+		entities = append(entities, r.toEntity(messages[i]))
+	}
+	return r.dao.BatchCreate(ctx, entities)
+}
+
+func (r *inboxRepository) List(ctx context.Context, query domain.InboxQuery) ([]domain.InboxMessage, error) {
+	entities, err := r.dao.List(ctx, query.BizID, query.Receiver, query.Cursor, query.Limit, query.UnreadOnly)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]domain.InboxMessage, 0, len(entities))
+	for i := range entities {
+		messages = append(messages, r.toDomain(entities[i]))
+	}
+	return messages, nil
+}
+
+func (r *inboxRepository) ListSince(ctx context.Context, bizID int64, receiver string, afterID int64, limit int) ([]domain.InboxMessage, error) {
+	entities, err := r.dao.ListSince(ctx, bizID, receiver, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]domain.InboxMessage, 0, len(entities))
+	for i := range entities {
+		messages = append(messages, r.toDomain(entities[i]))
+	}
+	return messages, nil
+}
+
+func (r *inboxRepository) LatestID(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	return r.dao.LatestID(ctx, bizID, receiver)
+}
+
+func (r *inboxRepository) CountUnread(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	return r.dao.CountUnread(ctx, bizID, receiver)
+}
+
+func (r *inboxRepository) MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	return r.dao.UpdateReadStatus(ctx, bizID, receiver, ids, true)
+}
+
+func (r *inboxRepository) MarkUnread(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	return r.dao.UpdateReadStatus(ctx, bizID, receiver, ids, false)
+}
+
+func (r *inboxRepository) MarkAllRead(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	return r.dao.MarkAllRead(ctx, bizID, receiver)
+}
+
+func (r *inboxRepository) Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	return r.dao.Delete(ctx, bizID, receiver, ids)
+}
+
+func (r *inboxRepository) toDomain(entity dao.InboxMessage) domain.InboxMessage {
+	return domain.InboxMessage{
+		ID:             entity.ID,
+		BizID:          entity.BizID,
+		NotificationID: entity.NotificationID,
+		Receiver:       entity.Receiver,
+		Title:          entity.Title,
+		Content:        entity.Content,
+		Read:           entity.IsRead,
+		ReadTime:       entity.ReadTime,
+		Ctime:          entity.Ctime,
+		Utime:          entity.Utime,
+	}
+}
+
+func (r *inboxRepository) toEntity(message domain.InboxMessage) dao.InboxMessage {
+	return dao.InboxMessage{
+		ID:             message.ID,
+		BizID:          message.BizID,
+		NotificationID: message.NotificationID,
+		Receiver:       message.Receiver,
+		Title:          message.Title,
+		Content:        message.Content,
+		IsRead:         message.Read,
+		ReadTime:       message.ReadTime,
+		Ctime:          message.Ctime,
+		Utime:          message.Utime,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"go-notification/internal/pkg/logger"
 	"go-notification/internal/repository/cache"
 	"go-notification/internal/repository/dao"
+	"slices"
 	"time"
 )
 
@@ -26,12 +27,44 @@ type NotificationRepository interface {
 	CASStatus(ctx context.Context, notification domain.Notification) error
 	UpdateStatus(ctx context.Context, notification domain.Notification) error
 
-	BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification) error
+	// BatchUpdateStatusSucceededOrFailed 批量更新为成功或失败并归还失败通知的额度，返回实际更新的通知ID
+	// 已经被其他路径标记过的通知不会被更新，也不会重复归还额度
+	BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification) ([]int64, error)
 
 	FindReadNotifications(ctx context.Context, offset, limit int) ([]domain.Notification, error)
 	MarkSuccess(ctx context.Context, notification domain.Notification) error
+	// MarkFailed 标记为 notification.Status 中的失败或频率受限状态并归还额度，通知已经被标记过终态时返回 errs.ErrNotificationVersionMismatch，不会重复归还
 	MarkFailed(ctx context.Context, notification domain.Notification) error
-	MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) (int64, error)
+	FindTimeoutSending(ctx context.Context, batchSize int) ([]domain.Notification, error)
+	Requeue(ctx context.Context, notification domain.Notification) error
+	// MarkRetry 等待自动重试，额度在最终失败时才归还
+	MarkRetry(ctx context.Context, notification domain.Notification) error
+	// Replay 重放发送失败的通知，重新扣减额度，失败时归还
+	Replay(ctx context.Context, notification domain.Notification) error
+	// Search 按条件检索业务下的通知
+	Search(ctx context.Context, q domain.NotificationSearch) ([]domain.Notification, error)
+	// MarkSending 调度发送前抢占待发送的通知，返回抢占成功的通知，状态和版本号为更新后的值
+	MarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error)
+	// Cancel 取消待发送的通知并归还额度
+	Cancel(ctx context.Context, notification domain.Notification) error
+	// Reschedule 修改待发送通知的计划发送时间
+	Reschedule(ctx context.Context, notification domain.Notification) error
+	// Defer 推迟待发送通知的计划发送时间并记录推迟原因，原因取自上下文中记录的错误
+	Defer(ctx context.Context, notification domain.Notification) error
+	// CreateDigestItem 创建等待合并的通知，不扣减额度，合并后的汇总通知扣减额度
+	CreateDigestItem(ctx context.Context, notification domain.Notification) (domain.Notification, error)
+	// FindDueDigestItems 查找到了合并时间的通知
+	FindDueDigestItems(ctx context.Context, limit int) ([]domain.Notification, error)
+	// FindDigestItems 查找与 item 同一分组、等待合并的通知
+	FindDigestItems(ctx context.Context, item domain.Notification, limit int) ([]domain.Notification, error)
+	// CreateDigest 创建汇总通知并扣减额度，同时把合并的通知关联到汇总通知
+	CreateDigest(ctx context.Context, digest domain.Notification, items []domain.Notification) (domain.Notification, error)
+	// FindDigestedItems 查找已经合并、等待汇总通知发送结果的通知
+	FindDigestedItems(ctx context.Context, limit int) ([]domain.Notification, error)
+	// SettleDigestItems 把合并的通知改为汇总通知的最终状态
+	SettleDigestItems(ctx context.Context, digest domain.Notification, items []domain.Notification) error
+	// CountByKeyPrefix 按状态统计业务下标识以 keyPrefix 开头的通知数量
+	CountByKeyPrefix(ctx context.Context, bizID int64, keyPrefix string) (map[domain.SendStatus]int64, error)
 }
 
 const (
@@ -176,6 +209,18 @@ func (r *notificationRepository) GetByKeys(ctx context.Context, bizId int64, key
 	return result, nil
 }
 
+func (r *notificationRepository) Search(ctx context.Context, q domain.NotificationSearch) ([]domain.Notification, error) {
+	notifications, err := r.dao.Search(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]domain.Notification, len(notifications))
+	for i := range notifications {
+		result[i] = r.toDomain(notifications[i])
+	}
+	return result, nil
+}
+
 // CASStatus 更新通知状态
 func (r *notificationRepository) CASStatus(ctx context.Context, notification domain.Notification) error {
 	return r.dao.CASStatus(ctx, r.toEntity(notification))
@@ -186,7 +231,7 @@ func (r *notificationRepository) UpdateStatus(ctx context.Context, notification
 }
 
 // BatchUpdateStatusSucceededOrFailed 批量更新通知状态为成功或失败
-func (r *notificationRepository) BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification) error {
+func (r *notificationRepository) BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification) ([]int64, error) {
 	successItems := make([]dao.Notification, 0, len(succeededNotifications))
 	for _, notification := range succeededNotifications {
 		successItems = append(successItems, r.toEntity(notification))
@@ -197,17 +242,29 @@ func (r *notificationRepository) BatchUpdateStatusSucceededOrFailed(ctx context.
 		failedItems = append(failedItems, r.toEntity(notification))
 	}
 
-	err := r.dao.BatchUpdateStatusSucceedOrFailed(ctx, successItems, failedItems)
+	affectedIDs, err := r.dao.BatchUpdateStatusSucceedOrFailed(ctx, successItems, failedItems)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	items := r.getItems(failedNotifications)
-	eerr := r.quotaCache.MutiIncr(ctx, items)
-	if eerr != nil {
-		r.logger.Error("发送失败，归还额度失败", logger.Error(eerr))
+	// 只归还本次真正标记为失败的通知的额度
+	affected := make(map[int64]struct{}, len(affectedIDs))
+	for _, id := range affectedIDs {
+		affected[id] = struct{}{}
 	}
-	return nil
+	refunds := make([]domain.Notification, 0, len(failedNotifications))
+	for i := range failedNotifications {
+		if _, ok := affected[failedNotifications[i].ID]; ok {
+			refunds = append(refunds, failedNotifications[i])
+		}
+	}
+	if len(refunds) != 0 {
+		eerr := r.quotaCache.MutiIncr(ctx, r.getItems(refunds))
+		if eerr != nil {
+			r.logger.Error("发送失败，归还额度失败", logger.Error(eerr))
+		}
+	}
+	return affectedIDs, nil
 }
 
 func (r *notificationRepository) FindReadNotifications(ctx context.Context, offset, limit int) ([]domain.Notification, error) {
@@ -234,13 +291,172 @@ func (r *notificationRepository) MarkFailed(ctx context.Context, notification do
 	return r.quotaCache.Incr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
 }
 
-func (r *notificationRepository) MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) (int64, error) {
-	return r.dao.MarkTimeoutSendingAsFailed(ctx, batchSize)
+func (r *notificationRepository) FindTimeoutSending(ctx context.Context, batchSize int) ([]domain.Notification, error) {
+	nos, err := r.dao.FindTimeoutSending(ctx, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]domain.Notification, 0, len(nos))
+	for i := range nos {
+		result = append(result, r.toDomain(nos[i]))
+	}
+	return result, nil
+}
+
+func (r *notificationRepository) Requeue(ctx context.Context, notification domain.Notification) error {
+	return r.dao.Requeue(ctx, r.toEntity(notification))
+}
+
+func (r *notificationRepository) MarkRetry(ctx context.Context, notification domain.Notification) error {
+	return r.dao.MarkRetry(ctx, r.toEntity(notification))
+}
+
+func (r *notificationRepository) Replay(ctx context.Context, notification domain.Notification) error {
+	// 最终失败时归还过额度，重放需要重新扣减
+	err := r.quotaCache.Decr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
+	if err != nil {
+		return err
+	}
+	err = r.dao.Replay(ctx, r.toEntity(notification))
+	if err != nil {
+		qerr := r.quotaCache.Incr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
+		if qerr != nil {
+			r.logger.Error("额度归还失败", logger.Error(qerr), logger.Int64("biz_id", notification.BizID), logger.String("channel", notification.Channel.String()))
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *notificationRepository) MarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	entities := make([]dao.Notification, 0, len(notifications))
+	for i := range notifications {
+		entities = append(entities, r.toEntity(notifications[i]))
+	}
+	ids, err := r.dao.MarkSending(ctx, entities)
+	if err != nil {
+		return nil, err
+	}
+	claimed := make([]domain.Notification, 0, len(ids))
+	for i := range notifications {
+		if slices.Contains(ids, notifications[i].ID) {
+			n := notifications[i]
+			n.Status = domain.SendStatusSending
+			n.Version++
+			claimed = append(claimed, n)
+		}
+	}
+	return claimed, nil
+}
+
+func (r *notificationRepository) Cancel(ctx context.Context, notification domain.Notification) error {
+	err := r.dao.Cancel(ctx, r.toEntity(notification))
+	if err != nil {
+		return err
+	}
+	// 创建时扣减过额度，取消后不会再发送，归还失败不影响取消结果
+	qerr := r.quotaCache.Incr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
+	if qerr != nil {
+		r.logger.Error("额度归还失败", logger.Error(qerr), logger.Int64("biz_id", notification.BizID), logger.String("channel", notification.Channel.String()))
+	}
+	return nil
+}
+
+func (r *notificationRepository) Reschedule(ctx context.Context, notification domain.Notification) error {
+	return r.dao.Reschedule(ctx, r.toEntity(notification))
+}
+
+func (r *notificationRepository) Defer(ctx context.Context, notification domain.Notification) error {
+	return r.dao.Defer(ctx, r.toEntity(notification))
+}
+
+func (r *notificationRepository) CreateDigestItem(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	notification.Status = domain.SendStatusDigesting
+	ds, err := r.dao.Create(ctx, r.toEntity(notification))
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return r.toDomain(ds), nil
+}
+
+func (r *notificationRepository) FindDueDigestItems(ctx context.Context, limit int) ([]domain.Notification, error) {
+	entities, err := r.dao.FindDueDigestItems(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(entities), nil
+}
+
+func (r *notificationRepository) FindDigestItems(ctx context.Context, item domain.Notification, limit int) ([]domain.Notification, error) {
+	entities, err := r.dao.FindDigestItems(ctx, r.toEntity(item), limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(entities), nil
+}
+
+func (r *notificationRepository) CreateDigest(ctx context.Context, digest domain.Notification, items []domain.Notification) (domain.Notification, error) {
+	// 扣减额度
+	err := r.quotaCache.Decr(ctx, digest.BizID, digest.Channel, defaultQuotaNumber)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	ids := make([]int64, 0, len(items))
+	for i := range items {
+		ids = append(ids, items[i].ID)
+	}
+	created, err := r.dao.CreateDigest(ctx, r.toEntity(digest), ids)
+	if err != nil {
+		// 未创建成功归还额度
+		qerr := r.quotaCache.Incr(ctx, digest.BizID, digest.Channel, defaultQuotaNumber)
+		if qerr != nil {
+			r.logger.Error("额度归还失败", logger.Error(qerr), logger.Int64("biz_id", digest.BizID), logger.String("channel", digest.Channel.String()))
+		}
+		return domain.Notification{}, err
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *notificationRepository) FindDigestedItems(ctx context.Context, limit int) ([]domain.Notification, error) {
+	entities, err := r.dao.FindDigestedItems(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(entities), nil
+}
+
+func (r *notificationRepository) SettleDigestItems(ctx context.Context, digest domain.Notification, items []domain.Notification) error {
+	ids := make([]int64, 0, len(items))
+	for i := range items {
+		ids = append(ids, items[i].ID)
+	}
+	return r.dao.SettleDigestItems(ctx, r.toEntity(digest), ids)
+}
+
+func (r *notificationRepository) CountByKeyPrefix(ctx context.Context, bizID int64, keyPrefix string) (map[domain.SendStatus]int64, error) {
+	counts, err := r.dao.CountByKeyPrefix(ctx, bizID, keyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[domain.SendStatus]int64, len(counts))
+	for st, cnt := range counts {
+		res[domain.SendStatus(st)] = cnt
+	}
+	return res, nil
+}
+
+func (r *notificationRepository) toDomains(entities []dao.Notification) []domain.Notification {
+	result := make([]domain.Notification, 0, len(entities))
+	for i := range entities {
+		result = append(result, r.toDomain(entities[i]))
+	}
+	return result
 }
 
 func (r *notificationRepository) toEntity(notification domain.Notification) dao.Notification {
 	templateParams, _ := notification.MarshalTemplateParms()
 	receivers, _ := notification.MarshalReceivers()
+	fallbackTemplates, _ := notification.MarshalFallbackTemplates()
 	return dao.Notification{
 		ID:                notification.ID,
 		BizID:             notification.BizID,
@@ -249,11 +465,19 @@ func (r *notificationRepository) toEntity(notification domain.Notification) dao.
 		Channel:           notification.Channel.String(),
 		TemplateID:        notification.Template.ID,
 		TemplateVersionID: notification.Template.VersionID,
+		TemplatePinned:    notification.Template.VersionPinned,
 		TemplateParams:    templateParams,
 		Status:            notification.Status.String(),
 		ScheduledSTime:    notification.ScheduledSTime.UnixMilli(),
 		ScheduledETime:    notification.ScheduledETime.UnixMilli(),
 		Version:           notification.Version,
+		FallbackTemplates: fallbackTemplates,
+		DeliveredChannel:  notification.DeliveredChannel.String(),
+		RetryCount:        notification.RetryCount,
+		ReceiverTimezone:  notification.ReceiverTimezone,
+		DigestGroup:       notification.SendStrategyConfig.DigestGroup,
+		DigestTemplateID:  notification.SendStrategyConfig.DigestTemplateID,
+		DigestID:          notification.DigestID,
 	}
 }
 
@@ -264,6 +488,20 @@ func (r *notificationRepository) toDomain(n dao.Notification) domain.Notificatio
 	var receivers []string
 	_ = json.Unmarshal([]byte(n.Receivers), &receivers)
 
+	var fallbackTemplates map[domain.Channel]int64
+	if n.FallbackTemplates != "" {
+		_ = json.Unmarshal([]byte(n.FallbackTemplates), &fallbackTemplates)
+	}
+
+	var sendStrategyConfig domain.SendStrategyConfig
+	if n.DigestGroup != "" {
+		sendStrategyConfig = domain.SendStrategyConfig{
+			Type:             domain.SendStrategyDigest,
+			DigestGroup:      n.DigestGroup,
+			DigestTemplateID: n.DigestTemplateID,
+		}
+	}
+
 	return domain.Notification{
 		ID:        n.ID,
 		BizID:     n.BizID,
@@ -271,14 +509,23 @@ func (r *notificationRepository) toDomain(n dao.Notification) domain.Notificatio
 		Receivers: receivers,
 		Channel:   domain.Channel(n.Channel),
 		Template: domain.Template{
-			ID:        n.TemplateID,
-			VersionID: n.TemplateVersionID,
-			Params:    templateParams,
+			ID:            n.TemplateID,
+			VersionID:     n.TemplateVersionID,
+			Params:        templateParams,
+			VersionPinned: n.TemplatePinned,
 		},
-		Status:         domain.SendStatus(n.Status),
-		ScheduledSTime: time.UnixMilli(n.ScheduledSTime),
-		ScheduledETime: time.UnixMilli(n.ScheduledETime),
-		Version:        n.Version,
+		Status:             domain.SendStatus(n.Status),
+		ScheduledSTime:     time.UnixMilli(n.ScheduledSTime),
+		ScheduledETime:     time.UnixMilli(n.ScheduledETime),
+		Version:            n.Version,
+		FallbackTemplates:  fallbackTemplates,
+		DeliveredChannel:   domain.Channel(n.DeliveredChannel),
+		RetryCount:         n.RetryCount,
+		ReceiverTimezone:   n.ReceiverTimezone,
+		SendStrategyConfig: sendStrategyConfig,
+		DigestID:           n.DigestID,
+		Ctime:              n.Ctime,
+		Utime:              n.Utime,
 	}
 }
 
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"go-notification/internal/domain"
+	"go-notification/internal/repository/dao"
+	"time"
+)
+
+type NotificationSeriesRepository interface {
+	Create(ctx context.Context, series domain.NotificationSeries) (domain.NotificationSeries, error)
+	GetByKey(ctx context.Context, bizID int64, key string) (domain.NotificationSeries, error)
+	// FindDue 查找到了发送时间的周期发送
+	FindDue(ctx context.Context, limit int) ([]domain.NotificationSeries, error)
+	// CASUpdate 按版本号更新已发送次数、下一次发送时间和状态，版本号不匹配时返回 errs.ErrNotificationVersionMismatch
+	CASUpdate(ctx context.Context, series domain.NotificationSeries) error
+}
+
+type notificationSeriesRepository struct {
+	dao dao.NotificationSeriesDAO
+}
+
+func NewNotificationSeriesRepository(dao dao.NotificationSeriesDAO) NotificationSeriesRepository {
+	return &notificationSeriesRepository{dao: dao}
+}
+
+func (r *notificationSeriesRepository) Create(ctx context.Context, series domain.NotificationSeries) (domain.NotificationSeries, error) {
+	created, err := r.dao.Create(ctx, r.toEntity(series))
+	if err != nil {
+		return domain.NotificationSeries{}, err
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *notificationSeriesRepository) GetByKey(ctx context.Context, bizID int64, key string) (domain.NotificationSeries, error) {
+	found, err := r.dao.GetByKey(ctx, bizID, key)
+	if err != nil {
+		return domain.NotificationSeries{}, err
+	}
+	return r.toDomain(found), nil
+}
+
+func (r *notificationSeriesRepository) FindDue(ctx context.Context, limit int) ([]domain.NotificationSeries, error) {
+	entities, err := r.dao.FindDue(ctx, time.Now().UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]domain.NotificationSeries, 0, len(entities))
+	for i := range entities {
+		result = append(result, r.toDomain(entities[i]))
+	}
+	return result, nil
+}
+
+func (r *notificationSeriesRepository) CASUpdate(ctx context.Context, series domain.NotificationSeries) error {
+	return r.dao.CASUpdate(ctx, r.toEntity(series))
+}
+
+func (r *notificationSeriesRepository) toEntity(series domain.NotificationSeries) dao.NotificationSeries {
+	n := series.Notification
+	templateParams, _ := n.MarshalTemplateParms()
+	receivers, _ := n.MarshalReceivers()
+	fallbackTemplates, _ := n.MarshalFallbackTemplates()
+	// 零值时间存为 0，已完成的周期发送没有下一次发送时间
+	var endTime, nextTime int64
+	if !n.SendStrategyConfig.RecurringEnd.IsZero() {
+		endTime = n.SendStrategyConfig.RecurringEnd.UnixMilli()
+	}
+	if !series.NextTime.IsZero() {
+		nextTime = series.NextTime.UnixMilli()
+	}
+	return dao.NotificationSeries{
+		ID:                series.ID,
+		BizID:             series.BizID,
+		Key:               series.Key,
+		Receivers:         receivers,
+		Channel:           n.Channel.String(),
+		TemplateID:        n.Template.ID,
+		TemplateVersionID: n.Template.VersionID,
+		TemplatePinned:    n.Template.VersionPinned,
+		TemplateParams:    templateParams,
+		FallbackTemplates: fallbackTemplates,
+		ReceiverTimezone:  n.ReceiverTimezone,
+		CronExpr:          n.SendStrategyConfig.CronExpr,
+		Timezone:          n.SendStrategyConfig.Timezone,
+		EndTime:           endTime,
+		MaxOccurrences:    n.SendStrategyConfig.MaxOccurrences,
+		Occurrences:       series.Occurrences,
+		NextTime:          nextTime,
+		Status:            series.Status.String(),
+		Version:           series.Version,
+	}
+}
+
+func (r *notificationSeriesRepository) toDomain(s dao.NotificationSeries) domain.NotificationSeries {
+	var templateParams map[string]string
+	_ = json.Unmarshal([]byte(s.TemplateParams), &templateParams)
+
+	var receivers []string
+	_ = json.Unmarshal([]byte(s.Receivers), &receivers)
+
+	var fallbackTemplates map[domain.Channel]int64
+	if s.FallbackTemplates != "" {
+		_ = json.Unmarshal([]byte(s.FallbackTemplates), &fallbackTemplates)
+	}
+
+	cfg := domain.SendStrategyConfig{
+		Type:           domain.SendStrategyRecurring,
+		CronExpr:       s.CronExpr,
+		Timezone:       s.Timezone,
+		MaxOccurrences: s.MaxOccurrences,
+	}
+	if s.EndTime > 0 {
+		cfg.RecurringEnd = time.UnixMilli(s.EndTime)
+	}
+	var nextTime time.Time
+	if s.NextTime > 0 {
+		nextTime = time.UnixMilli(s.NextTime)
+	}
+	return domain.NotificationSeries{
+		ID:    s.ID,
+		BizID: s.BizID,
+		Key:   s.Key,
+		Notification: domain.Notification{
+			BizID:     s.BizID,
+			Key:       s.Key,
+			Receivers: receivers,
+			Channel:   domain.Channel(s.Channel),
+			Template: domain.Template{
+				ID:            s.TemplateID,
+				VersionID:     s.TemplateVersionID,
+				Params:        templateParams,
+				VersionPinned: s.TemplatePinned,
+			},
+			SendStrategyConfig: cfg,
+			FallbackTemplates:  fallbackTemplates,
+			ReceiverTimezone:   s.ReceiverTimezone,
+		},
+		Occurrences: s.Occurrences,
+		NextTime:    nextTime,
+		Status:      domain.SeriesStatus(s.Status),
+		Version:     s.Version,
+		Ctime:       s.Ctime,
+		Utime:       s.Utime,
+	}
+}
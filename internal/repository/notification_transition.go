@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"go-notification/internal/repository/dao"
+)
+
+// NotificationTransitionRepository 通知状态流转记录，记录随状态更新一起写入，这里只负责查询
+type NotificationTransitionRepository interface {
+	FindByNotificationID(ctx context.Context, notificationID int64) ([]domain.NotificationTransition, error)
+	// FindAfter 按ID升序查找业务下ID大于 afterID 的记录，notificationIDs 和 keys 都为空时不限通知
+	FindAfter(ctx context.Context, bizID, afterID int64, notificationIDs []int64, keys []string, limit int) ([]domain.NotificationTransition, error)
+	LatestID(ctx context.Context, bizID int64) (int64, error)
+}
+
+type notificationTransitionRepository struct {
+	dao dao.NotificationTransitionDAO
+}
+
+func NewNotificationTransitionRepository(dao dao.NotificationTransitionDAO) NotificationTransitionRepository {
+	return &notificationTransitionRepository{dao: dao}
+}
+
+func (r *notificationTransitionRepository) FindByNotificationID(ctx context.Context, notificationID int64) ([]domain.NotificationTransition, error) {
+	entities, err := r.dao.FindByNotificationID(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(entities), nil
+}
+
+func (r *notificationTransitionRepository) FindAfter(ctx context.Context, bizID, afterID int64, notificationIDs []int64, keys []string, limit int) ([]domain.NotificationTransition, error) {
+	entities, err := r.dao.FindAfter(ctx, bizID, afterID, notificationIDs, keys, limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(entities), nil
+}
+
+func (r *notificationTransitionRepository) LatestID(ctx context.Context, bizID int64) (int64, error) {
+	return r.dao.LatestID(ctx, bizID)
+}
+
+func (r *notificationTransitionRepository) toDomains(entities []dao.NotificationTransition) []domain.NotificationTransition {
+	res := make([]domain.NotificationTransition, 0, len(entities))
+	for i := range entities {
+		res = append(res, r.toDomain(entities[i]))
+	}
+	return res
+}
+
+func (r *notificationTransitionRepository) toDomain(t dao.NotificationTransition) domain.NotificationTransition {
+	return domain.NotificationTransition{
+		ID:             t.ID,
+		NotificationID: t.NotificationID,
+		BizID:          t.BizID,
+		Key:            t.Key,
+		FromStatus:     domain.SendStatus(t.FromStatus),
+		ToStatus:       domain.SendStatus(t.ToStatus),
+		Actor:          domain.TransitionActor(t.Actor),
+		Provider:       t.Provider,
+		ErrMsg:         t.ErrMsg,
+		Ctime:          t.Ctime,
+	}
+}
@@ -70,12 +70,15 @@ func (p *providerRepository) toEntity(provider domain.Provider) dao.Provider {
 		Name:             provider.Name,
 		Channel:          provider.Channel.String(),
 		Endpoint:         provider.Endpoint,
+		RegionID:         provider.RegionID,
 		APIKey:           provider.APIKey,
 		APISecret:        provider.APISecret,
+		APPID:            provider.APPID,
 		Weight:           provider.Weight,
 		QPSLimit:         provider.QPSLimit,
 		DailyLimit:       provider.DailyLimit,
 		AuditCallbackURL: provider.AuditCallbackURL,
+		ReceiptToken:     provider.ReceiptToken,
 		Status:           provider.Status.String(),
 	}
 }
@@ -86,12 +89,15 @@ func (p *providerRepository) toDomain(provider dao.Provider) domain.Provider {
 		Name:             provider.Name,
 		Channel:          domain.Channel(provider.Channel),
 		Endpoint:         provider.Endpoint,
+		RegionID:         provider.RegionID,
 		APIKey:           provider.APIKey,
 		APISecret:        provider.APISecret,
+		APPID:            provider.APPID,
 		Weight:           provider.Weight,
 		QPSLimit:         provider.QPSLimit,
 		DailyLimit:       provider.DailyLimit,
 		AuditCallbackURL: provider.AuditCallbackURL,
+		ReceiptToken:     provider.ReceiptToken,
 		Status:           domain.ProviderStatus(provider.Status),
 	}
 }
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"go-notification/internal/repository/dao"
+)
+
+// PushTokenRepository 推送设备令牌存储
+type PushTokenRepository interface {
+	MarkInvalid(ctx context.Context, tokens []domain.InvalidPushToken) error
+	FindInvalid(ctx context.Context, bizID, startID int64, limit int) ([]domain.InvalidPushToken, error)
+}
+
+type pushTokenRepository struct {
+	dao dao.PushTokenDAO
+}
+
+func NewPushTokenRepository(dao dao.PushTokenDAO) PushTokenRepository {
+	return &pushTokenRepository{dao: dao}
+}
+
+func (r *pushTokenRepository) MarkInvalid(ctx context.Context, tokens []domain.InvalidPushToken) error {
+	entities := make([]dao.InvalidPushToken, 0, len(tokens))
+	for i := range tokens {
+		entities = append(entities, dao.InvalidPushToken{
+			BizID:    tokens[i].BizID,
+			Provider: tokens[i].Provider,
+			Token:    tokens[i].Token,
+			Reason:   tokens[i].Reason,
+		})
+	}
+	return r.dao.MarkInvalid(ctx, entities)
+}
+
+func (r *pushTokenRepository) FindInvalid(ctx context.Context, bizID, startID int64, limit int) ([]domain.InvalidPushToken, error) {
+	entities, err := r.dao.FindInvalid(ctx, bizID, startID, limit)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]domain.InvalidPushToken, 0, len(entities))
+	for i := range entities {
+		tokens = append(tokens, domain.InvalidPushToken{
+			ID:       entities[i].ID,
+			BizID:    entities[i].BizID,
+			Provider: entities[i].Provider,
+			Token:    entities[i].Token,
+			Reason:   entities[i].Reason,
+			Ctime:    entities[i].Ctime,
+			Utime:    entities[i].Utime,
+		})
+	}
+	return tokens, nil
+}
@@ -361,6 +361,7 @@ func (r *channelTemplateRepository) toVersionDomain(version dao.ChannelTemplateV
 		ChannelTemplateID:        version.ChannelTemplateID,
 		Name:                     version.Name,
 		Signature:                version.Signature,
+		Subject:                  version.Subject,
 		Content:                  version.Content,
 		Remark:                   version.Remark,
 		AuditId:                  version.AuditID,
@@ -380,6 +381,7 @@ func (r *channelTemplateRepository) toVersionEntity(version domain.ChannelTempla
 		ChannelTemplateID:         version.ChannelTemplateID,
 		Name:                      version.Name,
 		Signature:                 version.Signature,
+		Subject:                   version.Subject,
 		Content:                   version.Content,
 		Remark:                    version.Remark,
 		AuditID:                   version.AuditorId,
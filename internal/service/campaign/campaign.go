@@ -0,0 +1,153 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/id_generator"
+	"go-notification/internal/repository"
+)
+
+// Service 管理活动：创建、上传接收者、开始、暂停、恢复、终止和查询进度
+// 已经生成的通知不受活动状态影响，可以单独查询和取消
+type Service interface {
+	// Create 创建草稿状态的活动
+	Create(ctx context.Context, campaign domain.Campaign) (domain.Campaign, error)
+	// AddReceivers 给草稿状态的活动追加接收者，可以多次追加
+	AddReceivers(ctx context.Context, bizID int64, key string, receivers []domain.CampaignReceiver) (domain.Campaign, error)
+	// Start 开始按发送速率生成通知，开始后不能再追加接收者
+	Start(ctx context.Context, bizID int64, key string) (domain.Campaign, error)
+	Pause(ctx context.Context, bizID int64, key string) (domain.Campaign, error)
+	// Resume 从暂停的位置继续生成通知
+	Resume(ctx context.Context, bizID int64, key string) (domain.Campaign, error)
+	// Abort 终止后不能再恢复
+	Abort(ctx context.Context, bizID int64, key string) (domain.Campaign, error)
+	// GetReport 查询活动进度和已生成通知的发送结果
+	GetReport(ctx context.Context, bizID int64, key string) (domain.CampaignReport, error)
+}
+
+// maxCASRetries 与生成通知的任务并发修改时的重试次数
+const maxCASRetries = 3
+
+type service struct {
+	repo             repository.CampaignRepository
+	notificationRepo repository.NotificationRepository
+	idGenerator      *id_generator.Generator
+}
+
+func NewService(repo repository.CampaignRepository, notificationRepo repository.NotificationRepository) Service {
+	return &service{
+		repo:             repo,
+		notificationRepo: notificationRepo,
+		idGenerator:      id_generator.NewGenerator(),
+	}
+}
+
+func (s *service) Create(ctx context.Context, campaign domain.Campaign) (domain.Campaign, error) {
+	if err := campaign.Validate(); err != nil {
+		return domain.Campaign{}, err
+	}
+	campaign.ID = s.idGenerator.GenerateID(campaign.BizID, campaign.Key)
+	campaign.Status = domain.CampaignStatusDraft
+	campaign.Total, campaign.Dispatched, campaign.Failed = 0, 0, 0
+	return s.repo.Create(ctx, campaign)
+}
+
+func (s *service) AddReceivers(ctx context.Context, bizID int64, key string, receivers []domain.CampaignReceiver) (domain.Campaign, error) {
+	if err := domain.ValidateCampaignReceivers(receivers); err != nil {
+		return domain.Campaign{}, err
+	}
+	campaign, err := s.get(ctx, bizID, key)
+	if err != nil {
+		return domain.Campaign{}, err
+	}
+	return s.repo.AppendReceivers(ctx, campaign, receivers)
+}
+
+func (s *service) Start(ctx context.Context, bizID int64, key string) (domain.Campaign, error) {
+	return s.update(ctx, bizID, key, func(campaign *domain.Campaign) error {
+		if campaign.Status != domain.CampaignStatusDraft {
+			return fmt.Errorf("%w: 活动状态为 %s，只能开始草稿状态的活动", errs.ErrInvalidOperation, campaign.Status)
+		}
+		if campaign.Total == 0 {
+			return fmt.Errorf("%w: 活动还没有接收者", errs.ErrInvalidOperation)
+		}
+		campaign.Status = domain.CampaignStatusRunning
+		return nil
+	})
+}
+
+func (s *service) Pause(ctx context.Context, bizID int64, key string) (domain.Campaign, error) {
+	return s.update(ctx, bizID, key, func(campaign *domain.Campaign) error {
+		if campaign.Status != domain.CampaignStatusRunning {
+			return fmt.Errorf("%w: 活动状态为 %s，只能暂停发送中的活动", errs.ErrInvalidOperation, campaign.Status)
+		}
+		campaign.Status = domain.CampaignStatusPaused
+		return nil
+	})
+}
+
+func (s *service) Resume(ctx context.Context, bizID int64, key string) (domain.Campaign, error) {
+	return s.update(ctx, bizID, key, func(campaign *domain.Campaign) error {
+		if campaign.Status != domain.CampaignStatusPaused {
+			return fmt.Errorf("%w: 活动状态为 %s，只能恢复已暂停的活动", errs.ErrInvalidOperation, campaign.Status)
+		}
+		campaign.Status = domain.CampaignStatusRunning
+		return nil
+	})
+}
+
+func (s *service) Abort(ctx context.Context, bizID int64, key string) (domain.Campaign, error) {
+	return s.update(ctx, bizID, key, func(campaign *domain.Campaign) error {
+		if campaign.IsFinished() {
+			return fmt.Errorf("%w: 活动状态为 %s，已经结束", errs.ErrInvalidOperation, campaign.Status)
+		}
+		campaign.Status = domain.CampaignStatusAborted
+		return nil
+	})
+}
+
+func (s *service) GetReport(ctx context.Context, bizID int64, key string) (domain.CampaignReport, error) {
+	campaign, err := s.get(ctx, bizID, key)
+	if err != nil {
+		return domain.CampaignReport{}, err
+	}
+	counts, err := s.notificationRepo.CountByKeyPrefix(ctx, bizID, campaign.NotificationKeyPrefix())
+	if err != nil {
+		return domain.CampaignReport{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	return domain.CampaignReport{Campaign: campaign, StatusCounts: counts}, nil
+}
+
+func (s *service) get(ctx context.Context, bizID int64, key string) (domain.Campaign, error) {
+	if key == "" {
+		return domain.Campaign{}, fmt.Errorf("%w: 业务内唯一标识不能为空", errs.ErrInvalidParameter)
+	}
+	return s.repo.GetByKey(ctx, bizID, key)
+}
+
+// update 读取后按版本号更新，版本号不匹配说明任务刚好推进了进度，重新读取后再试
+func (s *service) update(ctx context.Context, bizID int64, key string, fn func(campaign *domain.Campaign) error) (domain.Campaign, error) {
+	var err error
+	for i := 0; i < maxCASRetries; i++ {
+		var campaign domain.Campaign
+		campaign, err = s.get(ctx, bizID, key)
+		if err != nil {
+			return domain.Campaign{}, err
+		}
+		if err = fn(&campaign); err != nil {
+			return domain.Campaign{}, err
+		}
+		err = s.repo.CASUpdate(ctx, campaign)
+		if err == nil {
+			campaign.Version++
+			return campaign, nil
+		}
+		if !errors.Is(err, errs.ErrNotificationVersionMismatch) {
+			return domain.Campaign{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+		}
+	}
+	return domain.Campaign{}, err
+}
@@ -0,0 +1,141 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"github.com/meoying/dlock-go"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/id_generator"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/pkg/loopjob"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/sendstrategy"
+	"time"
+)
+
+// Task 按发送速率为发送中的活动的接收者生成通知，通知按活动的发送策略创建
+// 每轮最多为每个活动生成 SendRate 条通知，一轮至少一秒
+type Task struct {
+	repo         repository.CampaignRepository
+	sendStrategy sendstrategy.SendStrategy
+	idGenerator  *id_generator.Generator
+	dclient      dlock.Client
+	log          logger.Logger
+	batchSize    int
+}
+
+func NewTask(repo repository.CampaignRepository, sendStrategy sendstrategy.SendStrategy, dclient dlock.Client, log logger.Logger) *Task {
+	const defaultBatchSize = 10
+	return &Task{
+		repo:         repo,
+		sendStrategy: sendStrategy,
+		idGenerator:  id_generator.NewGenerator(),
+		dclient:      dclient,
+		log:          log,
+		batchSize:    defaultBatchSize,
+	}
+}
+
+func (t *Task) Start(ctx context.Context) {
+	const key = "notification_campaign_dispatch"
+	lj := loopjob.NewInfiniteLoop(t.dclient, t.log, t.Dispatch, key)
+	lj.Run(ctx)
+}
+
+// Dispatch 为一批发送中的活动各生成一轮通知
+func (t *Task) Dispatch(ctx context.Context) error {
+	start := time.Now()
+	ctx = domain.CtxWithTransitionActor(ctx, domain.TransitionActorCampaign)
+	campaigns, err := t.repo.FindRunning(ctx, t.batchSize)
+	if err != nil {
+		return err
+	}
+	for i := range campaigns {
+		t.dispatch(ctx, campaigns[i])
+	}
+	// 发送速率按秒计算
+	if elapsed := time.Since(start); elapsed < time.Second {
+		time.Sleep(time.Second - elapsed)
+	}
+	return nil
+}
+
+// batchSizeLimit 单次批量创建的通知数量上限，与批量发送接口的限制一致
+const batchSizeLimit = 100
+
+// dispatch 按序号为接下来的 SendRate 个接收者生成通知并推进进度，每批最多 batchSizeLimit 条
+// 通知已经存在说明上一轮生成后没有推进成功，参数不合法的接收者计入失败，其他错误下一轮从该接收者重试
+func (t *Task) dispatch(ctx context.Context, campaign domain.Campaign) {
+	receivers, err := t.repo.FindReceivers(ctx, campaign.ID, campaign.Dispatched, int(campaign.SendRate))
+	if err != nil {
+		t.log.Warn("查询活动的接收者失败",
+			logger.Int64("campaignID", campaign.ID),
+			logger.Error(err))
+		return
+	}
+
+	var processed, failed int64
+	for start := 0; start < len(receivers); start += batchSizeLimit {
+		notifications := make([]domain.Notification, 0, batchSizeLimit)
+		for _, r := range receivers[start:min(start+batchSizeLimit, len(receivers))] {
+			n := campaign.ReceiverNotification(r)
+			n.ID = t.idGenerator.GenerateID(n.BizID, n.Key)
+			notifications = append(notifications, n)
+		}
+		p, f, done := t.send(ctx, campaign, notifications)
+		processed += p
+		failed += f
+		if !done {
+			break
+		}
+	}
+	if processed == 0 && len(receivers) > 0 {
+		return
+	}
+
+	campaign.Advance(processed, failed)
+	if err = t.repo.CASUpdate(ctx, campaign); err != nil {
+		// 版本号不匹配说明业务方刚好暂停或者终止了活动，以业务方的修改为准，已经生成的通知下次不会重复生成
+		t.log.Warn("活动推进进度失败",
+			logger.Int64("campaignID", campaign.ID),
+			logger.Error(err))
+	}
+}
+
+// send 批量创建一批通知，批量创建失败时（如其中有通知已经存在）逐条创建以区分每个接收者的结果
+// done 为 false 表示遇到了需要下一轮重试的错误，processed 只统计出错的接收者之前的部分
+func (t *Task) send(ctx context.Context, campaign domain.Campaign, notifications []domain.Notification) (processed, failed int64, done bool) {
+	valid := make([]domain.Notification, 0, len(notifications))
+	for i := range notifications {
+		if notifications[i].Validate() == nil {
+			valid = append(valid, notifications[i])
+		}
+	}
+	if len(valid) == 0 {
+		return int64(len(notifications)), int64(len(notifications)), true
+	}
+	if _, err := t.sendStrategy.BatchSend(ctx, valid); err == nil {
+		return int64(len(notifications)), int64(len(notifications) - len(valid)), true
+	}
+
+	for i := range notifications {
+		n := notifications[i]
+		err := n.Validate()
+		if err == nil {
+			_, err = t.sendStrategy.Send(ctx, n)
+		}
+		if err != nil && !errors.Is(err, errs.ErrNotificationDuplicate) {
+			if !errors.Is(err, errs.ErrInvalidParameter) {
+				t.log.Warn("活动生成通知失败",
+					logger.Int64("campaignID", campaign.ID),
+					logger.String("key", n.Key),
+					logger.Error(err))
+				return processed, failed, false
+			}
+			failed++
+		}
+		processed++
+	}
+	return processed, failed, true
+}
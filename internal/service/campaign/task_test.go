@@ -0,0 +1,245 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/sendstrategy"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCampaignRepo struct {
+	repository.CampaignRepository
+	running   []domain.Campaign
+	receivers []domain.CampaignReceiver
+	updated   []domain.Campaign
+}
+
+func (r *fakeCampaignRepo) FindRunning(_ context.Context, _ int) ([]domain.Campaign, error) {
+	return r.running, nil
+}
+
+func (r *fakeCampaignRepo) FindReceivers(_ context.Context, _, afterSeq int64, limit int) ([]domain.CampaignReceiver, error) {
+	var res []domain.CampaignReceiver
+	for i := range r.receivers {
+		if r.receivers[i].Seq > afterSeq && len(res) < limit {
+			res = append(res, r.receivers[i])
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeCampaignRepo) CASUpdate(_ context.Context, campaign domain.Campaign) error {
+	r.updated = append(r.updated, campaign)
+	return nil
+}
+
+type fakeSendStrategy struct {
+	sendstrategy.SendStrategy
+	errs    map[string]error
+	sent    []domain.Notification
+	batches []int // 批量创建成功的每批数量
+}
+
+// BatchSend 任意一个接收者出错时整批失败
+func (s *fakeSendStrategy) BatchSend(_ context.Context, ns []domain.Notification) ([]domain.SendResponse, error) {
+	for i := range ns {
+		if err := s.errs[ns[i].Receivers[0]]; err != nil {
+			return nil, err
+		}
+	}
+	s.batches = append(s.batches, len(ns))
+	resps := make([]domain.SendResponse, 0, len(ns))
+	for i := range ns {
+		s.sent = append(s.sent, ns[i])
+		resps = append(resps, domain.SendResponse{NotificationID: ns[i].ID, Status: domain.SendStatusPending})
+	}
+	return resps, nil
+}
+
+func (s *fakeSendStrategy) Send(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+	if err := s.errs[n.Receivers[0]]; err != nil {
+		return domain.SendResponse{}, err
+	}
+	s.sent = append(s.sent, n)
+	return domain.SendResponse{NotificationID: n.ID, Status: domain.SendStatusPending}, nil
+}
+
+func TestTask_Dispatch(t *testing.T) {
+	t.Parallel()
+
+	newCampaign := func(sendRate int32, dispatched int64) domain.Campaign {
+		return domain.Campaign{
+			ID:    1,
+			BizID: 1,
+			Key:   "promo",
+			Notification: domain.Notification{
+				BizID:   1,
+				Key:     "promo",
+				Channel: domain.ChannelSMS,
+				Template: domain.Template{
+					ID:        1,
+					VersionID: 1,
+					Params:    map[string]string{"name": "用户", "coupon": "10元"},
+				},
+				SendStrategyConfig: domain.SendStrategyConfig{Type: domain.SendStrategyImmediate},
+			},
+			SendRate:   sendRate,
+			Total:      3,
+			Dispatched: dispatched,
+			Status:     domain.CampaignStatusRunning,
+		}
+	}
+	receivers := []domain.CampaignReceiver{
+		{Seq: 1, Receiver: "13800000001", Params: map[string]string{"name": "张三"}},
+		{Seq: 2, Receiver: "13800000002"},
+		{Seq: 3, Receiver: "13800000003"},
+	}
+
+	testCases := []struct {
+		name           string
+		campaign       domain.Campaign
+		sendErrs       map[string]error
+		wantSent       int
+		wantBatches    []int
+		wantDispatched int64
+		wantFailed     int64
+		wantStatus     domain.CampaignStatus
+	}{
+		{
+			name:           "按发送速率生成通知",
+			campaign:       newCampaign(2, 0),
+			wantSent:       2,
+			wantBatches:    []int{2},
+			wantDispatched: 2,
+			wantStatus:     domain.CampaignStatusRunning,
+		},
+		{
+			name:           "所有接收者处理完后完成",
+			campaign:       newCampaign(2, 2),
+			wantSent:       1,
+			wantBatches:    []int{1},
+			wantDispatched: 3,
+			wantStatus:     domain.CampaignStatusCompleted,
+		},
+		{
+			name:     "参数不合法的接收者计入失败",
+			campaign: newCampaign(10, 0),
+			sendErrs: map[string]error{
+				"13800000002": fmt.Errorf("%w: 手机号格式不对", errs.ErrInvalidParameter),
+			},
+			wantSent:       2,
+			wantDispatched: 3,
+			wantFailed:     1,
+			wantStatus:     domain.CampaignStatusCompleted,
+		},
+		{
+			name:     "通知已经存在视为已经处理",
+			campaign: newCampaign(10, 0),
+			sendErrs: map[string]error{
+				"13800000001": fmt.Errorf("创建通知失败: %w", errs.ErrNotificationDuplicate),
+			},
+			wantSent:       2,
+			wantDispatched: 3,
+			wantStatus:     domain.CampaignStatusCompleted,
+		},
+		{
+			name:     "系统错误时下一轮从该接收者重试",
+			campaign: newCampaign(10, 0),
+			sendErrs: map[string]error{
+				"13800000002": errs.ErrDatabaseError,
+			},
+			wantSent:       1,
+			wantDispatched: 1,
+			wantStatus:     domain.CampaignStatusRunning,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeCampaignRepo{running: []domain.Campaign{tc.campaign}, receivers: receivers}
+			sender := &fakeSendStrategy{errs: tc.sendErrs}
+			task := NewTask(repo, sender, nil, logger.NewNopLogger())
+
+			require.NoError(t, task.Dispatch(t.Context()))
+
+			require.Len(t, sender.sent, tc.wantSent)
+			assert.Equal(t, tc.wantBatches, sender.batches)
+			require.Len(t, repo.updated, 1)
+			updated := repo.updated[0]
+			assert.Equal(t, tc.wantDispatched, updated.Dispatched)
+			assert.Equal(t, tc.wantFailed, updated.Failed)
+			assert.Equal(t, tc.wantStatus, updated.Status)
+		})
+	}
+}
+
+func TestTask_DispatchInBatches(t *testing.T) {
+	t.Parallel()
+
+	const total = 250
+	receivers := make([]domain.CampaignReceiver, 0, total)
+	for i := 1; i <= total; i++ {
+		receivers = append(receivers, domain.CampaignReceiver{Seq: int64(i), Receiver: fmt.Sprintf("138%08d", i)})
+	}
+	campaign := domain.Campaign{
+		ID:    1,
+		BizID: 1,
+		Key:   "promo",
+		Notification: domain.Notification{
+			BizID:              1,
+			Key:                "promo",
+			Channel:            domain.ChannelSMS,
+			Template:           domain.Template{ID: 1, VersionID: 1, Params: map[string]string{"coupon": "10元"}},
+			SendStrategyConfig: domain.SendStrategyConfig{Type: domain.SendStrategyImmediate},
+		},
+		SendRate: total,
+		Total:    total,
+		Status:   domain.CampaignStatusRunning,
+	}
+	repo := &fakeCampaignRepo{running: []domain.Campaign{campaign}, receivers: receivers}
+	// 第二批中有一个接收者出错，只有这一批退回逐条创建
+	sender := &fakeSendStrategy{errs: map[string]error{"13800000150": fmt.Errorf("%w: 手机号格式不对", errs.ErrInvalidParameter)}}
+	task := NewTask(repo, sender, nil, logger.NewNopLogger())
+
+	require.NoError(t, task.Dispatch(t.Context()))
+
+	assert.Equal(t, []int{100, 50}, sender.batches)
+	assert.Len(t, sender.sent, total-1)
+	require.Len(t, repo.updated, 1)
+	assert.Equal(t, int64(total), repo.updated[0].Dispatched)
+	assert.Equal(t, int64(1), repo.updated[0].Failed)
+	assert.Equal(t, domain.CampaignStatusCompleted, repo.updated[0].Status)
+}
+
+func TestCampaign_ReceiverNotification(t *testing.T) {
+	t.Parallel()
+
+	c := domain.Campaign{
+		Key: "promo",
+		Notification: domain.Notification{
+			Key:                "promo",
+			Template:           domain.Template{ID: 1, Params: map[string]string{"name": "用户", "coupon": "10元"}},
+			SendStrategyConfig: domain.SendStrategyConfig{Type: domain.SendStrategyImmediate},
+		},
+	}
+
+	n := c.ReceiverNotification(domain.CampaignReceiver{Seq: 7, Receiver: "13800000001", Params: map[string]string{"name": "张三"}})
+
+	assert.Equal(t, "promo#7", n.Key)
+	assert.Equal(t, []string{"13800000001"}, n.Receivers)
+	// 接收者的参数覆盖共用的参数
+	assert.Equal(t, map[string]string{"name": "张三", "coupon": "10元"}, n.Template.Params)
+	assert.Equal(t, map[string]string{"name": "用户", "coupon": "10元"}, c.Notification.Template.Params)
+	// 活动在后台异步发送，立即发送改为截止日期发送
+	assert.Equal(t, domain.SendStrategyDeadline, n.SendStrategyConfig.Type)
+}
@@ -13,6 +13,8 @@ import (
 type Channel interface {
 	// Send 发送通知
 	Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error)
+	// Reconcile 按幂等标识向渠道下的供应商核对，返回已经被受理的接收者
+	Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error)
 }
 
 // Dispatcher 渠道分发器，对外伪装成Channel，作为统一入口
@@ -33,3 +35,11 @@ func (d *Dispatcher) Send(ctx context.Context, notification domain.Notification)
 	}
 	return channel.Send(ctx, notification)
 }
+
+func (d *Dispatcher) Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error) {
+	channel, ok := d.channels[notification.Channel]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errs.ErrNoAvailableChannel, notification.Channel)
+	}
+	return channel.Reconcile(ctx, notification)
+}
@@ -0,0 +1,11 @@
+package channel
+
+import "go-notification/internal/service/provider"
+
+type emailChannel struct {
+	baseChannel
+}
+
+func NewEmailChannel(builder provider.SelectorBuilder) Channel {
+	return &emailChannel{baseChannel: baseChannel{builder: builder}}
+}
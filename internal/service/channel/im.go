@@ -0,0 +1,11 @@
+package channel
+
+import "go-notification/internal/service/provider"
+
+type imChannel struct {
+	baseChannel
+}
+
+func NewIMChannel(builder provider.SelectorBuilder) Channel {
+	return &imChannel{baseChannel: baseChannel{builder: builder}}
+}
@@ -0,0 +1,11 @@
+package channel
+
+import "go-notification/internal/service/provider"
+
+type inAppChannel struct {
+	baseChannel
+}
+
+func NewInAppChannel(builder provider.SelectorBuilder) Channel {
+	return &inAppChannel{baseChannel: baseChannel{builder: builder}}
+}
@@ -0,0 +1,11 @@
+package channel
+
+import "go-notification/internal/service/provider"
+
+type pushChannel struct {
+	baseChannel
+}
+
+func NewPushChannel(builder provider.SelectorBuilder) Channel {
+	return &pushChannel{baseChannel: baseChannel{builder: builder}}
+}
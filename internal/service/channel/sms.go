@@ -18,18 +18,53 @@ func (b *baseChannel) Send(ctx context.Context, notification domain.Notification
 		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
 	}
 
+	var lastErr error
 	for {
 		// 获取供应商
 		p, er := selector.Next(ctx, notification)
 		if er != nil {
+			if lastErr != nil {
+				// 保留最后一个供应商的错误，便于上层判断是否为限流
+				return domain.SendResponse{}, fmt.Errorf("%w: %w: %w", errs.ErrSendNotificationFailed, er, lastErr)
+			}
 			return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, er)
 		}
 
-		// 使用当前供应商发送
+		// 使用当前供应商发送，失败或超限时换下一个供应商
 		resp, er1 := p.Send(ctx, notification)
 		if er1 == nil {
 			return resp, nil
 		}
+		lastErr = er1
+	}
+}
+
+// Reconcile 上一次请求可能发给了任意一个供应商，所以逐个向支持核对的供应商查询
+// 任意一个供应商核对失败都返回错误，由调用方决定是否重发
+func (b *baseChannel) Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error) {
+	selector, err := b.builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	var deliveries []domain.Delivery
+	// 轮询选择器不会主动结束，遇到重复的供应商说明已经查过一轮
+	seen := make(map[provider.Provider]struct{})
+	for {
+		p, er := selector.Next(ctx, notification)
+		if er != nil {
+			// 没有更多供应商
+			return deliveries, nil
+		}
+		if _, ok := seen[p]; ok {
+			return deliveries, nil
+		}
+		seen[p] = struct{}{}
+		ds, er := provider.Reconcile(ctx, p, notification)
+		if er != nil {
+			return nil, er
+		}
+		deliveries = append(deliveries, ds...)
 	}
 }
 
@@ -0,0 +1,11 @@
+package channel
+
+import "go-notification/internal/service/provider"
+
+type webhookChannel struct {
+	baseChannel
+}
+
+func NewWebhookChannel(builder provider.SelectorBuilder) Channel {
+	return &webhookChannel{baseChannel: baseChannel{builder: builder}}
+}
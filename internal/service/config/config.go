@@ -63,5 +63,15 @@ func (b *businessConfigService) SaveConfig(ctx context.Context, config domain.Bu
 	if config.ID <= 0 {
 		return fmt.Errorf("%w", errs.ErrInvalidParameter)
 	}
+	if config.QuietHours != nil {
+		if err := config.QuietHours.Validate(); err != nil {
+			return err
+		}
+	}
+	if config.FrequencyCap != nil {
+		if err := config.FrequencyCap.Validate(); err != nil {
+			return err
+		}
+	}
 	return b.repo.SaveConfig(ctx, config)
 }
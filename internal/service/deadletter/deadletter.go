@@ -0,0 +1,196 @@
+package deadletter
+
+import (
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/template/manage"
+	"time"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	maxReplayIDs    = 100
+)
+
+// ListResult 死信分页结果
+type ListResult struct {
+	DeadLetters []domain.DeadLetter
+	NextCursor  int64 // 下一页游标
+	HasMore     bool  // 是否还有更多数据
+}
+
+// ReplayResult 单条死信的重放结果
+type ReplayResult struct {
+	ID             int64 // 死信ID
+	NotificationID int64 // 重放的通知ID，沿用原通知
+	Err            error // 重放失败的原因，为 nil 表示已经重新进入待发送
+}
+
+// Service 死信服务，查询最终发送失败的通知并重放
+type Service interface {
+	List(ctx context.Context, query domain.DeadLetterQuery) (ListResult, error)
+	// Replay 重放死信，原通知改回待发送后由调度发送，每条死信单独返回结果
+	Replay(ctx context.Context, bizID int64, ids []int64, opts domain.ReplayOptions) ([]ReplayResult, error)
+}
+
+type service struct {
+	repo             repository.DeadLetterRepository
+	notificationRepo repository.NotificationRepository
+	templateSvc      manage.ChannelTemplateService
+	logger           logger.Logger
+}
+
+func NewService(repo repository.DeadLetterRepository, notificationRepo repository.NotificationRepository,
+	templateSvc manage.ChannelTemplateService, logger logger.Logger,
+) Service {
+	return &service{repo: repo, notificationRepo: notificationRepo, templateSvc: templateSvc, logger: logger}
+}
+
+func (s *service) List(ctx context.Context, query domain.DeadLetterQuery) (ListResult, error) {
+	if query.BizID <= 0 {
+		return ListResult{}, fmt.Errorf("%w: 业务ID", errs.ErrInvalidParameter)
+	}
+	if query.Channel != "" && !query.Channel.IsValid() {
+		return ListResult{}, fmt.Errorf("%w: 渠道类型", errs.ErrInvalidParameter)
+	}
+	if query.EndTime > 0 && query.StartTime > query.EndTime {
+		return ListResult{}, fmt.Errorf("%w: 开始时间晚于结束时间", errs.ErrInvalidParameter)
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultPageSize
+	}
+	if query.Limit > maxPageSize {
+		query.Limit = maxPageSize
+	}
+
+	// 多查一条用于判断是否还有下一页
+	pageSize := query.Limit
+	query.Limit++
+	deadLetters, err := s.repo.List(ctx, query)
+	if err != nil {
+		return ListResult{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+
+	res := ListResult{DeadLetters: deadLetters}
+	if len(deadLetters) > pageSize {
+		res.DeadLetters = deadLetters[:pageSize]
+		res.HasMore = true
+	}
+	if len(res.DeadLetters) > 0 {
+		res.NextCursor = res.DeadLetters[len(res.DeadLetters)-1].ID
+	}
+	return res, nil
+}
+
+func (s *service) Replay(ctx context.Context, bizID int64, ids []int64, opts domain.ReplayOptions) ([]ReplayResult, error) {
+	if bizID <= 0 {
+		return nil, fmt.Errorf("%w: 业务ID", errs.ErrInvalidParameter)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: 死信ID列表不能为空", errs.ErrInvalidParameter)
+	}
+	if len(ids) > maxReplayIDs {
+		return nil, fmt.Errorf("%w: %d > %d", errs.ErrBatchSizeOverLimit, len(ids), maxReplayIDs)
+	}
+	if opts.Channel != "" && !opts.Channel.IsValid() {
+		return nil, fmt.Errorf("%w: 渠道类型", errs.ErrInvalidParameter)
+	}
+	if opts.TemplateVersionID < 0 {
+		return nil, fmt.Errorf("%w: 模板版本ID", errs.ErrInvalidParameter)
+	}
+
+	deadLetters, err := s.repo.FindByIDs(ctx, bizID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	found := make(map[int64]domain.DeadLetter, len(deadLetters))
+	for i := range deadLetters {
+		found[deadLetters[i].ID] = deadLetters[i]
+	}
+
+	results := make([]ReplayResult, 0, len(ids))
+	for _, id := range ids {
+		dl, ok := found[id]
+		if !ok {
+			results = append(results, ReplayResult{ID: id, Err: fmt.Errorf("%w: 死信 %d 不存在", errs.ErrInvalidParameter, id)})
+			continue
+		}
+		results = append(results, ReplayResult{
+			ID:             id,
+			NotificationID: dl.NotificationID,
+			Err:            s.replay(ctx, dl, opts),
+		})
+	}
+	return results, nil
+}
+
+func (s *service) replay(ctx context.Context, dl domain.DeadLetter, opts domain.ReplayOptions) error {
+	if dl.Status != domain.DeadLetterStatusPending {
+		return fmt.Errorf("%w: 死信 %d 已经重放过", errs.ErrInvalidOperation, dl.ID)
+	}
+	n, err := s.notificationRepo.GetByID(ctx, dl.NotificationID)
+	if err != nil {
+		return err
+	}
+	if n.Status != domain.SendStatusFailed {
+		return fmt.Errorf("%w: 通知 %d 当前状态为 %s", errs.ErrInvalidOperation, n.ID, n.Status)
+	}
+
+	if opts.Channel != "" {
+		replayed, err := n.WithFallbackChannel(opts.Channel)
+		if err != nil {
+			return err
+		}
+		n = replayed
+	}
+	if err = s.applyTemplateVersion(ctx, &n, opts.TemplateVersionID); err != nil {
+		return err
+	}
+
+	// 沿用原通知ID重新发送，幂等标识不变，重放次数记录在死信上
+	n.ScheduleResend(time.Now())
+	if err = s.notificationRepo.Replay(domain.CtxWithTransitionActor(ctx, domain.TransitionActorReplay), n); err != nil {
+		return err
+	}
+	if _, err = s.repo.MarkReplayed(ctx, dl.ID); err != nil {
+		s.logger.Warn("标记死信已重放失败",
+			logger.Int64("deadLetterID", dl.ID),
+			logger.Error(err))
+	}
+	return nil
+}
+
+// applyTemplateVersion 指定版本时校验版本属于通知的模板且已审核通过，否则使用模板当前的发布版本
+func (s *service) applyTemplateVersion(ctx context.Context, n *domain.Notification, versionID int64) error {
+	tmpl, err := s.templateSvc.GetTemplateByID(ctx, n.Template.ID)
+	if err != nil {
+		return err
+	}
+	if versionID == 0 {
+		if !tmpl.HasPublished() {
+			return fmt.Errorf("%w: 模板 %d 没有发布的版本", errs.ErrTemplateNotFound, n.Template.ID)
+		}
+		n.Template.VersionID = tmpl.ActiveVersionID
+		n.Template.VersionPinned = false
+		return nil
+	}
+
+	for i := range tmpl.Versions {
+		v := tmpl.Versions[i]
+		if v.Id != versionID {
+			continue
+		}
+		if v.AuditStatus != domain.AuditStatusApproved {
+			return fmt.Errorf("%w: versionID=%d", errs.ErrTemplateVersionNotApprovedByPlatform, versionID)
+		}
+		n.Template.VersionID = versionID
+		n.Template.VersionPinned = true
+		return nil
+	}
+	return fmt.Errorf("%w: templateID=%d, versionID=%d", errs.ErrTemplateAndVersionMisMatch, n.Template.ID, versionID)
+}
@@ -0,0 +1,212 @@
+package deadletter
+
+import (
+	"context"
+	"testing"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/template/manage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDeadLetterRepo struct {
+	repository.DeadLetterRepository
+	deadLetters map[int64]domain.DeadLetter
+	replayed    []int64
+}
+
+func (r *fakeDeadLetterRepo) FindByIDs(_ context.Context, bizID int64, ids []int64) ([]domain.DeadLetter, error) {
+	var res []domain.DeadLetter
+	for _, id := range ids {
+		if dl, ok := r.deadLetters[id]; ok && dl.BizID == bizID {
+			res = append(res, dl)
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeDeadLetterRepo) MarkReplayed(_ context.Context, id int64) (bool, error) {
+	r.replayed = append(r.replayed, id)
+	return true, nil
+}
+
+type fakeNotificationRepo struct {
+	repository.NotificationRepository
+	notifications map[int64]domain.Notification
+	replayed      []domain.Notification
+}
+
+func (r *fakeNotificationRepo) GetByID(_ context.Context, id int64) (domain.Notification, error) {
+	n, ok := r.notifications[id]
+	if !ok {
+		return domain.Notification{}, errs.ErrNotificationNotFound
+	}
+	return n, nil
+}
+
+func (r *fakeNotificationRepo) Replay(_ context.Context, n domain.Notification) error {
+	r.replayed = append(r.replayed, n)
+	return nil
+}
+
+type fakeTemplateService struct {
+	manage.ChannelTemplateService
+}
+
+// GetTemplateByID 模板 10 发布了版本 11，版本 12 审核通过未发布，版本 13 未审核通过
+func (s *fakeTemplateService) GetTemplateByID(_ context.Context, templateID int64) (domain.ChannelTemplate, error) {
+	if templateID != 10 {
+		return domain.ChannelTemplate{ID: templateID, ActiveVersionID: templateID + 1}, nil
+	}
+	return domain.ChannelTemplate{
+		ID:              10,
+		ActiveVersionID: 11,
+		Versions: []domain.ChannelTemplateVersion{
+			{Id: 11, AuditStatus: domain.AuditStatusApproved},
+			{Id: 12, AuditStatus: domain.AuditStatusApproved},
+			{Id: 13, AuditStatus: domain.AuditStatusPending},
+		},
+	}, nil
+}
+
+func TestService_Replay(t *testing.T) {
+	t.Parallel()
+
+	failed := domain.Notification{
+		ID:                100,
+		BizID:             1,
+		Channel:           domain.ChannelSMS,
+		Template:          domain.Template{ID: 10, VersionID: 11},
+		Receivers:         []string{"13800000000"},
+		Status:            domain.SendStatusFailed,
+		RetryCount:        2,
+		FallbackTemplates: map[domain.Channel]int64{domain.ChannelEmail: 20},
+	}
+
+	testCases := []struct {
+		name         string
+		deadLetter   domain.DeadLetter
+		notification domain.Notification
+		opts         domain.ReplayOptions
+		wantErr      error
+		assertReplay func(t *testing.T, n domain.Notification)
+	}{
+		{
+			name:         "沿用原通知ID重放",
+			deadLetter:   domain.DeadLetter{ID: 1, BizID: 1, NotificationID: 100, Status: domain.DeadLetterStatusPending},
+			notification: failed,
+			assertReplay: func(t *testing.T, n domain.Notification) {
+				assert.Equal(t, int64(100), n.ID)
+				assert.Equal(t, domain.SendStatusPending, n.Status)
+				assert.Equal(t, domain.ChannelSMS, n.Channel)
+				assert.Equal(t, int64(11), n.Template.VersionID)
+				assert.False(t, n.Template.VersionPinned)
+				assert.True(t, n.ScheduledETime.After(n.ScheduledSTime))
+			},
+		},
+		{
+			name:         "指定模板版本",
+			deadLetter:   domain.DeadLetter{ID: 1, BizID: 1, NotificationID: 100, Status: domain.DeadLetterStatusPending},
+			notification: failed,
+			opts:         domain.ReplayOptions{TemplateVersionID: 12},
+			assertReplay: func(t *testing.T, n domain.Notification) {
+				assert.Equal(t, int64(12), n.Template.VersionID)
+				assert.True(t, n.Template.VersionPinned)
+			},
+		},
+		{
+			name:       "改用降级渠道",
+			deadLetter: domain.DeadLetter{ID: 1, BizID: 1, NotificationID: 100, Status: domain.DeadLetterStatusPending},
+			notification: func() domain.Notification {
+				n := failed
+				n.Receivers = []string{"user@example.com"}
+				return n
+			}(),
+			opts: domain.ReplayOptions{Channel: domain.ChannelEmail},
+			assertReplay: func(t *testing.T, n domain.Notification) {
+				assert.Equal(t, domain.ChannelEmail, n.Channel)
+				assert.Equal(t, int64(20), n.Template.ID)
+				assert.Equal(t, int64(21), n.Template.VersionID)
+			},
+		},
+		{
+			name:         "渠道没有配置模板",
+			deadLetter:   domain.DeadLetter{ID: 1, BizID: 1, NotificationID: 100, Status: domain.DeadLetterStatusPending},
+			notification: failed,
+			opts:         domain.ReplayOptions{Channel: domain.ChannelPush},
+			wantErr:      errs.ErrInvalidParameter,
+		},
+		{
+			name:         "接收者格式不符合目标渠道",
+			deadLetter:   domain.DeadLetter{ID: 1, BizID: 1, NotificationID: 100, Status: domain.DeadLetterStatusPending},
+			notification: failed,
+			opts:         domain.ReplayOptions{Channel: domain.ChannelEmail},
+			wantErr:      errs.ErrInvalidParameter,
+		},
+		{
+			name:         "模板版本未审核通过",
+			deadLetter:   domain.DeadLetter{ID: 1, BizID: 1, NotificationID: 100, Status: domain.DeadLetterStatusPending},
+			notification: failed,
+			opts:         domain.ReplayOptions{TemplateVersionID: 13},
+			wantErr:      errs.ErrTemplateVersionNotApprovedByPlatform,
+		},
+		{
+			name:         "模板版本不属于该模板",
+			deadLetter:   domain.DeadLetter{ID: 1, BizID: 1, NotificationID: 100, Status: domain.DeadLetterStatusPending},
+			notification: failed,
+			opts:         domain.ReplayOptions{TemplateVersionID: 99},
+			wantErr:      errs.ErrTemplateAndVersionMisMatch,
+		},
+		{
+			name:         "已经重放过",
+			deadLetter:   domain.DeadLetter{ID: 1, BizID: 1, NotificationID: 100, Status: domain.DeadLetterStatusReplayed},
+			notification: failed,
+			wantErr:      errs.ErrInvalidOperation,
+		},
+		{
+			name:       "通知已经不是失败状态",
+			deadLetter: domain.DeadLetter{ID: 1, BizID: 1, NotificationID: 100, Status: domain.DeadLetterStatusPending},
+			notification: func() domain.Notification {
+				n := failed
+				n.Status = domain.SendStatusPending
+				return n
+			}(),
+			wantErr: errs.ErrInvalidOperation,
+		},
+		{
+			name:         "其他业务的死信",
+			deadLetter:   domain.DeadLetter{ID: 1, BizID: 2, NotificationID: 100, Status: domain.DeadLetterStatusPending},
+			notification: failed,
+			wantErr:      errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeDeadLetterRepo{deadLetters: map[int64]domain.DeadLetter{tc.deadLetter.ID: tc.deadLetter}}
+			notificationRepo := &fakeNotificationRepo{notifications: map[int64]domain.Notification{tc.notification.ID: tc.notification}}
+			svc := NewService(repo, notificationRepo, &fakeTemplateService{}, logger.NewNopLogger())
+
+			results, err := svc.Replay(t.Context(), 1, []int64{1}, tc.opts)
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.ErrorIs(t, results[0].Err, tc.wantErr)
+			if tc.wantErr != nil {
+				assert.Empty(t, notificationRepo.replayed)
+				assert.Empty(t, repo.replayed)
+				return
+			}
+			assert.Equal(t, int64(100), results[0].NotificationID)
+			require.Len(t, notificationRepo.replayed, 1)
+			assert.Equal(t, []int64{1}, repo.replayed)
+			tc.assertReplay(t, notificationRepo.replayed[0])
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/notification/callback"
+)
+
+var _ Service = (*service)(nil)
+
+type service struct {
+	repo             repository.DeliveryRepository
+	notificationRepo repository.NotificationRepository
+	providerRepo     repository.ProviderRepository
+	callbackSvc      callback.Service
+	sources          map[string]ReceiptSource // 供应商名称 -> 回执来源
+	logger           logger.Logger
+}
+
+func NewService(repo repository.DeliveryRepository, notificationRepo repository.NotificationRepository,
+	providerRepo repository.ProviderRepository, callbackSvc callback.Service, sources map[string]ReceiptSource, logger logger.Logger,
+) Service {
+	return &service{
+		repo:             repo,
+		notificationRepo: notificationRepo,
+		providerRepo:     providerRepo,
+		callbackSvc:      callbackSvc,
+		sources:          sources,
+		logger:           logger,
+	}
+}
+
+func (s *service) Report(ctx context.Context, reports []domain.DeliveryReport) error {
+	// 按供应商分组，同一个回执ID只查一次
+	serialNos := make(map[string][]string)
+	for i := range reports {
+		if reports[i].SerialNo == "" || !reports[i].Status.IsFinal() {
+			continue
+		}
+		serialNos[reports[i].Provider] = append(serialNos[reports[i].Provider], reports[i].SerialNo)
+	}
+
+	finished := make(map[int64]struct{})
+	for provider, nos := range serialNos {
+		deliveries, err := s.repo.FindBySerialNos(ctx, provider, nos)
+		if err != nil {
+			return err
+		}
+		for i := range deliveries {
+			for j := range reports {
+				if !deliveries[i].Match(reports[j]) {
+					continue
+				}
+				ok, err := s.update(ctx, deliveries[i], reports[j])
+				if err != nil {
+					return err
+				}
+				if ok {
+					finished[deliveries[i].NotificationID] = struct{}{}
+				}
+				break
+			}
+		}
+	}
+
+	for id := range finished {
+		s.callbackIfCompleted(ctx, id)
+	}
+	return nil
+}
+
+func (s *service) update(ctx context.Context, delivery domain.Delivery, report domain.DeliveryReport) (bool, error) {
+	delivery.Status = report.Status
+	delivery.ErrCode = report.ErrCode
+	delivery.ErrMsg = report.ErrMsg
+	delivery.ReportTime = report.ReportTime
+	return s.repo.UpdateStatus(ctx, delivery)
+}
+
+// callbackIfCompleted 所有接收者都有终态后回调业务方，回调失败只记录日志，由业务方查询接口兜底
+func (s *service) callbackIfCompleted(ctx context.Context, notificationID int64) {
+	cnt, err := s.repo.CountWaiting(ctx, notificationID)
+	if err != nil || cnt > 0 {
+		return
+	}
+	notification, err := s.notificationRepo.GetByID(ctx, notificationID)
+	if err != nil {
+		s.logger.Warn("查询通知失败", logger.Int64("notificationID", notificationID), logger.Error(err))
+		return
+	}
+	deliveries, err := s.repo.FindByNotificationIDs(ctx, []int64{notificationID})
+	if err != nil {
+		s.logger.Warn("查询送达回执失败", logger.Int64("notificationID", notificationID), logger.Error(err))
+		return
+	}
+	notification.Deliveries = deliveries[notificationID]
+	if err = s.callbackSvc.SendDeliveryCallback(ctx, notification); err != nil {
+		s.logger.Warn("回调送达结果失败", logger.Int64("notificationID", notificationID), logger.Error(err))
+	}
+}
+
+func (s *service) HandleReceipt(ctx context.Context, provider, token string, body []byte) ([]byte, error) {
+	source, ok := s.sources[provider]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errs.ErrProviderNotFound, provider)
+	}
+	if err := s.authenticate(ctx, provider, token); err != nil {
+		return nil, err
+	}
+	reports, ack, err := source.ParseReceipts(body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrInvalidParameter, err)
+	}
+	if err = s.Report(ctx, reports); err != nil {
+		return nil, err
+	}
+	return ack, nil
+}
+
+// authenticate 按供应商表中配置的回执令牌校验推送来源，修改令牌后无需重启即可生效
+func (s *service) authenticate(ctx context.Context, provider, token string) error {
+	providers, err := s.providerRepo.FindByChannel(ctx, domain.ChannelSMS)
+	if err != nil {
+		return err
+	}
+	for i := range providers {
+		if providers[i].Name != provider {
+			continue
+		}
+		if providers[i].AuthenticateReceipt(token) {
+			return nil
+		}
+		break
+	}
+	return fmt.Errorf("%w: %s", errs.ErrReceiptUnauthorized, provider)
+}
+
+func (s *service) GetByNotificationIDs(ctx context.Context, notificationIDs []int64) (map[int64][]domain.Delivery, error) {
+	return s.repo.FindByNotificationIDs(ctx, notificationIDs)
+}
+
+func (s *service) Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error) {
+	found, err := s.repo.FindByNotificationIDs(ctx, []int64{notification.ID})
+	if err != nil {
+		return nil, err
+	}
+	deliveries := found[notification.ID]
+
+	// 按发送时记录的供应商分组，只核对提交过但没有受理结果的接收者
+	receivers := make(map[string][]string)
+	for i := range deliveries {
+		if deliveries[i].Status.IsSubmitted() || deliveries[i].Attempts == 0 {
+			continue
+		}
+		receivers[deliveries[i].Provider] = append(receivers[deliveries[i].Provider], deliveries[i].Receiver)
+	}
+
+	var reconciled []domain.Delivery
+	for provider, rs := range receivers {
+		source, ok := s.sources[provider]
+		if !ok {
+			return nil, fmt.Errorf("%w: 供应商 %q", errs.ErrReconcileNotSupported, provider)
+		}
+		n := notification
+		n.Receivers = rs
+		ds, er := source.Reconcile(ctx, n)
+		if er != nil {
+			return nil, er
+		}
+		reconciled = append(reconciled, ds...)
+	}
+	if err = s.repo.BatchCreate(ctx, reconciled); err != nil {
+		return nil, err
+	}
+	return domain.MergeDeliveries(deliveries, reconciled), nil
+}
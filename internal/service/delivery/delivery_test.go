@@ -0,0 +1,324 @@
+package delivery
+
+import (
+	"context"
+	"testing"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/notification/callback"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDeliveryRepo 内存实现，只覆盖 Report 用到的方法
+type fakeDeliveryRepo struct {
+	repository.DeliveryRepository
+	deliveries []domain.Delivery
+}
+
+func (r *fakeDeliveryRepo) FindBySerialNos(_ context.Context, provider string, serialNos []string) ([]domain.Delivery, error) {
+	var res []domain.Delivery
+	for _, d := range r.deliveries {
+		for _, no := range serialNos {
+			if d.Provider == provider && d.SerialNo == no {
+				res = append(res, d)
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeDeliveryRepo) UpdateStatus(_ context.Context, delivery domain.Delivery) (bool, error) {
+	for i := range r.deliveries {
+		if r.deliveries[i].ID == delivery.ID && r.deliveries[i].Status == domain.DeliveryStatusWaiting {
+			r.deliveries[i] = delivery
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeDeliveryRepo) CountWaiting(_ context.Context, notificationID int64) (int64, error) {
+	var cnt int64
+	for _, d := range r.deliveries {
+		if d.NotificationID == notificationID && d.Status == domain.DeliveryStatusWaiting {
+			cnt++
+		}
+	}
+	return cnt, nil
+}
+
+func (r *fakeDeliveryRepo) FindByNotificationIDs(_ context.Context, ids []int64) (map[int64][]domain.Delivery, error) {
+	res := make(map[int64][]domain.Delivery)
+	for _, d := range r.deliveries {
+		for _, id := range ids {
+			if d.NotificationID == id {
+				res[id] = append(res[id], d)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeDeliveryRepo) BatchCreate(_ context.Context, deliveries []domain.Delivery) error {
+	for _, d := range deliveries {
+		for i := range r.deliveries {
+			if r.deliveries[i].NotificationID == d.NotificationID && r.deliveries[i].Receiver == d.Receiver {
+				d.ID, d.Attempts = r.deliveries[i].ID, r.deliveries[i].Attempts
+				r.deliveries[i] = d
+			}
+		}
+	}
+	return nil
+}
+
+// fakeReceiptSource 核对时返回 accepted 中的接收者
+type fakeReceiptSource struct {
+	ReceiptSource
+	accepted map[string]bool
+	err      error
+	queried  [][]string
+}
+
+func (f *fakeReceiptSource) Reconcile(_ context.Context, n domain.Notification) ([]domain.Delivery, error) {
+	f.queried = append(f.queried, n.Receivers)
+	if f.err != nil {
+		return nil, f.err
+	}
+	var res []domain.Delivery
+	for _, r := range n.Receivers {
+		if f.accepted[r] {
+			res = append(res, domain.Delivery{NotificationID: n.ID, Receiver: r, Provider: "aliyun", Status: domain.DeliveryStatusSubmitted})
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeReceiptSource) ParseReceipts(_ []byte) ([]domain.DeliveryReport, []byte, error) {
+	return nil, []byte(`{"code":0}`), nil
+}
+
+type fakeProviderRepo struct {
+	repository.ProviderRepository
+}
+
+func (r *fakeProviderRepo) FindByChannel(_ context.Context, channel domain.Channel) ([]domain.Provider, error) {
+	return []domain.Provider{
+		{ID: 1, Name: "aliyun", Channel: channel, ReceiptToken: "secret"},
+		{ID: 2, Name: "tencentcloud", Channel: channel},
+	}, nil
+}
+
+type fakeNotificationRepo struct {
+	repository.NotificationRepository
+}
+
+func (r *fakeNotificationRepo) GetByID(_ context.Context, id int64) (domain.Notification, error) {
+	return domain.Notification{ID: id, BizID: 1, Channel: domain.ChannelSMS}, nil
+}
+
+type fakeCallbackService struct {
+	callback.Service
+	notifications []domain.Notification
+}
+
+func (f *fakeCallbackService) SendDeliveryCallback(_ context.Context, n domain.Notification) error {
+	f.notifications = append(f.notifications, n)
+	return nil
+}
+
+func TestService_Report(t *testing.T) {
+	t.Parallel()
+
+	waiting := func() []domain.Delivery {
+		// 阿里云同一批号码共用一个 BizId
+		return []domain.Delivery{
+			{ID: 1, NotificationID: 100, Receiver: "13800000001", Provider: "aliyun", SerialNo: "biz-1", Status: domain.DeliveryStatusWaiting},
+			{ID: 2, NotificationID: 100, Receiver: "13800000002", Provider: "aliyun", SerialNo: "biz-1", Status: domain.DeliveryStatusWaiting},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		reports        []domain.DeliveryReport
+		wantStatus     []domain.DeliveryStatus
+		wantCallbacked bool
+	}{
+		{
+			name: "部分回执到达不回调",
+			reports: []domain.DeliveryReport{
+				{Provider: "aliyun", SerialNo: "biz-1", Receiver: "+8613800000001", Status: domain.DeliveryStatusDelivered},
+			},
+			wantStatus: []domain.DeliveryStatus{domain.DeliveryStatusDelivered, domain.DeliveryStatusWaiting},
+		},
+		{
+			name: "全部回执到达后回调",
+			reports: []domain.DeliveryReport{
+				{Provider: "aliyun", SerialNo: "biz-1", Receiver: "13800000001", Status: domain.DeliveryStatusDelivered},
+				{Provider: "aliyun", SerialNo: "biz-1", Receiver: "13800000002", Status: domain.DeliveryStatusUndelivered, ErrCode: "MOBILE_NOT_ON_SERVICE"},
+			},
+			wantStatus:     []domain.DeliveryStatus{domain.DeliveryStatusDelivered, domain.DeliveryStatusUndelivered},
+			wantCallbacked: true,
+		},
+		{
+			name: "忽略其他供应商和等待中的回执",
+			reports: []domain.DeliveryReport{
+				{Provider: "tencent", SerialNo: "biz-1", Receiver: "13800000001", Status: domain.DeliveryStatusDelivered},
+				{Provider: "aliyun", SerialNo: "biz-1", Receiver: "13800000002", Status: domain.DeliveryStatusWaiting},
+			},
+			wantStatus: []domain.DeliveryStatus{domain.DeliveryStatusWaiting, domain.DeliveryStatusWaiting},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeDeliveryRepo{deliveries: waiting()}
+			cb := &fakeCallbackService{}
+			svc := NewService(repo, &fakeNotificationRepo{}, &fakeProviderRepo{}, cb, nil, logger.NewNopLogger())
+
+			err := svc.Report(t.Context(), tc.reports)
+			require.NoError(t, err)
+
+			for i, want := range tc.wantStatus {
+				assert.Equal(t, want, repo.deliveries[i].Status)
+			}
+			if !tc.wantCallbacked {
+				assert.Empty(t, cb.notifications)
+				return
+			}
+			require.Len(t, cb.notifications, 1)
+			assert.Equal(t, int64(100), cb.notifications[0].ID)
+			assert.Len(t, cb.notifications[0].Deliveries, 2)
+		})
+	}
+}
+
+func TestService_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		deliveries  []domain.Delivery
+		source      *fakeReceiptSource
+		wantErr     error
+		wantQueried [][]string
+		wantStatus  []domain.DeliveryStatus
+	}{
+		{
+			name: "只核对提交过且没有结果的接收者",
+			deliveries: []domain.Delivery{
+				{ID: 1, NotificationID: 100, Receiver: "13800000001", Provider: "aliyun", Status: domain.DeliveryStatusPending, Attempts: 1},
+				{ID: 2, NotificationID: 100, Receiver: "13800000002", Provider: "aliyun", Status: domain.DeliveryStatusPending, Attempts: 1},
+				{ID: 3, NotificationID: 100, Receiver: "13800000003", Status: domain.DeliveryStatusPending},
+				{ID: 4, NotificationID: 100, Receiver: "13800000004", Provider: "aliyun", Status: domain.DeliveryStatusWaiting, Attempts: 1},
+			},
+			source:      &fakeReceiptSource{accepted: map[string]bool{"13800000001": true}},
+			wantQueried: [][]string{{"13800000001", "13800000002"}},
+			wantStatus: []domain.DeliveryStatus{
+				domain.DeliveryStatusSubmitted, domain.DeliveryStatusPending,
+				domain.DeliveryStatusPending, domain.DeliveryStatusWaiting,
+			},
+		},
+		{
+			name: "没有记录供应商无法核对",
+			deliveries: []domain.Delivery{
+				{ID: 1, NotificationID: 100, Receiver: "13800000001", Status: domain.DeliveryStatusSubmitFailed, Attempts: 1},
+			},
+			source:  &fakeReceiptSource{},
+			wantErr: errs.ErrReconcileNotSupported,
+		},
+		{
+			name: "供应商查询失败",
+			deliveries: []domain.Delivery{
+				{ID: 1, NotificationID: 100, Receiver: "13800000001", Provider: "aliyun", Status: domain.DeliveryStatusPending, Attempts: 1},
+			},
+			source:      &fakeReceiptSource{err: errs.ErrExternalServiceError},
+			wantErr:     errs.ErrExternalServiceError,
+			wantQueried: [][]string{{"13800000001"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeDeliveryRepo{deliveries: tc.deliveries}
+			sources := map[string]ReceiptSource{"aliyun": tc.source}
+			svc := NewService(repo, &fakeNotificationRepo{}, &fakeProviderRepo{}, &fakeCallbackService{}, sources, logger.NewNopLogger())
+
+			deliveries, err := svc.Reconcile(t.Context(), domain.Notification{ID: 100, BizID: 1})
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantQueried, tc.source.queried)
+			if err != nil {
+				return
+			}
+			assert.Len(t, deliveries, len(tc.deliveries))
+			for i, want := range tc.wantStatus {
+				assert.Equal(t, want, repo.deliveries[i].Status)
+			}
+		})
+	}
+}
+
+func TestService_HandleReceipt(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		provider string
+		token    string
+		wantErr  error
+	}{
+		{
+			name:     "令牌正确",
+			provider: "aliyun",
+			token:    "secret",
+		},
+		{
+			name:     "令牌错误",
+			provider: "aliyun",
+			token:    "guess",
+			wantErr:  errs.ErrReceiptUnauthorized,
+		},
+		{
+			name:     "没有携带令牌",
+			provider: "aliyun",
+			wantErr:  errs.ErrReceiptUnauthorized,
+		},
+		{
+			name:     "供应商没有配置令牌",
+			provider: "tencentcloud",
+			wantErr:  errs.ErrReceiptUnauthorized,
+		},
+		{
+			name:     "未知供应商",
+			provider: "unknown",
+			token:    "secret",
+			wantErr:  errs.ErrProviderNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sources := map[string]ReceiptSource{"aliyun": &fakeReceiptSource{}, "tencentcloud": &fakeReceiptSource{}}
+			svc := NewService(&fakeDeliveryRepo{}, &fakeNotificationRepo{}, &fakeProviderRepo{}, &fakeCallbackService{}, sources, logger.NewNopLogger())
+
+			ack, err := svc.HandleReceipt(t.Context(), tc.provider, tc.token, []byte(`[]`))
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				assert.Nil(t, ack)
+				return
+			}
+			assert.JSONEq(t, `{"code":0}`, string(ack))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package delivery
+
+import (
+	"context"
+	"github.com/meoying/dlock-go"
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/pkg/loopjob"
+	"go-notification/internal/repository"
+	"time"
+)
+
+const (
+	// receiptTimeout 超过该时间仍没有回执的，视为未送达
+	receiptTimeout = 72 * time.Hour
+	// queryInterval 两次主动拉取回执的间隔
+	queryInterval = 5 * time.Minute
+)
+
+// ReceiptTask 主动拉取供应商回执，兜底供应商推送丢失的情况
+type ReceiptTask struct {
+	dclient dlock.Client
+	repo    repository.DeliveryRepository
+	svc     Service
+	sources map[string]ReceiptSource
+	log     logger.Logger
+}
+
+func NewReceiptTask(dclient dlock.Client, repo repository.DeliveryRepository, svc Service,
+	sources map[string]ReceiptSource, log logger.Logger,
+) *ReceiptTask {
+	return &ReceiptTask{dclient: dclient, repo: repo, svc: svc, sources: sources, log: log}
+}
+
+func (t *ReceiptTask) Start(ctx context.Context) {
+	const key = "notification_delivery_receipt_query"
+	lj := loopjob.NewInfiniteLoop(t.dclient, t.log, t.QueryReceipts, key)
+	lj.Run(ctx)
+}
+
+func (t *ReceiptTask) QueryReceipts(ctx context.Context) error {
+	const batchSize = 100
+	const defaultSleepTime = time.Second * 10
+	now := time.Now()
+	deliveries, err := t.repo.FindWaiting(ctx, now.UnixMilli(), batchSize)
+	if err != nil {
+		return err
+	}
+	if len(deliveries) < batchSize {
+		defer time.Sleep(defaultSleepTime)
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	// 先推迟下一次拉取时间，拉取失败也不会反复查询同一批
+	ids := make([]int64, 0, len(deliveries))
+	for i := range deliveries {
+		ids = append(ids, deliveries[i].ID)
+	}
+	if err = t.repo.UpdateNextQueryTime(ctx, ids, now.Add(queryInterval).UnixMilli()); err != nil {
+		return err
+	}
+
+	var reports []domain.DeliveryReport
+	group := make(map[string][]domain.Delivery)
+	for i := range deliveries {
+		d := deliveries[i]
+		if now.Sub(time.UnixMilli(d.Ctime)) > receiptTimeout {
+			reports = append(reports, domain.DeliveryReport{
+				Provider:   d.Provider,
+				SerialNo:   d.SerialNo,
+				Receiver:   d.Receiver,
+				Status:     domain.DeliveryStatusUndelivered,
+				ErrCode:    "TIMEOUT",
+				ErrMsg:     "等待回执超时",
+				ReportTime: now.UnixMilli(),
+			})
+			continue
+		}
+		group[d.Provider] = append(group[d.Provider], d)
+	}
+
+	for provider, ds := range group {
+		source, ok := t.sources[provider]
+		if !ok {
+			continue
+		}
+		rs, er := source.QueryReceipts(ctx, ds)
+		if er != nil {
+			t.log.Warn("拉取供应商回执失败", logger.String("provider", provider), logger.Error(er))
+			continue
+		}
+		reports = append(reports, rs...)
+	}
+	return t.svc.Report(ctx, reports)
+}
@@ -0,0 +1,29 @@
+package delivery
+
+import (
+	"context"
+	"go-notification/internal/domain"
+)
+
+// ReceiptSource 供应商回执来源
+type ReceiptSource interface {
+	// QueryReceipts 主动向供应商拉取回执，只返回已经有终态的回执
+	QueryReceipts(ctx context.Context, deliveries []domain.Delivery) ([]domain.DeliveryReport, error)
+	// ParseReceipts 解析供应商推送的回执，ack 为需要返回给供应商的应答内容
+	ParseReceipts(body []byte) (reports []domain.DeliveryReport, ack []byte, err error)
+	// Reconcile 按幂等标识查询通知在供应商侧的发送记录，没有被受理的接收者不返回
+	Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error)
+}
+
+// Service 送达回执服务
+type Service interface {
+	// Report 处理回执，通知下所有接收者都有终态后回调业务方
+	Report(ctx context.Context, reports []domain.DeliveryReport) error
+	// HandleReceipt 处理供应商推送的回执，token 与供应商配置的回执令牌不一致时返回 errs.ErrReceiptUnauthorized
+	HandleReceipt(ctx context.Context, provider, token string, body []byte) (ack []byte, err error)
+	// GetByNotificationIDs 查询通知的送达回执
+	GetByNotificationIDs(ctx context.Context, notificationIDs []int64) (map[int64][]domain.Delivery, error)
+	// Reconcile 向发送时记录的供应商核对已经提交但没有结果的接收者，返回核对后通知的全部记录
+	// 没有记录供应商或供应商不支持核对时返回 errs.ErrReconcileNotSupported
+	Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error)
+}
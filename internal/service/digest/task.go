@@ -0,0 +1,189 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"github.com/meoying/dlock-go"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/id_generator"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/pkg/loopjob"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/notification/callback"
+	"go-notification/internal/service/template/manage"
+	"time"
+)
+
+// Task 汇总发送任务
+// 到了合并时间的分组合并为一条汇总通知，由调度发送；汇总通知发送结束后，把结果同步给合并的每条通知并回调业务方
+type Task struct {
+	repo        repository.NotificationRepository
+	templateSvc manage.ChannelTemplateService
+	callbackSvc callback.Service
+	idGenerator *id_generator.Generator
+	dclient     dlock.Client
+	log         logger.Logger
+	batchSize   int
+	// maxItems 一条汇总通知最多合并的通知数量，超过的留给下一条汇总通知
+	maxItems int
+}
+
+func NewTask(
+	repo repository.NotificationRepository,
+	templateSvc manage.ChannelTemplateService,
+	callbackSvc callback.Service,
+	dclient dlock.Client,
+	log logger.Logger,
+) *Task {
+	const (
+		defaultBatchSize = 100
+		defaultMaxItems  = 100
+	)
+	return &Task{
+		repo:        repo,
+		templateSvc: templateSvc,
+		callbackSvc: callbackSvc,
+		idGenerator: id_generator.NewGenerator(),
+		dclient:     dclient,
+		log:         log,
+		batchSize:   defaultBatchSize,
+		maxItems:    defaultMaxItems,
+	}
+}
+
+func (t *Task) Start(ctx context.Context) {
+	const (
+		flushKey  = "notification_digest_flush"
+		settleKey = "notification_digest_settle"
+	)
+	go loopjob.NewInfiniteLoop(t.dclient, t.log, t.Flush, flushKey).Run(ctx)
+	loopjob.NewInfiniteLoop(t.dclient, t.log, t.Settle, settleKey).Run(ctx)
+}
+
+// Flush 合并一批到了合并时间的分组
+func (t *Task) Flush(ctx context.Context) error {
+	ctx = domain.CtxWithTransitionActor(ctx, domain.TransitionActorDigest)
+	due, err := t.repo.FindDueDigestItems(ctx, t.batchSize)
+	if err != nil {
+		return err
+	}
+	flushed := make(map[domain.DigestGroupKey]struct{}, len(due))
+	for i := range due {
+		key := due[i].DigestGroupKey()
+		if _, ok := flushed[key]; ok {
+			continue
+		}
+		flushed[key] = struct{}{}
+		if err = t.flush(ctx, due[i]); err != nil {
+			// 下一轮重试
+			t.log.Warn("合并汇总通知失败",
+				logger.Int64("notificationID", due[i].ID),
+				logger.String("digestGroup", key.Group),
+				logger.Error(err))
+		}
+	}
+	if len(due) < t.batchSize {
+		time.Sleep(time.Second)
+	}
+	return nil
+}
+
+// flush 合并 item 所在分组中等待合并的通知
+func (t *Task) flush(ctx context.Context, item domain.Notification) error {
+	items, err := t.repo.FindDigestItems(ctx, item, t.maxItems)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	contents, err := t.render(ctx, items)
+	if err != nil {
+		return err
+	}
+	digest := domain.NewDigestNotification(items, contents, time.Now())
+	digest.ID = t.idGenerator.GenerateID(digest.BizID, digest.Key)
+	_, err = t.repo.CreateDigest(ctx, digest, items)
+	return err
+}
+
+// render 每条通知按自己模板的发布版本渲染内容
+func (t *Task) render(ctx context.Context, items []domain.Notification) ([]string, error) {
+	templates := make(map[int64]domain.ChannelTemplate)
+	contents := make([]string, 0, len(items))
+	for i := range items {
+		tpl, ok := templates[items[i].Template.ID]
+		if !ok {
+			var err error
+			tpl, err = t.templateSvc.GetTemplateByID(ctx, items[i].Template.ID)
+			if err != nil {
+				return nil, err
+			}
+			templates[tpl.ID] = tpl
+		}
+		version := tpl.ActiveVersion()
+		if version == nil {
+			return nil, fmt.Errorf("%w: 模板 %d 没有发布的版本", errs.ErrInvalidParameter, tpl.ID)
+		}
+		contents = append(contents, version.RenderContent(items[i].Template.Params))
+	}
+	return contents, nil
+}
+
+// Settle 汇总通知发送结束后，合并的通知改为汇总通知的最终状态并逐条回调业务方
+func (t *Task) Settle(ctx context.Context) error {
+	const defaultSleepTime = 5 * time.Second
+	ctx = domain.CtxWithTransitionActor(ctx, domain.TransitionActorDigest)
+	items, err := t.repo.FindDigestedItems(ctx, t.batchSize)
+	if err != nil {
+		return err
+	}
+	groups := make(map[int64][]domain.Notification)
+	digestIDs := make([]int64, 0, len(items))
+	for i := range items {
+		if _, ok := groups[items[i].DigestID]; !ok {
+			digestIDs = append(digestIDs, items[i].DigestID)
+		}
+		groups[items[i].DigestID] = append(groups[items[i].DigestID], items[i])
+	}
+	digests, err := t.repo.BatchGetByID(ctx, digestIDs)
+	if err != nil {
+		return err
+	}
+
+	settled := 0
+	for _, digestID := range digestIDs {
+		digest, ok := digests[digestID]
+		if !ok || !digest.Status.IsFinal() {
+			continue
+		}
+		if t.settle(ctx, digest, groups[digestID]) {
+			settled++
+		}
+	}
+	// 汇总通知都还没有发送结束
+	if settled == 0 {
+		time.Sleep(defaultSleepTime)
+	}
+	return nil
+}
+
+func (t *Task) settle(ctx context.Context, digest domain.Notification, items []domain.Notification) bool {
+	if err := t.repo.SettleDigestItems(ctx, digest, items); err != nil {
+		t.log.Warn("同步汇总通知的发送结果失败",
+			logger.Int64("digestID", digest.ID),
+			logger.Error(err))
+		return false
+	}
+	for i := range items {
+		items[i].Status = digest.Status
+		items[i].DeliveredChannel = digest.DeliveredChannel
+	}
+	if err := t.callbackSvc.SendCallbackByNotifications(ctx, items); err != nil {
+		t.log.Warn("回调汇总发送结果失败",
+			logger.Int64("digestID", digest.ID),
+			logger.Error(err))
+	}
+	return true
+}
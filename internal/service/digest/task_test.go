@@ -0,0 +1,171 @@
+package digest
+
+import (
+	"context"
+	"testing"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/notification/callback"
+	"go-notification/internal/service/template/manage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRepo struct {
+	repository.NotificationRepository
+	items   []domain.Notification
+	digests map[int64]domain.Notification
+
+	created     []domain.Notification
+	createdWith [][]domain.Notification
+	settled     map[int64][]domain.Notification
+}
+
+func (r *fakeRepo) FindDueDigestItems(_ context.Context, limit int) ([]domain.Notification, error) {
+	return r.items[:min(limit, len(r.items))], nil
+}
+
+func (r *fakeRepo) FindDigestItems(_ context.Context, item domain.Notification, limit int) ([]domain.Notification, error) {
+	var res []domain.Notification
+	for i := range r.items {
+		if r.items[i].DigestGroupKey() == item.DigestGroupKey() && len(res) < limit {
+			res = append(res, r.items[i])
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeRepo) CreateDigest(_ context.Context, digest domain.Notification, items []domain.Notification) (domain.Notification, error) {
+	r.created = append(r.created, digest)
+	r.createdWith = append(r.createdWith, items)
+	return digest, nil
+}
+
+func (r *fakeRepo) FindDigestedItems(_ context.Context, limit int) ([]domain.Notification, error) {
+	return r.items[:min(limit, len(r.items))], nil
+}
+
+func (r *fakeRepo) BatchGetByID(_ context.Context, ids []int64) (map[int64]domain.Notification, error) {
+	res := make(map[int64]domain.Notification, len(ids))
+	for _, id := range ids {
+		if d, ok := r.digests[id]; ok {
+			res[id] = d
+		}
+	}
+	return res, nil
+}
+
+func (r *fakeRepo) SettleDigestItems(_ context.Context, digest domain.Notification, items []domain.Notification) error {
+	r.settled[digest.ID] = items
+	return nil
+}
+
+type fakeTemplateSvc struct {
+	manage.ChannelTemplateService
+	contents map[int64]string
+}
+
+func (s *fakeTemplateSvc) GetTemplateByID(_ context.Context, templateID int64) (domain.ChannelTemplate, error) {
+	return domain.ChannelTemplate{
+		ID:              templateID,
+		ActiveVersionID: templateID,
+		Versions:        []domain.ChannelTemplateVersion{{Id: templateID, Content: s.contents[templateID]}},
+	}, nil
+}
+
+type fakeCallbackSvc struct {
+	callback.Service
+	notifications []domain.Notification
+}
+
+func (s *fakeCallbackSvc) SendCallbackByNotifications(_ context.Context, notifications []domain.Notification) error {
+	s.notifications = append(s.notifications, notifications...)
+	return nil
+}
+
+func newItem(id int64, receiver, group string, templateID int64, params map[string]string) domain.Notification {
+	return domain.Notification{
+		ID:        id,
+		BizID:     1,
+		Key:       "key",
+		Receivers: []string{receiver},
+		Channel:   domain.ChannelEmail,
+		Template:  domain.Template{ID: templateID, Params: params},
+		Status:    domain.SendStatusDigesting,
+		SendStrategyConfig: domain.SendStrategyConfig{
+			Type:             domain.SendStrategyDigest,
+			DigestGroup:      group,
+			DigestTemplateID: 100,
+		},
+	}
+}
+
+func TestTask_Flush(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeRepo{items: []domain.Notification{
+		newItem(1, "a@example.com", "comment", 10, map[string]string{"user": "张三"}),
+		newItem(2, "a@example.com", "comment", 11, map[string]string{"user": "李四"}),
+		newItem(3, "b@example.com", "comment", 10, map[string]string{"user": "王五"}),
+		newItem(4, "a@example.com", "like", 10, map[string]string{"user": "赵六"}),
+	}}
+	task := NewTask(repo, &fakeTemplateSvc{contents: map[int64]string{
+		10: "${user} 评论了你",
+		11: "${user} 回复了你",
+	}}, &fakeCallbackSvc{}, nil, logger.NewNopLogger())
+	task.batchSize = len(repo.items)
+
+	require.NoError(t, task.Flush(t.Context()))
+
+	// 按业务、渠道、接收者和分组合并
+	require.Len(t, repo.created, 3)
+	digest := repo.created[0]
+	assert.NotZero(t, digest.ID)
+	assert.Equal(t, "digest#comment#1", digest.Key)
+	assert.Equal(t, domain.SendStatusPending, digest.Status)
+	assert.Equal(t, []string{"a@example.com"}, digest.Receivers)
+	assert.Equal(t, int64(100), digest.Template.ID)
+	assert.Equal(t, map[string]string{
+		domain.DigestParamCount: "2",
+		domain.DigestParamItems: "张三 评论了你\n李四 回复了你",
+	}, digest.Template.Params)
+	assert.Equal(t, []int64{1, 2}, []int64{repo.createdWith[0][0].ID, repo.createdWith[0][1].ID})
+	assert.Equal(t, "1", repo.created[1].Template.Params[domain.DigestParamCount])
+	assert.Equal(t, "digest#like#4", repo.created[2].Key)
+}
+
+func TestTask_Settle(t *testing.T) {
+	t.Parallel()
+
+	item := func(id, digestID int64) domain.Notification {
+		n := newItem(id, "a@example.com", "comment", 10, nil)
+		n.DigestID = digestID
+		return n
+	}
+	repo := &fakeRepo{
+		items: []domain.Notification{item(1, 100), item(2, 100), item(3, 200)},
+		digests: map[int64]domain.Notification{
+			100: {ID: 100, Status: domain.SendStatusSucceeded, DeliveredChannel: domain.ChannelEmail},
+			// 汇总通知还在发送中，等待发送结束
+			200: {ID: 200, Status: domain.SendStatusSending},
+		},
+		settled: make(map[int64][]domain.Notification),
+	}
+	callbackSvc := &fakeCallbackSvc{}
+	task := NewTask(repo, &fakeTemplateSvc{}, callbackSvc, nil, logger.NewNopLogger())
+
+	require.NoError(t, task.Settle(t.Context()))
+
+	require.Len(t, repo.settled, 1)
+	assert.Len(t, repo.settled[100], 2)
+	// 每条合并的通知单独回调，带上汇总通知的发送结果
+	require.Len(t, callbackSvc.notifications, 2)
+	for _, n := range callbackSvc.notifications {
+		assert.Equal(t, int64(100), n.DigestID)
+		assert.Equal(t, domain.SendStatusSucceeded, n.Status)
+		assert.Equal(t, domain.ChannelEmail, n.DeliveredChannel)
+	}
+}
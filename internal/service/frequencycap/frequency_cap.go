@@ -0,0 +1,85 @@
+package frequencycap
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository/cache"
+	"go-notification/internal/service/config"
+	"go-notification/internal/service/template/manage"
+	"strconv"
+	"time"
+)
+
+// Service 发送通知前按业务方配置的规则检查接收者的发送频率
+// 查询配置、模板或者计数失败时放行，不因为频率检查阻塞发送
+type Service interface {
+	// Acquire 没有超过上限时记录本次发送并返回 true，一条通知有多个接收者时任何一个超过上限都不发送
+	// 同一条通知重试时只计一次
+	Acquire(ctx context.Context, n domain.Notification) bool
+	// Release 通知最终发送失败时撤销 Acquire 记录的发送
+	Release(ctx context.Context, n domain.Notification)
+}
+
+type service struct {
+	configSvc   config.BusinessConfigService
+	templateSvc manage.ChannelTemplateService
+	cache       cache.FrequencyCapCache
+	logger      logger.Logger
+}
+
+func NewService(configSvc config.BusinessConfigService, templateSvc manage.ChannelTemplateService, cache cache.FrequencyCapCache, logger logger.Logger) Service {
+	return &service{configSvc: configSvc, templateSvc: templateSvc, cache: cache, logger: logger}
+}
+
+func (s *service) Acquire(ctx context.Context, n domain.Notification) bool {
+	key, rules, ok := s.rules(ctx, n)
+	if !ok {
+		return true
+	}
+	acquired, err := s.cache.Acquire(ctx, key, strconv.FormatInt(n.ID, 10), rules, time.Now())
+	if err != nil {
+		s.logger.Warn("记录接收者发送次数失败，跳过频率检查",
+			logger.Int64("notificationID", n.ID),
+			logger.Error(err))
+		return true
+	}
+	return acquired
+}
+
+func (s *service) Release(ctx context.Context, n domain.Notification) {
+	key, _, ok := s.rules(ctx, n)
+	if !ok {
+		return
+	}
+	if err := s.cache.Release(ctx, key, strconv.FormatInt(n.ID, 10)); err != nil {
+		s.logger.Warn("撤销接收者发送次数失败",
+			logger.Int64("notificationID", n.ID),
+			logger.Error(err))
+	}
+}
+
+// rules 返回对通知生效的规则，没有规则时第三个返回值为 false
+func (s *service) rules(ctx context.Context, n domain.Notification) (cache.FrequencyCapKey, []domain.FrequencyCapRule, bool) {
+	cfg, err := s.configSvc.GetByID(ctx, n.BizID)
+	if err != nil || cfg.FrequencyCap == nil || len(cfg.FrequencyCap.Rules) == 0 {
+		return cache.FrequencyCapKey{}, nil, false
+	}
+	template, err := s.templateSvc.GetTemplateByID(ctx, n.Template.ID)
+	if err != nil {
+		s.logger.Warn("查询模板业务类型失败，跳过频率检查",
+			logger.Int64("notificationID", n.ID),
+			logger.Int64("templateID", n.Template.ID),
+			logger.Error(err))
+		return cache.FrequencyCapKey{}, nil, false
+	}
+	rules := cfg.FrequencyCap.Match(n.Channel, template.BusinessType)
+	if len(rules) == 0 {
+		return cache.FrequencyCapKey{}, nil, false
+	}
+	return cache.FrequencyCapKey{
+		Receivers:    n.Receivers,
+		Channel:      n.Channel,
+		BusinessType: template.BusinessType,
+	}, rules, true
+}
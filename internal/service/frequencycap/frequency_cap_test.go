@@ -0,0 +1,143 @@
+package frequencycap
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository/cache"
+	"go-notification/internal/service/config"
+	"go-notification/internal/service/template/manage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeConfigSvc struct {
+	config.BusinessConfigService
+	cfg domain.BusinessConfig
+}
+
+func (s *fakeConfigSvc) GetByID(_ context.Context, _ int64) (domain.BusinessConfig, error) {
+	return s.cfg, nil
+}
+
+type fakeTemplateSvc struct {
+	manage.ChannelTemplateService
+	businessType domain.BusinessType
+}
+
+func (s *fakeTemplateSvc) GetTemplateByID(_ context.Context, templateID int64) (domain.ChannelTemplate, error) {
+	return domain.ChannelTemplate{ID: templateID, BusinessType: s.businessType}, nil
+}
+
+type fakeCache struct {
+	acquired bool
+	err      error
+	key      cache.FrequencyCapKey
+	rules    []domain.FrequencyCapRule
+	calls    int
+}
+
+func (c *fakeCache) Acquire(_ context.Context, key cache.FrequencyCapKey, _ string, rules []domain.FrequencyCapRule, _ time.Time) (bool, error) {
+	c.calls++
+	c.key, c.rules = key, rules
+	return c.acquired, c.err
+}
+
+func (c *fakeCache) Release(_ context.Context, _ cache.FrequencyCapKey, _ string) error {
+	return nil
+}
+
+func TestService_Acquire(t *testing.T) {
+	t.Parallel()
+
+	promotionSMS := domain.FrequencyCapRule{
+		Channels:      []domain.Channel{domain.ChannelSMS},
+		BusinessTypes: []domain.BusinessType{domain.BusinessTypePromotion},
+		Window:        86400,
+		Max:           3,
+	}
+	hourly := domain.FrequencyCapRule{Window: 3600, Max: 1}
+	notification := domain.Notification{
+		ID:        1,
+		BizID:     1,
+		Receivers: []string{"13800138000"},
+		Channel:   domain.ChannelSMS,
+		Template:  domain.Template{ID: 1},
+	}
+
+	testCases := []struct {
+		name         string
+		frequencyCap *domain.FrequencyCapConfig
+		businessType domain.BusinessType
+		cache        *fakeCache
+		want         bool
+		wantRules    []domain.FrequencyCapRule
+	}{
+		{
+			name:         "没有配置频率上限时放行",
+			businessType: domain.BusinessTypePromotion,
+			cache:        &fakeCache{},
+			want:         true,
+		},
+		{
+			name:         "规则不匹配业务类型时放行",
+			frequencyCap: &domain.FrequencyCapConfig{Rules: []domain.FrequencyCapRule{promotionSMS}},
+			businessType: domain.BusinessTypeVerificationCode,
+			cache:        &fakeCache{},
+			want:         true,
+		},
+		{
+			name:         "匹配的规则都没有达到上限",
+			frequencyCap: &domain.FrequencyCapConfig{Rules: []domain.FrequencyCapRule{promotionSMS, hourly}},
+			businessType: domain.BusinessTypePromotion,
+			cache:        &fakeCache{acquired: true},
+			want:         true,
+			wantRules:    []domain.FrequencyCapRule{promotionSMS, hourly},
+		},
+		{
+			name:         "达到上限",
+			frequencyCap: &domain.FrequencyCapConfig{Rules: []domain.FrequencyCapRule{promotionSMS, hourly}},
+			businessType: domain.BusinessTypeNotification,
+			cache:        &fakeCache{},
+			want:         false,
+			wantRules:    []domain.FrequencyCapRule{hourly},
+		},
+		{
+			name:         "计数失败时放行",
+			frequencyCap: &domain.FrequencyCapConfig{Rules: []domain.FrequencyCapRule{hourly}},
+			businessType: domain.BusinessTypePromotion,
+			cache:        &fakeCache{err: errors.New("redis 不可用")},
+			want:         true,
+			wantRules:    []domain.FrequencyCapRule{hourly},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := NewService(
+				&fakeConfigSvc{cfg: domain.BusinessConfig{ID: 1, FrequencyCap: tc.frequencyCap}},
+				&fakeTemplateSvc{businessType: tc.businessType},
+				tc.cache,
+				logger.NewNopLogger(),
+			)
+
+			assert.Equal(t, tc.want, svc.Acquire(t.Context(), notification))
+			if tc.wantRules == nil {
+				assert.Zero(t, tc.cache.calls)
+				return
+			}
+			assert.Equal(t, tc.wantRules, tc.cache.rules)
+			assert.Equal(t, cache.FrequencyCapKey{
+				Receivers:    notification.Receivers,
+				Channel:      domain.ChannelSMS,
+				BusinessType: tc.businessType,
+			}, tc.cache.key)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/repository"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	maxBatchIDs     = 100
+)
+
+// ListResult 收件箱分页结果
+type ListResult struct {
+	Messages   []domain.InboxMessage
+	NextCursor int64 // 下一页游标
+	HasMore    bool  // 是否还有更多数据
+}
+
+// Service 站内信收件箱服务
+type Service interface {
+	// Deliver 投递到收件箱，供站内信渠道使用
+	Deliver(ctx context.Context, messages []domain.InboxMessage) error
+	List(ctx context.Context, query domain.InboxQuery) (ListResult, error)
+	// ListSince 按ID正序返回游标之后的消息，供实时推送网关重放使用
+	ListSince(ctx context.Context, bizID int64, receiver string, afterID int64, limit int) ([]domain.InboxMessage, error)
+	// LatestID 最新一条消息的ID，作为新连接的初始游标
+	LatestID(ctx context.Context, bizID int64, receiver string) (int64, error)
+	UnreadCount(ctx context.Context, bizID int64, receiver string) (int64, error)
+	MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+	MarkUnread(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+	MarkAllRead(ctx context.Context, bizID int64, receiver string) (int64, error)
+	Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error)
+}
+
+type service struct {
+	repo repository.InboxRepository
+}
+
+func NewService(repo repository.InboxRepository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) Deliver(ctx context.Context, messages []domain.InboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	return s.repo.BatchCreate(ctx, messages)
+}
+
+func (s *service) List(ctx context.Context, query domain.InboxQuery) (ListResult, error) {
+	if err := s.checkOwner(query.BizID, query.Receiver); err != nil {
+		return ListResult{}, err
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultPageSize
+	}
+	if query.Limit > maxPageSize {
+		query.Limit = maxPageSize
+	}
+
+	// 多查一条用于判断是否还有下一页
+	pageSize := query.Limit
+	query.Limit++
+	messages, err := s.repo.List(ctx, query)
+	if err != nil {
+		return ListResult{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+
+	res := ListResult{Messages: messages}
+	if len(messages) > pageSize {
+		res.Messages = messages[:pageSize]
+		res.HasMore = true
+	}
+	if len(res.Messages) > 0 {
+		res.NextCursor = res.Messages[len(res.Messages)-1].ID
+	}
+	return res, nil
+}
+
+func (s *service) ListSince(ctx context.Context, bizID int64, receiver string, afterID int64, limit int) ([]domain.InboxMessage, error) {
+	if err := s.checkOwner(bizID, receiver); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > maxPageSize {
+		limit = maxPageSize
+	}
+	return s.repo.ListSince(ctx, bizID, receiver, afterID, limit)
+}
+
+func (s *service) LatestID(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	if err := s.checkOwner(bizID, receiver); err != nil {
+		return 0, err
+	}
+	return s.repo.LatestID(ctx, bizID, receiver)
+}
+
+func (s *service) UnreadCount(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	if err := s.checkOwner(bizID, receiver); err != nil {
+		return 0, err
+	}
+	return s.repo.CountUnread(ctx, bizID, receiver)
+}
+
+func (s *service) MarkRead(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	if err := s.checkBatch(bizID, receiver, ids); err != nil {
+		return 0, err
+	}
+	return s.repo.MarkRead(ctx, bizID, receiver, ids)
+}
+
+func (s *service) MarkUnread(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	if err := s.checkBatch(bizID, receiver, ids); err != nil {
+		return 0, err
+	}
+	return s.repo.MarkUnread(ctx, bizID, receiver, ids)
+}
+
+func (s *service) MarkAllRead(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	if err := s.checkOwner(bizID, receiver); err != nil {
+		return 0, err
+	}
+	return s.repo.MarkAllRead(ctx, bizID, receiver)
+}
+
+func (s *service) Delete(ctx context.Context, bizID int64, receiver string, ids []int64) (int64, error) {
+	if err := s.checkBatch(bizID, receiver, ids); err != nil {
+		return 0, err
+	}
+	return s.repo.Delete(ctx, bizID, receiver, ids)
+}
+
+func (s *service) checkOwner(bizID int64, receiver string) error {
+	if bizID <= 0 {
+		return fmt.Errorf("%w: 业务ID", errs.ErrInvalidParameter)
+	}
+	if receiver == "" {
+		return fmt.Errorf("%w: 接收者不能为空", errs.ErrInvalidParameter)
+	}
+	return nil
+}
+
+func (s *service) checkBatch(bizID int64, receiver string, ids []int64) error {
+	if err := s.checkOwner(bizID, receiver); err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("%w: 消息ID列表不能为空", errs.ErrInvalidParameter)
+	}
+	if len(ids) > maxBatchIDs {
+		return fmt.Errorf("%w: %d > %d", errs.ErrBatchSizeOverLimit, len(ids), maxBatchIDs)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package inbox
+
+import (
+	"context"
+	"testing"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRepo 按ID倒序返回游标之后的消息
+type stubRepo struct {
+	repository.InboxRepository
+	messages []domain.InboxMessage
+}
+
+func (r *stubRepo) List(_ context.Context, query domain.InboxQuery) ([]domain.InboxMessage, error) {
+	res := make([]domain.InboxMessage, 0, query.Limit)
+	for _, msg := range r.messages {
+		if query.Cursor > 0 && msg.ID >= query.Cursor {
+			continue
+		}
+		if len(res) == query.Limit {
+			break
+		}
+		res = append(res, msg)
+	}
+	return res, nil
+}
+
+func TestService_List(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubRepo{}
+	for id := int64(5); id > 0; id-- {
+		repo.messages = append(repo.messages, domain.InboxMessage{ID: id, BizID: 1, Receiver: "u1"})
+	}
+	svc := NewService(repo)
+
+	testCases := []struct {
+		name       string
+		query      domain.InboxQuery
+		wantIDs    []int64
+		wantCursor int64
+		wantMore   bool
+		wantErr    error
+	}{
+		{
+			name:       "首页",
+			query:      domain.InboxQuery{BizID: 1, Receiver: "u1", Limit: 2},
+			wantIDs:    []int64{5, 4},
+			wantCursor: 4,
+			wantMore:   true,
+		},
+		{
+			name:       "最后一页",
+			query:      domain.InboxQuery{BizID: 1, Receiver: "u1", Cursor: 2, Limit: 2},
+			wantIDs:    []int64{1},
+			wantCursor: 1,
+		},
+		{
+			name:       "恰好取完",
+			query:      domain.InboxQuery{BizID: 1, Receiver: "u1", Cursor: 3, Limit: 2},
+			wantIDs:    []int64{2, 1},
+			wantCursor: 1,
+		},
+		{
+			name:    "接收者为空",
+			query:   domain.InboxQuery{BizID: 1},
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := svc.List(context.Background(), tc.query)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			ids := make([]int64, 0, len(res.Messages))
+			for _, msg := range res.Messages {
+				ids = append(ids, msg.ID)
+			}
+			require.Equal(t, tc.wantIDs, ids)
+			assert.Equal(t, tc.wantCursor, res.NextCursor)
+			assert.Equal(t, tc.wantMore, res.HasMore)
+		})
+	}
+}
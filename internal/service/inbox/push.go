@@ -0,0 +1,78 @@
+package inbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"go-notification/internal/pkg/logger"
+)
+
+// PushEvent 站内信推送事件，IN_APP 通知被标记为发送成功后发布
+type PushEvent struct {
+	BizID          int64    `json:"bizId"`
+	NotificationID int64    `json:"notificationId"`
+	Receivers      []string `json:"receivers"`
+}
+
+// Publisher 发布推送事件
+type Publisher interface {
+	Publish(ctx context.Context, evt PushEvent) error
+}
+
+// Subscriber 订阅推送事件，阻塞直到 ctx 结束
+type Subscriber interface {
+	Subscribe(ctx context.Context, handler func(evt PushEvent)) error
+}
+
+var (
+	_ Publisher  = (*RedisBroker)(nil)
+	_ Subscriber = (*RedisBroker)(nil)
+)
+
+// RedisBroker 基于 Redis pub/sub 在多个平台实例之间扇出推送事件
+type RedisBroker struct {
+	client  *redis.Client
+	channel string
+	logger  logger.Logger
+}
+
+func NewRedisBroker(client *redis.Client, channel string, logger logger.Logger) *RedisBroker {
+	return &RedisBroker{client: client, channel: channel, logger: logger}
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, evt PushEvent) error {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	return b.client.Publish(ctx, b.channel, payload).Err()
+}
+
+func (b *RedisBroker) Subscribe(ctx context.Context, handler func(evt PushEvent)) error {
+	pubsub := b.client.Subscribe(ctx, b.channel)
+	defer pubsub.Close()
+
+	// 确认订阅成功后再开始消费
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return fmt.Errorf("订阅站内信推送频道失败: %w", err)
+	}
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			var evt PushEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &evt); err != nil {
+				b.logger.Warn("解析站内信推送事件失败", logger.Error(err), logger.String("payload", msg.Payload))
+				continue
+			}
+			handler(evt)
+		}
+	}
+}
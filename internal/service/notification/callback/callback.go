@@ -23,6 +23,8 @@ type Service interface {
 	SendCallback(ctx context.Context, startTime, batchSize int64) error
 	SendCallbackByNotification(ctx context.Context, notification domain.Notification) error
 	SendCallbackByNotifications(ctx context.Context, notifications []domain.Notification) error
+	// SendDeliveryCallback 所有接收者的回执都到齐后，回调最终的送达结果
+	SendDeliveryCallback(ctx context.Context, notification domain.Notification) error
 }
 
 type service struct {
@@ -30,18 +32,22 @@ type service struct {
 	bizID2Config sync.Map
 	clients      *mygrpc.Clients[clientv1.CallbackServiceClient]
 	repo         repository.CallbackLogRepository
+	deliveryRepo repository.DeliveryRepository
 	logger       logger.Logger
 }
 
-func NewService(configSvc configSvc.BusinessConfigService, repo repository.CallbackLogRepository, logger logger.Logger) Service {
+func NewService(configSvc configSvc.BusinessConfigService, repo repository.CallbackLogRepository,
+	deliveryRepo repository.DeliveryRepository, logger logger.Logger,
+) Service {
 	return &service{
 		configSvc:    configSvc,
 		bizID2Config: sync.Map{},
 		clients: mygrpc.NewClients(func(conn *grpc.ClientConn) clientv1.CallbackServiceClient {
 			return clientv1.NewCallbackServiceClient(conn)
 		}),
-		repo:   repo,
-		logger: logger,
+		repo:         repo,
+		deliveryRepo: deliveryRepo,
+		logger:       logger,
 	}
 }
 
@@ -117,6 +123,17 @@ func (s *service) SendCallbackByNotifications(ctx context.Context, notifications
 	return er
 }
 
+func (s *service) SendDeliveryCallback(ctx context.Context, notification domain.Notification) error {
+	resp, err := s.sendCallback(ctx, notification)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return fmt.Errorf("%w: 业务方处理送达回执失败", errs.ErrExternalServiceError)
+	}
+	return nil
+}
+
 func (s *service) sendCallbackAndUpdateCallBackLogs(ctx context.Context, logs []domain.CallbackLog) error {
 	needUpdate := make([]domain.CallbackLog, 0, len(logs))
 	for i := range logs {
@@ -173,6 +190,13 @@ func (s *service) sendCallback(ctx context.Context, notification domain.Notifica
 	if cfg == nil {
 		return nil, fmt.Errorf("%w", errs.ErrConfigNotFound)
 	}
+	if notification.Deliveries == nil {
+		// 带上各接收者的发送情况，查询失败不影响回调
+		deliveries, er := s.deliveryRepo.FindByNotificationIDs(ctx, []int64{notification.ID})
+		if er == nil {
+			notification.Deliveries = deliveries[notification.ID]
+		}
+	}
 	return s.clients.Get(cfg.ServiceName).HandleNotificationResult(ctx, s.buildRequest(notification))
 }
 
@@ -196,33 +220,84 @@ func (s *service) buildRequest(notification domain.Notification) *clientv1.Handl
 	if notification.Template.Params != nil {
 		templateParams = notification.Template.Params
 	}
+	result := &notificationv1.SendNotificationResponse{
+		NotificationId:   notification.ID,
+		Status:           s.getStatus(notification),
+		DeliveredChannel: s.getChannel(notification.DeliveredChannel),
+		Deliveries:       s.getDeliveries(notification.Deliveries),
+		// 汇总发送的通知带上汇总通知ID，发送结果即汇总通知的发送结果
+		DigestNotificationId: notification.DigestID,
+	}
+	if notification.Status == domain.SendStatusFrequencyCapped {
+		result.ErrorCode = notificationv1.ErrorCode_FREQUENCY_CAP_EXCEEDED
+		result.ErrorMessage = errs.ErrFrequencyCapped.Error()
+	}
 	return &clientv1.HandleNotificationResultRequest{
 		NotificationId: notification.ID,
 		OriginalRequest: &notificationv1.SendNotificationRequest{
 			Notification: &notificationv1.Notification{
 				Key:            notification.Key,
 				Receivers:      notification.Receivers,
-				Channel:        s.getChannel(notification),
+				Channel:        s.getChannel(notification.Channel),
 				TemplateId:     fmt.Sprintf("%d", notification.Template.ID),
 				TemplateParams: templateParams,
 			},
 		},
-		Result: &notificationv1.SendNotificationResponse{
-			NotificationId: notification.ID,
-			Status:         s.getStatus(notification),
-		},
+		Result: result,
+	}
+}
+
+func (s *service) getDeliveries(deliveries []domain.Delivery) []*notificationv1.ReceiverDelivery {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	result := make([]*notificationv1.ReceiverDelivery, 0, len(deliveries))
+	for i := range deliveries {
+		result = append(result, &notificationv1.ReceiverDelivery{
+			Receiver:   deliveries[i].Receiver,
+			Status:     getDeliveryStatus(deliveries[i].Status),
+			ErrCode:    deliveries[i].ErrCode,
+			ErrMsg:     deliveries[i].ErrMsg,
+			ReportTime: deliveries[i].ReportTime,
+		})
+	}
+	return result
+}
+
+func getDeliveryStatus(status domain.DeliveryStatus) notificationv1.DeliveryStatus {
+	switch status {
+	case domain.DeliveryStatusWaiting:
+		return notificationv1.DeliveryStatus_WAITING
+	case domain.DeliveryStatusDelivered:
+		return notificationv1.DeliveryStatus_DELIVERED
+	case domain.DeliveryStatusUndelivered:
+		return notificationv1.DeliveryStatus_UNDELIVERED
+	case domain.DeliveryStatusPending:
+		return notificationv1.DeliveryStatus_DELIVERY_PENDING
+	case domain.DeliveryStatusSubmitFailed:
+		return notificationv1.DeliveryStatus_SUBMIT_FAILED
+	case domain.DeliveryStatusSubmitted:
+		return notificationv1.DeliveryStatus_SUBMITTED
+	default:
+		return notificationv1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
 	}
 }
 
-func (s *service) getChannel(notification domain.Notification) notificationv1.Channel {
+func (s *service) getChannel(c domain.Channel) notificationv1.Channel {
 	var channel notificationv1.Channel
-	switch notification.Channel {
+	switch c {
 	case domain.ChannelSMS:
 		channel = notificationv1.Channel_SMS
 	case domain.ChannelEmail:
 		channel = notificationv1.Channel_EMAIL
 	case domain.ChannelInApp:
 		channel = notificationv1.Channel_IN_APP
+	case domain.ChannelWebhook:
+		channel = notificationv1.Channel_WEBHOOK
+	case domain.ChannelIM:
+		channel = notificationv1.Channel_IM
+	case domain.ChannelPush:
+		channel = notificationv1.Channel_PUSH
 	default:
 		channel = notificationv1.Channel_CHANNEL_UNSPECIFIED
 	}
@@ -236,14 +311,18 @@ func (s *service) getStatus(notification domain.Notification) notificationv1.Sen
 		status = notificationv1.SendStatus_SUCCEEDED
 	case domain.SendStatusFailed:
 		status = notificationv1.SendStatus_FAILED
+	case domain.SendStatusPartiallySucceeded:
+		status = notificationv1.SendStatus_PARTIALLY_SUCCEEDED
 	case domain.SendStatusCanceled:
 		status = notificationv1.SendStatus_CANCELED
-	case domain.SendStatusPending:
+	case domain.SendStatusPending, domain.SendStatusDigesting:
 		status = notificationv1.SendStatus_PENDING
 	case domain.SendStatusPrepare:
 		status = notificationv1.SendStatus_PREPARE
 	case domain.SendStatusSending:
 		status = notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
+	case domain.SendStatusFrequencyCapped:
+		status = notificationv1.SendStatus_FREQUENCY_CAPPED
 	default:
 		status = notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
 	}
@@ -0,0 +1,135 @@
+package callback
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clientv1 "go-notification/api/proto/gen/client/v1"
+	notificationv1 "go-notification/api/proto/gen/notification/v1"
+	"go-notification/internal/domain"
+	mygrpc "go-notification/internal/pkg/grpc"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/pkg/retry"
+	"go-notification/internal/repository"
+	configSvc "go-notification/internal/service/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type fakeCallbackClient struct {
+	clientv1.CallbackServiceClient
+	success  bool
+	requests []*clientv1.HandleNotificationResultRequest
+}
+
+func (c *fakeCallbackClient) HandleNotificationResult(_ context.Context, in *clientv1.HandleNotificationResultRequest, _ ...grpc.CallOption) (*clientv1.HandleNotificationResultResponse, error) {
+	c.requests = append(c.requests, in)
+	return &clientv1.HandleNotificationResultResponse{Success: c.success}, nil
+}
+
+type fakeConfigSvc struct {
+	configSvc.BusinessConfigService
+}
+
+func (s *fakeConfigSvc) GetByID(_ context.Context, id int64) (domain.BusinessConfig, error) {
+	return domain.BusinessConfig{
+		ID: id,
+		CallbackConfig: &domain.CallbackConfig{
+			ServiceName: "order",
+			RetryPolicy: &retry.Config{
+				Type:          "fixed",
+				FixedInterval: &retry.FixedIntervalConfig{MaxRetries: 3, Interval: time.Minute},
+			},
+		},
+	}, nil
+}
+
+type fakeCallbackLogRepo struct {
+	repository.CallbackLogRepository
+	logs    []domain.CallbackLog
+	updated []domain.CallbackLog
+}
+
+func (r *fakeCallbackLogRepo) FindByNotificationIDs(_ context.Context, _ []int64) ([]domain.CallbackLog, error) {
+	return r.logs, nil
+}
+
+func (r *fakeCallbackLogRepo) Update(_ context.Context, logs []domain.CallbackLog) error {
+	r.updated = append(r.updated, logs...)
+	return nil
+}
+
+type fakeDeliveryRepo struct {
+	repository.DeliveryRepository
+}
+
+func (r *fakeDeliveryRepo) FindByNotificationIDs(_ context.Context, _ []int64) (map[int64][]domain.Delivery, error) {
+	return nil, nil
+}
+
+func TestService_SendCallbackByNotification_FrequencyCapped(t *testing.T) {
+	t.Parallel()
+
+	capped := domain.Notification{
+		ID:        1,
+		BizID:     1,
+		Key:       "key",
+		Receivers: []string{"13800000000"},
+		Channel:   domain.ChannelSMS,
+		Template:  domain.Template{ID: 1},
+		Status:    domain.SendStatusFrequencyCapped,
+	}
+
+	testCases := []struct {
+		name          string
+		clientSuccess bool
+		wantStatus    domain.CallbackLogStatus
+		wantRetries   int32
+	}{
+		{
+			name:          "频率受限的通知回调业务方",
+			clientSuccess: true,
+			wantStatus:    domain.CallbackLogStatusSuccess,
+		},
+		{
+			name:        "业务方处理失败等待回调任务重试",
+			wantStatus:  domain.CallbackLogStatusPending,
+			wantRetries: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &fakeCallbackClient{success: tc.clientSuccess}
+			// 频率受限的通知创建时回调记录就是可以发送的状态
+			logRepo := &fakeCallbackLogRepo{logs: []domain.CallbackLog{
+				{ID: 1, Notification: capped, Status: domain.CallbackLogStatusPending},
+			}}
+			svc := &service{
+				configSvc: &fakeConfigSvc{},
+				clients: mygrpc.NewClients(func(*grpc.ClientConn) clientv1.CallbackServiceClient {
+					return client
+				}),
+				repo:         logRepo,
+				deliveryRepo: &fakeDeliveryRepo{},
+				logger:       logger.NewNopLogger(),
+			}
+
+			require.NoError(t, svc.SendCallbackByNotification(t.Context(), capped))
+
+			require.Len(t, client.requests, 1)
+			result := client.requests[0].GetResult()
+			assert.Equal(t, capped.ID, client.requests[0].GetNotificationId())
+			assert.Equal(t, notificationv1.SendStatus_FREQUENCY_CAPPED, result.GetStatus())
+			assert.Equal(t, notificationv1.ErrorCode_FREQUENCY_CAP_EXCEEDED, result.GetErrorCode())
+			require.Len(t, logRepo.updated, 1)
+			assert.Equal(t, tc.wantStatus, logRepo.updated[0].Status)
+			assert.Equal(t, tc.wantRetries, logRepo.updated[0].RetryCount)
+		})
+	}
+}
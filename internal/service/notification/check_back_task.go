@@ -55,7 +55,7 @@ func (task *TxCheckTask) Start(ctx context.Context) {
 
 // 为了性能，使用了批量操作，针对的是数据库的批量操作
 func (task *TxCheckTask) oneLoop(ctx context.Context) error {
-	loopCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	loopCtx, cancel := context.WithTimeout(domain.CtxWithTransitionActor(ctx, domain.TransitionActorTxCheck), defaultTimeout)
 	defer cancel()
 	txNotifications, err := task.repo.FindCheckBack(loopCtx, 0, task.batchSize)
 	if err != nil {
@@ -12,6 +12,7 @@ package notificationmocks
 import (
 	context "context"
 	domain "go-notification/internal/domain"
+	notification "go-notification/internal/service/notification"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,44 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockService) Cancel(ctx context.Context, bizID int64, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, bizID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockServiceMockRecorder) Cancel(ctx, bizID, key any) *MockServiceCancelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockService)(nil).Cancel), ctx, bizID, key)
+	return &MockServiceCancelCall{Call: call}
+}
+
+// MockServiceCancelCall wrap *gomock.Call
+type MockServiceCancelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCancelCall) Return(arg0 error) *MockServiceCancelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCancelCall) Do(f func(context.Context, int64, string) error) *MockServiceCancelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCancelCall) DoAndReturn(f func(context.Context, int64, string) error) *MockServiceCancelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindReadyNotifications mocks base method.
 func (m *MockService) FindReadyNotifications(ctx context.Context, offiset, limit int) ([]domain.Notification, error) {
 	m.ctrl.T.Helper()
@@ -123,3 +162,160 @@ func (c *MockServiceGetByKeysCall) DoAndReturn(f func(context.Context, int64, ..
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetTimeline mocks base method.
+func (m *MockService) GetTimeline(ctx context.Context, bizID int64, key string) (domain.Notification, []domain.NotificationTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeline", ctx, bizID, key)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].([]domain.NotificationTransition)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTimeline indicates an expected call of GetTimeline.
+func (mr *MockServiceMockRecorder) GetTimeline(ctx, bizID, key any) *MockServiceGetTimelineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockService)(nil).GetTimeline), ctx, bizID, key)
+	return &MockServiceGetTimelineCall{Call: call}
+}
+
+// MockServiceGetTimelineCall wrap *gomock.Call
+type MockServiceGetTimelineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceGetTimelineCall) Return(arg0 domain.Notification, arg1 []domain.NotificationTransition, arg2 error) *MockServiceGetTimelineCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceGetTimelineCall) Do(f func(context.Context, int64, string) (domain.Notification, []domain.NotificationTransition, error)) *MockServiceGetTimelineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceGetTimelineCall) DoAndReturn(f func(context.Context, int64, string) (domain.Notification, []domain.NotificationTransition, error)) *MockServiceGetTimelineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkSending mocks base method.
+func (m *MockService) MarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSending", ctx, notifications)
+	ret0, _ := ret[0].([]domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkSending indicates an expected call of MarkSending.
+func (mr *MockServiceMockRecorder) MarkSending(ctx, notifications any) *MockServiceMarkSendingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSending", reflect.TypeOf((*MockService)(nil).MarkSending), ctx, notifications)
+	return &MockServiceMarkSendingCall{Call: call}
+}
+
+// MockServiceMarkSendingCall wrap *gomock.Call
+type MockServiceMarkSendingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceMarkSendingCall) Return(arg0 []domain.Notification, arg1 error) *MockServiceMarkSendingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceMarkSendingCall) Do(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockServiceMarkSendingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceMarkSendingCall) DoAndReturn(f func(context.Context, []domain.Notification) ([]domain.Notification, error)) *MockServiceMarkSendingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reschedule mocks base method.
+func (m *MockService) Reschedule(ctx context.Context, bizID int64, key string, strategy domain.SendStrategyConfig) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", ctx, bizID, key, strategy)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockServiceMockRecorder) Reschedule(ctx, bizID, key, strategy any) *MockServiceRescheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockService)(nil).Reschedule), ctx, bizID, key, strategy)
+	return &MockServiceRescheduleCall{Call: call}
+}
+
+// MockServiceRescheduleCall wrap *gomock.Call
+type MockServiceRescheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRescheduleCall) Return(arg0 domain.Notification, arg1 error) *MockServiceRescheduleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRescheduleCall) Do(f func(context.Context, int64, string, domain.SendStrategyConfig) (domain.Notification, error)) *MockServiceRescheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRescheduleCall) DoAndReturn(f func(context.Context, int64, string, domain.SendStrategyConfig) (domain.Notification, error)) *MockServiceRescheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Search mocks base method.
+func (m *MockService) Search(ctx context.Context, q domain.NotificationSearch) (notification.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, q)
+	ret0, _ := ret[0].(notification.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockServiceMockRecorder) Search(ctx, q any) *MockServiceSearchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockService)(nil).Search), ctx, q)
+	return &MockServiceSearchCall{Call: call}
+}
+
+// MockServiceSearchCall wrap *gomock.Call
+type MockServiceSearchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSearchCall) Return(arg0 notification.SearchResult, arg1 error) *MockServiceSearchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSearchCall) Do(f func(context.Context, domain.NotificationSearch) (notification.SearchResult, error)) *MockServiceSearchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSearchCall) DoAndReturn(f func(context.Context, domain.NotificationSearch) (notification.SearchResult, error)) *MockServiceSearchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -2,10 +2,12 @@ package notification
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"go-notification/internal/domain"
 	"go-notification/internal/errs"
 	"go-notification/internal/repository"
+	"go-notification/internal/service/notification/callback"
 )
 
 //go:generate mockgen -source=./notification.go -destination=./mocks/notification.mock.go -package=notificationmocks -typed Service
@@ -14,20 +16,44 @@ type Service interface {
 	FindReadyNotifications(ctx context.Context, offiset, limit int) ([]domain.Notification, error)
 	// GetByKeys 根据业务ID和业务内唯一标识获取通知列表
 	GetByKeys(ctx context.Context, bizID int64, keys ...string) ([]domain.Notification, error)
+	// Search 按条件分页检索业务下的通知
+	Search(ctx context.Context, q domain.NotificationSearch) (SearchResult, error)
+	// GetTimeline 获取通知及其按发生顺序排列的状态流转记录
+	GetTimeline(ctx context.Context, bizID int64, key string) (domain.Notification, []domain.NotificationTransition, error)
+	// MarkSending 调度发送前抢占通知，只返回抢占成功的通知
+	MarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error)
+	// Cancel 取消待发送的通知，归还额度并回调取消结果
+	Cancel(ctx context.Context, bizID int64, key string) error
+	// Reschedule 按新的发送策略修改待发送通知的计划发送时间
+	Reschedule(ctx context.Context, bizID int64, key string, strategy domain.SendStrategyConfig) (domain.Notification, error)
 }
 
-type notificationService struct {
-	repo repository.NotificationRepository
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// SearchResult 通知检索的分页结果
+type SearchResult struct {
+	Notifications []domain.Notification
+	NextCursor    domain.NotificationCursor // 下一页游标
+	HasMore       bool                      // 是否还有更多数据
 }
 
-func newNotificationService(repo repository.NotificationRepository) Service {
-	return &notificationService{repo: repo}
+type notificationService struct {
+	repo           repository.NotificationRepository
+	transitionRepo repository.NotificationTransitionRepository
+	callbackSvc    callback.Service
 }
 
 // NewNotificationService 创建通知服务实例
-func NewNotificationService(repo repository.NotificationRepository) Service {
+func NewNotificationService(repo repository.NotificationRepository, transitionRepo repository.NotificationTransitionRepository,
+	callbackSvc callback.Service,
+) Service {
 	return &notificationService{
-		repo: repo,
+		repo:           repo,
+		transitionRepo: transitionRepo,
+		callbackSvc:    callbackSvc,
 	}
 }
 
@@ -48,3 +74,139 @@ func (n *notificationService) GetByKeys(ctx context.Context, bizID int64, keys .
 	}
 	return notifications, nil
 }
+
+// Search 按条件分页检索业务下的通知，默认按创建时间倒序
+func (n *notificationService) Search(ctx context.Context, q domain.NotificationSearch) (SearchResult, error) {
+	if q.BizID <= 0 {
+		return SearchResult{}, fmt.Errorf("%w: 业务ID", errs.ErrInvalidParameter)
+	}
+	if q.Channel != "" && !q.Channel.IsValid() {
+		return SearchResult{}, fmt.Errorf("%w: 渠道类型", errs.ErrInvalidParameter)
+	}
+	if q.EndTime > 0 && q.StartTime > q.EndTime {
+		return SearchResult{}, fmt.Errorf("%w: 开始时间晚于结束时间", errs.ErrInvalidParameter)
+	}
+	if q.SortBy == "" {
+		q.SortBy = domain.NotificationSortByCtime
+	}
+	if !q.SortBy.IsValid() {
+		return SearchResult{}, fmt.Errorf("%w: 排序字段 %s", errs.ErrInvalidParameter, q.SortBy)
+	}
+	if q.Limit <= 0 {
+		q.Limit = defaultSearchPageSize
+	}
+	if q.Limit > maxSearchPageSize {
+		q.Limit = maxSearchPageSize
+	}
+
+	// 多查一条用于判断是否还有下一页
+	pageSize := q.Limit
+	q.Limit++
+	notifications, err := n.repo.Search(ctx, q)
+	if err != nil {
+		return SearchResult{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+
+	res := SearchResult{Notifications: notifications}
+	if len(notifications) > pageSize {
+		res.Notifications = notifications[:pageSize]
+		res.HasMore = true
+	}
+	if len(res.Notifications) > 0 {
+		res.NextCursor = q.CursorOf(res.Notifications[len(res.Notifications)-1])
+	}
+	return res, nil
+}
+
+// GetTimeline 获取通知及其按发生顺序排列的状态流转记录
+func (n *notificationService) GetTimeline(ctx context.Context, bizID int64, key string) (domain.Notification, []domain.NotificationTransition, error) {
+	if key == "" {
+		return domain.Notification{}, nil, fmt.Errorf("%w: 业务内唯一标识不能为空", errs.ErrInvalidParameter)
+	}
+	notifications, err := n.repo.GetByKeys(ctx, bizID, key)
+	if err != nil {
+		return domain.Notification{}, nil, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	if len(notifications) == 0 {
+		return domain.Notification{}, nil, fmt.Errorf("%w: key=%s", errs.ErrNotificationNotFound, key)
+	}
+	transitions, err := n.transitionRepo.FindByNotificationID(ctx, notifications[0].ID)
+	if err != nil {
+		return domain.Notification{}, nil, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	return notifications[0], transitions, nil
+}
+
+// MarkSending 调度发送前抢占通知，已被取消、改期或者其他实例抢占的通知不再发送
+func (n *notificationService) MarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	if len(notifications) == 0 {
+		return nil, nil
+	}
+	claimed, err := n.repo.MarkSending(ctx, notifications)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	return claimed, nil
+}
+
+// Cancel 取消待发送的通知，调度已经抢占的通知版本号发生了变化，不会被取消
+func (n *notificationService) Cancel(ctx context.Context, bizID int64, key string) error {
+	found, err := n.getPending(ctx, bizID, key)
+	if err != nil {
+		return err
+	}
+	err = n.repo.Cancel(ctx, found)
+	if err != nil {
+		return n.wrapCASError(err)
+	}
+	found.Status = domain.SendStatusCanceled
+	found.Version++
+	// 回调失败时由回调任务重试
+	_ = n.callbackSvc.SendCallbackByNotifications(ctx, []domain.Notification{found})
+	return nil
+}
+
+// Reschedule 按新的发送策略修改待发送通知的计划发送时间
+func (n *notificationService) Reschedule(ctx context.Context, bizID int64, key string, strategy domain.SendStrategyConfig) (domain.Notification, error) {
+	if err := strategy.Validate(); err != nil {
+		return domain.Notification{}, err
+	}
+	found, err := n.getPending(ctx, bizID, key)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	found.SendStrategyConfig = strategy
+	found.SetSendTime()
+	err = n.repo.Reschedule(ctx, found)
+	if err != nil {
+		return domain.Notification{}, n.wrapCASError(err)
+	}
+	found.Version++
+	return found, nil
+}
+
+// getPending 获取待发送的通知，其他状态的通知已经开始发送或者已经结束
+func (n *notificationService) getPending(ctx context.Context, bizID int64, key string) (domain.Notification, error) {
+	if key == "" {
+		return domain.Notification{}, fmt.Errorf("%w: 业务内唯一标识不能为空", errs.ErrInvalidParameter)
+	}
+	notifications, err := n.repo.GetByKeys(ctx, bizID, key)
+	if err != nil {
+		return domain.Notification{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+	if len(notifications) == 0 {
+		return domain.Notification{}, fmt.Errorf("%w: key=%s", errs.ErrNotificationNotFound, key)
+	}
+	if notifications[0].Status != domain.SendStatusPending {
+		return domain.Notification{}, fmt.Errorf("%w: 通知状态为 %s，只能操作待发送的通知", errs.ErrInvalidOperation, notifications[0].Status)
+	}
+	return notifications[0], nil
+}
+
+// wrapCASError 读取之后通知被调度抢占或者被并发修改时版本号不匹配
+func (n *notificationService) wrapCASError(err error) error {
+	if errors.Is(err, errs.ErrNotificationVersionMismatch) {
+		return fmt.Errorf("%w: 通知已开始发送或已被修改: %w", errs.ErrInvalidOperation, err)
+	}
+	return fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+}
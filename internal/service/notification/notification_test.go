@@ -0,0 +1,303 @@
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/notification/callback"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSearchRepo struct {
+	repository.NotificationRepository
+	notifications []domain.Notification
+	query         domain.NotificationSearch
+}
+
+func (r *fakeSearchRepo) Search(_ context.Context, q domain.NotificationSearch) ([]domain.Notification, error) {
+	r.query = q
+	if len(r.notifications) > q.Limit {
+		return r.notifications[:q.Limit], nil
+	}
+	return r.notifications, nil
+}
+
+type fakeTimelineRepo struct {
+	repository.NotificationRepository
+}
+
+func (r *fakeTimelineRepo) GetByKeys(_ context.Context, bizID int64, keys ...string) ([]domain.Notification, error) {
+	if bizID != 1 || keys[0] != "k1" {
+		return nil, nil
+	}
+	return []domain.Notification{{ID: 100, BizID: 1, Key: "k1", Status: domain.SendStatusSucceeded}}, nil
+}
+
+type fakeTransitionRepo struct {
+	repository.NotificationTransitionRepository
+}
+
+func (r *fakeTransitionRepo) FindByNotificationID(_ context.Context, notificationID int64) ([]domain.NotificationTransition, error) {
+	return []domain.NotificationTransition{
+		{NotificationID: notificationID, ToStatus: domain.SendStatusPending, Actor: domain.TransitionActorAPI},
+		{NotificationID: notificationID, FromStatus: domain.SendStatusPending, ToStatus: domain.SendStatusSucceeded, Actor: domain.TransitionActorScheduler},
+	}, nil
+}
+
+// fakeCancelRepo 取消时按版本号比较，模拟读取之后被调度抢占
+type fakeCancelRepo struct {
+	repository.NotificationRepository
+	found       domain.Notification
+	version     int
+	canceled    bool
+	rescheduled domain.Notification
+}
+
+func (r *fakeCancelRepo) GetByKeys(_ context.Context, _ int64, _ ...string) ([]domain.Notification, error) {
+	if r.found.ID == 0 {
+		return nil, nil
+	}
+	return []domain.Notification{r.found}, nil
+}
+
+func (r *fakeCancelRepo) Cancel(_ context.Context, n domain.Notification) error {
+	if n.Version != r.version {
+		return errs.ErrNotificationVersionMismatch
+	}
+	r.canceled = true
+	return nil
+}
+
+func (r *fakeCancelRepo) Reschedule(_ context.Context, n domain.Notification) error {
+	if n.Version != r.version {
+		return errs.ErrNotificationVersionMismatch
+	}
+	r.rescheduled = n
+	return nil
+}
+
+type fakeCallbackSvc struct {
+	callback.Service
+	notifications []domain.Notification
+}
+
+func (s *fakeCallbackSvc) SendCallbackByNotifications(_ context.Context, notifications []domain.Notification) error {
+	s.notifications = append(s.notifications, notifications...)
+	return nil
+}
+
+func TestNotificationService_Cancel(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		found        domain.Notification
+		version      int
+		wantErr      error
+		wantCallback bool
+	}{
+		{
+			name:         "取消待发送的通知",
+			found:        domain.Notification{ID: 1, Status: domain.SendStatusPending, Version: 1},
+			version:      1,
+			wantCallback: true,
+		},
+		{
+			name:    "已被调度抢占",
+			found:   domain.Notification{ID: 1, Status: domain.SendStatusPending, Version: 1},
+			version: 2,
+			wantErr: errs.ErrInvalidOperation,
+		},
+		{
+			name:    "已经发送成功",
+			found:   domain.Notification{ID: 1, Status: domain.SendStatusSucceeded, Version: 3},
+			version: 3,
+			wantErr: errs.ErrInvalidOperation,
+		},
+		{
+			name:    "通知不存在",
+			wantErr: errs.ErrNotificationNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeCancelRepo{found: tc.found, version: tc.version}
+			callbackSvc := &fakeCallbackSvc{}
+			svc := NewNotificationService(repo, nil, callbackSvc)
+
+			err := svc.Cancel(t.Context(), 1, "k1")
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantErr == nil, repo.canceled)
+			if !tc.wantCallback {
+				assert.Empty(t, callbackSvc.notifications)
+				return
+			}
+			require.Len(t, callbackSvc.notifications, 1)
+			assert.Equal(t, domain.SendStatusCanceled, callbackSvc.notifications[0].Status)
+		})
+	}
+}
+
+func TestNotificationService_Reschedule(t *testing.T) {
+	t.Parallel()
+
+	sendTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	testCases := []struct {
+		name      string
+		strategy  domain.SendStrategyConfig
+		wantErr   error
+		wantETime time.Time
+	}{
+		{
+			name:      "改为定时发送",
+			strategy:  domain.SendStrategyConfig{Type: domain.SendStrategyScheduled, ScheduleTime: sendTime},
+			wantETime: sendTime,
+		},
+		{
+			name:     "发送时间已经过去",
+			strategy: domain.SendStrategyConfig{Type: domain.SendStrategyScheduled, ScheduleTime: time.Now().Add(-time.Hour)},
+			wantErr:  errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeCancelRepo{
+				found:   domain.Notification{ID: 1, Status: domain.SendStatusPending, Version: 1},
+				version: 1,
+			}
+			svc := NewNotificationService(repo, nil, nil)
+
+			n, err := svc.Reschedule(t.Context(), 1, "k1", tc.strategy)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tc.wantETime, repo.rescheduled.ScheduledETime)
+			assert.Equal(t, 2, n.Version)
+		})
+	}
+}
+
+func TestNotificationService_GetTimeline(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		bizID   int64
+		key     string
+		wantErr error
+		wantLen int
+	}{
+		{name: "返回全部状态流转", bizID: 1, key: "k1", wantLen: 2},
+		{name: "其他业务的通知", bizID: 2, key: "k1", wantErr: errs.ErrNotificationNotFound},
+		{name: "缺少业务内唯一标识", bizID: 1, wantErr: errs.ErrInvalidParameter},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := NewNotificationService(&fakeTimelineRepo{}, &fakeTransitionRepo{}, nil)
+			n, transitions, err := svc.GetTimeline(t.Context(), tc.bizID, tc.key)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+			assert.Equal(t, int64(100), n.ID)
+			require.Len(t, transitions, tc.wantLen)
+			assert.Equal(t, int64(100), transitions[0].NotificationID)
+		})
+	}
+}
+
+func TestNotificationService_Search(t *testing.T) {
+	t.Parallel()
+
+	notifications := func(n int) []domain.Notification {
+		res := make([]domain.Notification, 0, n)
+		for i := n; i > 0; i-- {
+			res = append(res, domain.Notification{ID: int64(i), Ctime: int64(i * 10), Utime: int64(i * 100)})
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name          string
+		notifications []domain.Notification
+		query         domain.NotificationSearch
+		wantErr       error
+		wantLen       int
+		wantHasMore   bool
+		wantCursor    domain.NotificationCursor
+		wantSortBy    domain.NotificationSortField
+	}{
+		{
+			name:          "默认按创建时间排序",
+			notifications: notifications(3),
+			query:         domain.NotificationSearch{BizID: 1},
+			wantLen:       3,
+			wantCursor:    domain.NotificationCursor{SortValue: 10, ID: 1},
+			wantSortBy:    domain.NotificationSortByCtime,
+		},
+		{
+			name:          "还有下一页",
+			notifications: notifications(3),
+			query:         domain.NotificationSearch{BizID: 1, SortBy: domain.NotificationSortByUtime, Limit: 2},
+			wantLen:       2,
+			wantHasMore:   true,
+			wantCursor:    domain.NotificationCursor{SortValue: 200, ID: 2},
+			wantSortBy:    domain.NotificationSortByUtime,
+		},
+		{
+			name:    "排序字段不支持",
+			query:   domain.NotificationSearch{BizID: 1, SortBy: "status"},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "时间范围错误",
+			query:   domain.NotificationSearch{BizID: 1, StartTime: 2, EndTime: 1},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "缺少业务ID",
+			query:   domain.NotificationSearch{},
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeSearchRepo{notifications: tc.notifications}
+			svc := NewNotificationService(repo, nil, nil)
+
+			res, err := svc.Search(t.Context(), tc.query)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr != nil {
+				return
+			}
+			require.Len(t, res.Notifications, tc.wantLen)
+			assert.Equal(t, tc.wantHasMore, res.HasMore)
+			assert.Equal(t, tc.wantCursor, res.NextCursor)
+			assert.Equal(t, tc.wantSortBy, repo.query.SortBy)
+
+			// 游标编码后可以还原
+			cursor, err := domain.ParseNotificationCursor(res.NextCursor.Encode())
+			require.NoError(t, err)
+			assert.Equal(t, res.NextCursor, cursor)
+		})
+	}
+}
@@ -3,20 +3,34 @@ package notification
 import (
 	"context"
 	"github.com/meoying/dlock-go"
+	"go-notification/internal/domain"
 	"go-notification/internal/pkg/logger"
 	"go-notification/internal/pkg/loopjob"
 	"go-notification/internal/repository"
+	"go-notification/internal/service/delivery"
+	"go-notification/internal/service/notification/callback"
 	"time"
 )
 
+// maxSendAttempts 接收者最多提交供应商的次数，达到后不再重新入队
+const maxSendAttempts = 3
+
+// SendingTimeoutTask 核对发送中超时的通知
+// 服务可能在调用供应商之后、更新状态之前崩溃，直接标记失败会导致已经发出的通知被业务方重发，
+// 所以先向发送时记录的供应商查询真实结果，供应商无法回答时才标记为失败
 type SendingTimeoutTask struct {
-	dclient dlock.Client
-	repo    repository.NotificationRepository
-	log     logger.Logger
+	dclient        dlock.Client
+	repo           repository.NotificationRepository
+	deadLetterRepo repository.DeadLetterRepository
+	deliverySvc    delivery.Service
+	callbackSvc    callback.Service
+	log            logger.Logger
 }
 
-func NewSendingTimeoutTask(dclient dlock.Client, repo repository.NotificationRepository, log logger.Logger) *SendingTimeoutTask {
-	return &SendingTimeoutTask{dclient: dclient, repo: repo, log: log}
+func NewSendingTimeoutTask(dclient dlock.Client, repo repository.NotificationRepository, deadLetterRepo repository.DeadLetterRepository,
+	deliverySvc delivery.Service, callbackSvc callback.Service, log logger.Logger,
+) *SendingTimeoutTask {
+	return &SendingTimeoutTask{dclient: dclient, repo: repo, deadLetterRepo: deadLetterRepo, deliverySvc: deliverySvc, callbackSvc: callbackSvc, log: log}
 }
 
 func (s *SendingTimeoutTask) Start(ctx context.Context) {
@@ -28,14 +42,87 @@ func (s *SendingTimeoutTask) Start(ctx context.Context) {
 func (s *SendingTimeoutTask) HandleSendingTimeout(ctx context.Context) error {
 	const batchSize = 10
 	const defaultSleepTime = time.Second * 10
-	cnt, err := s.repo.MarkTimeoutSendingAsFailed(ctx, batchSize)
+	ctx = domain.CtxWithTransitionActor(ctx, domain.TransitionActorTimeoutTask)
+	notifications, err := s.repo.FindTimeoutSending(ctx, batchSize)
 	if err != nil {
 		return err
 	}
+	for i := range notifications {
+		s.reconcile(ctx, notifications[i])
+	}
 	// 说明 SENDING 的不多，可以休息一下
-	if cnt < batchSize {
+	if len(notifications) < batchSize {
 		// 这里可以随便设置，在分钟以内都可以
 		time.Sleep(defaultSleepTime)
 	}
 	return nil
 }
+
+// reconcile 根据核对结果标记成功、失败或者重新入队
+func (s *SendingTimeoutTask) reconcile(ctx context.Context, notification domain.Notification) {
+	deliveries, err := s.deliverySvc.Reconcile(ctx, notification)
+	if err != nil {
+		s.log.Warn("向供应商核对发送超时的通知失败，标记为发送失败",
+			logger.Int64("notificationID", notification.ID),
+			logger.Error(err))
+		notification.Status = domain.SendStatusFailed
+		// 死信中需要保留各接收者的提交记录
+		if rows, er := s.deliverySvc.GetByNotificationIDs(ctx, []int64{notification.ID}); er == nil && len(rows[notification.ID]) > 0 {
+			notification.Deliveries = rows[notification.ID]
+		}
+		s.finish(ctx, notification, err)
+		return
+	}
+
+	notification.Deliveries = deliveries
+	if s.shouldRequeue(notification.Receivers, deliveries) {
+		// 供应商确认没有受理的接收者重新发送，发送时只会发给这些接收者
+		notification.ScheduleResend(time.Now())
+		if err = s.repo.Requeue(ctx, notification); err != nil {
+			s.log.Warn("发送超时的通知重新入队失败",
+				logger.Int64("notificationID", notification.ID),
+				logger.Error(err))
+		}
+		return
+	}
+	notification.Status = domain.DeliveriesSendStatus(deliveries)
+	s.finish(ctx, notification, nil)
+}
+
+// shouldRequeue 还有没被受理且没有达到提交次数上限的接收者
+func (s *SendingTimeoutTask) shouldRequeue(receivers []string, deliveries []domain.Delivery) bool {
+	attempts := make(map[string]int, len(deliveries))
+	for i := range deliveries {
+		attempts[domain.TrimPhonePrefix(deliveries[i].Receiver)] = deliveries[i].Attempts
+	}
+	for _, r := range domain.UnsubmittedReceivers(receivers, deliveries) {
+		if attempts[domain.TrimPhonePrefix(r)] < maxSendAttempts {
+			return true
+		}
+	}
+	return false
+}
+
+// finish 更新最终状态并回调，失败时写入死信，cause 为失败原因
+func (s *SendingTimeoutTask) finish(ctx context.Context, notification domain.Notification, cause error) {
+	var err error
+	if notification.Status == domain.SendStatusFailed {
+		err = s.repo.MarkFailed(domain.CtxWithTransitionError(ctx, cause), notification)
+	} else {
+		err = s.repo.MarkSuccess(ctx, notification)
+	}
+	if err != nil {
+		s.log.Warn("更新发送超时的通知状态失败",
+			logger.Int64("notificationID", notification.ID),
+			logger.Error(err))
+		return
+	}
+	if notification.Status == domain.SendStatusFailed {
+		if err = s.deadLetterRepo.Save(ctx, domain.NewDeadLetter(notification, cause)); err != nil {
+			s.log.Warn("写入死信失败",
+				logger.Int64("notificationID", notification.ID),
+				logger.Error(err))
+		}
+	}
+	_ = s.callbackSvc.SendCallbackByNotification(ctx, notification)
+}
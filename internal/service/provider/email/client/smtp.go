@@ -0,0 +1,192 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+var _ Client = (*SMTPClient)(nil)
+
+const defaultSMTPTimeout = 10 * time.Second
+
+// SMTPClient 基于 SMTP 协议的邮件客户端
+// 服务端支持时使用 STARTTLS 升级连接，配置了用户名时进行 PLAIN 认证
+type SMTPClient struct {
+	addr      string
+	host      string
+	username  string
+	password  string
+	tlsConfig *tls.Config
+	timeout   time.Duration
+}
+
+// NewSMTPClient 创建 SMTP 客户端
+// endpoint 为 host:port 格式，对应 providers 表中的 Endpoint
+// username 和 password 分别对应 APIKey 和 APISecret
+func NewSMTPClient(endpoint, username, password string) (*SMTPClient, error) {
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%w: SMTP地址非法: %w", ErrInvalidParameter, err)
+	}
+	return &SMTPClient{
+		addr:      endpoint,
+		host:      host,
+		username:  username,
+		password:  password,
+		tlsConfig: &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12},
+		timeout:   defaultSMTPTimeout,
+	}, nil
+}
+
+func (s *SMTPClient) Send(ctx context.Context, req SendReq) (SendResp, error) {
+	if len(req.To) == 0 {
+		return SendResp{}, fmt.Errorf("%w: 收件人不能为空", ErrInvalidParameter)
+	}
+	from, err := mail.ParseAddress(req.From)
+	if err != nil {
+		return SendResp{}, fmt.Errorf("%w: 发件人非法: %w", ErrInvalidParameter, err)
+	}
+
+	c, err := s.dial(ctx)
+	if err != nil {
+		return SendResp{}, fmt.Errorf("%w: %w", ErrSendFailed, err)
+	}
+	defer c.Close()
+
+	resp := SendResp{
+		MessageIDs: make(map[string]string, len(req.To)),
+		Failed:     make(map[string]error),
+	}
+	// 逐个收件人投递，单个收件人失败不影响其他收件人
+	var lastErr error
+	for _, to := range req.To {
+		messageID := s.newMessageID(req.IdempotencyKey, to)
+		msg, er := s.buildMessage(req, from, to, messageID)
+		if er != nil {
+			lastErr = fmt.Errorf("%w: 收件人 %s: %w", ErrInvalidParameter, to, er)
+			resp.Failed[to] = lastErr
+			continue
+		}
+		if er = s.deliver(c, from.Address, to, msg); er != nil {
+			lastErr = fmt.Errorf("%w: 收件人 %s: %w", ErrSendFailed, to, er)
+			resp.Failed[to] = lastErr
+			continue
+		}
+		resp.MessageIDs[to] = messageID
+	}
+	_ = c.Quit()
+	if len(resp.MessageIDs) == 0 {
+		return resp, lastErr
+	}
+	return resp, nil
+}
+
+// dial 建立连接，并按服务端能力完成 STARTTLS 和认证
+func (s *SMTPClient) dial(ctx context.Context) (*smtp.Client, error) {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return nil, err
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(s.timeout)
+	}
+	_ = conn.SetDeadline(deadline)
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err = c.StartTLS(s.tlsConfig); err != nil {
+			_ = c.Close()
+			return nil, err
+		}
+	}
+	if s.username != "" {
+		if ok, _ := c.Extension("AUTH"); ok {
+			if err = c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+				_ = c.Close()
+				return nil, err
+			}
+		}
+	}
+	return c, nil
+}
+
+func (s *SMTPClient) deliver(c *smtp.Client, from, to string, msg []byte) error {
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		// 重置会话，保证后续收件人可以继续投递
+		_ = c.Reset()
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		_ = c.Reset()
+		return err
+	}
+	if _, err = w.Write(msg); err != nil {
+		_ = w.Close()
+		_ = c.Reset()
+		return err
+	}
+	return w.Close()
+}
+
+func (s *SMTPClient) buildMessage(req SendReq, from *mail.Address, to, messageID string) ([]byte, error) {
+	contentType := "text/plain"
+	if req.HTML {
+		contentType = "text/html"
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from.String() + "\r\n")
+	buf.WriteString("To: " + to + "\r\n")
+	buf.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", req.Subject) + "\r\n")
+	buf.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("Message-ID: " + messageID + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: " + contentType + "; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buf.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(req.Body)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// newMessageID 有幂等标识时由标识和收件人生成固定的 Message-ID，否则随机生成
+func (s *SMTPClient) newMessageID(idempotencyKey, to string) string {
+	host := strings.Trim(s.host, "[]")
+	if idempotencyKey != "" {
+		const hashBytes = 8
+		sum := sha256.Sum256([]byte(strings.ToLower(to)))
+		return fmt.Sprintf("<%s.%s@%s>", idempotencyKey, hex.EncodeToString(sum[:hashBytes]), host)
+	}
+	const randomBytes = 8
+	b := make([]byte, randomBytes)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(b), host)
+}
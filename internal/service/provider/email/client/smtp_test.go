@@ -0,0 +1,320 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime/quotedprintable"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer 进程内的 SMTP 服务端替身，只实现测试需要的命令
+type fakeSMTPServer struct {
+	listener net.Listener
+	// 需要认证时的用户名密码，为空表示不支持 AUTH
+	username string
+	password string
+	// 会被拒绝的收件人
+	rejected map[string]bool
+
+	mu       sync.Mutex
+	authed   bool
+	messages []fakeMessage
+}
+
+type fakeMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+func newFakeSMTPServer(t *testing.T, username, password string, rejected ...string) *fakeSMTPServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeSMTPServer{
+		listener: l,
+		username: username,
+		password: password,
+		rejected: make(map[string]bool),
+	}
+	for _, r := range rejected {
+		s.rejected[r] = true
+	}
+	t.Cleanup(func() {
+		_ = l.Close()
+	})
+	go s.serve()
+	return s
+}
+
+func (s *fakeSMTPServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeSMTPServer) Messages() []fakeMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]fakeMessage(nil), s.messages...)
+}
+
+func (s *fakeSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost ESMTP fake")
+
+	var current fakeMessage
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			if s.username != "" {
+				_ = tp.PrintfLine("250-localhost")
+				_ = tp.PrintfLine("250 AUTH PLAIN")
+			} else {
+				_ = tp.PrintfLine("250 localhost")
+			}
+		case "AUTH":
+			s.handleAuth(tp, line)
+		case "MAIL":
+			if s.username != "" && !s.isAuthed() {
+				_ = tp.PrintfLine("530 authentication required")
+				continue
+			}
+			current = fakeMessage{from: s.extractAddr(line)}
+			_ = tp.PrintfLine("250 OK")
+		case "RCPT":
+			addr := s.extractAddr(line)
+			if s.rejected[addr] {
+				_ = tp.PrintfLine("550 mailbox unavailable")
+				continue
+			}
+			current.to = append(current.to, addr)
+			_ = tp.PrintfLine("250 OK")
+		case "DATA":
+			_ = tp.PrintfLine("354 end with <CRLF>.<CRLF>")
+			data, er := tp.ReadDotBytes()
+			if er != nil {
+				return
+			}
+			current.data = string(data)
+			s.mu.Lock()
+			s.messages = append(s.messages, current)
+			s.mu.Unlock()
+			current = fakeMessage{}
+			_ = tp.PrintfLine("250 OK queued")
+		case "RSET":
+			current = fakeMessage{}
+			_ = tp.PrintfLine("250 OK")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 command not implemented")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) handleAuth(tp *textproto.Conn, line string) {
+	parts := strings.Fields(line)
+	const authPlainParts = 3
+	if len(parts) != authPlainParts || !strings.EqualFold(parts[1], "PLAIN") {
+		_ = tp.PrintfLine("504 unsupported mechanism")
+		return
+	}
+	raw, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		_ = tp.PrintfLine("501 invalid encoding")
+		return
+	}
+	fields := strings.Split(string(raw), "\x00")
+	if len(fields) != authPlainParts || fields[1] != s.username || fields[2] != s.password {
+		_ = tp.PrintfLine("535 authentication failed")
+		return
+	}
+	s.mu.Lock()
+	s.authed = true
+	s.mu.Unlock()
+	_ = tp.PrintfLine("235 authenticated")
+}
+
+func (s *fakeSMTPServer) isAuthed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.authed
+}
+
+func (s *fakeSMTPServer) extractAddr(line string) string {
+	start := strings.Index(line, "<")
+	end := strings.Index(line, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
+func TestSMTPClient_Send(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		username   string
+		password   string
+		rejected   []string
+		req        SendReq
+		wantErr    error
+		wantTo     []string
+		wantFailed []string
+	}{
+		{
+			name:     "认证后逐个收件人投递",
+			username: "user",
+			password: "secret",
+			req: SendReq{
+				From:    "通知平台 <noreply@example.com>",
+				To:      []string{"a@example.com", "b@example.com"},
+				Subject: "验证码",
+				Body:    "<p>您的验证码是 1234</p>",
+				HTML:    true,
+			},
+			wantTo: []string{"a@example.com", "b@example.com"},
+		},
+		{
+			name: "服务端不需要认证",
+			req: SendReq{
+				From:    "noreply@example.com",
+				To:      []string{"a@example.com"},
+				Subject: "hello",
+				Body:    "plain text",
+			},
+			wantTo: []string{"a@example.com"},
+		},
+		{
+			name:     "部分收件人被拒绝时继续投递其他收件人",
+			rejected: []string{"bad@example.com"},
+			req: SendReq{
+				From:    "noreply@example.com",
+				To:      []string{"a@example.com", "bad@example.com", "b@example.com"},
+				Subject: "hello",
+				Body:    "plain text",
+			},
+			wantTo:     []string{"a@example.com", "b@example.com"},
+			wantFailed: []string{"bad@example.com"},
+		},
+		{
+			name:     "收件人被拒绝",
+			rejected: []string{"bad@example.com"},
+			req: SendReq{
+				From:    "noreply@example.com",
+				To:      []string{"bad@example.com"},
+				Subject: "hello",
+				Body:    "plain text",
+			},
+			wantErr: ErrSendFailed,
+		},
+		{
+			name: "收件人为空",
+			req: SendReq{
+				From: "noreply@example.com",
+			},
+			wantErr: ErrInvalidParameter,
+		},
+		{
+			name: "发件人非法",
+			req: SendReq{
+				From: "not an address",
+				To:   []string{"a@example.com"},
+			},
+			wantErr: ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			server := newFakeSMTPServer(t, tc.username, tc.password, tc.rejected...)
+			cli, err := NewSMTPClient(server.Addr(), tc.username, tc.password)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			resp, err := cli.Send(ctx, tc.req)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+
+			assert.Len(t, resp.MessageIDs, len(tc.wantTo))
+			assert.Len(t, resp.Failed, len(tc.wantFailed))
+			for _, to := range tc.wantFailed {
+				assert.ErrorIs(t, resp.Failed[to], ErrSendFailed)
+			}
+			msgs := server.Messages()
+			require.Len(t, msgs, len(tc.wantTo))
+			for i, msg := range msgs {
+				assert.Equal(t, []string{tc.wantTo[i]}, msg.to)
+				assert.Equal(t, "noreply@example.com", msg.from)
+				assert.Contains(t, msg.data, "Message-ID: "+resp.MessageIDs[tc.wantTo[i]])
+				assert.Contains(t, msg.data, "To: "+tc.wantTo[i])
+				if tc.req.HTML {
+					assert.Contains(t, msg.data, "Content-Type: text/html; charset=UTF-8")
+				} else {
+					assert.Contains(t, msg.data, "Content-Type: text/plain; charset=UTF-8")
+				}
+				// textproto 读取 DATA 时会把 CRLF 规整为 LF
+				_, body, found := strings.Cut(msg.data, "\n\n")
+				require.True(t, found)
+				decoded, er := io.ReadAll(quotedprintable.NewReader(strings.NewReader(body)))
+				require.NoError(t, er)
+				assert.Equal(t, tc.req.Body, strings.TrimRight(string(decoded), "\r\n"))
+			}
+		})
+	}
+}
+
+func TestNewSMTPClient(t *testing.T) {
+	t.Parallel()
+	_, err := NewSMTPClient("smtp.example.com", "u", "p")
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+
+	cli, err := NewSMTPClient("smtp.example.com:587", "u", "p")
+	require.NoError(t, err)
+	assert.Equal(t, "smtp.example.com", cli.host)
+}
+
+func TestSMTPClient_NewMessageID(t *testing.T) {
+	t.Parallel()
+	cli, err := NewSMTPClient("smtp.example.com:25", "", "")
+	require.NoError(t, err)
+
+	// 有幂等标识时重试生成相同的 Message-ID，不同收件人互不相同
+	id := cli.newMessageID("ntf-1", "a@example.com")
+	assert.Equal(t, id, cli.newMessageID("ntf-1", "a@example.com"))
+	assert.NotEqual(t, id, cli.newMessageID("ntf-1", "b@example.com"))
+	assert.True(t, strings.HasPrefix(id, "<ntf-1."))
+	assert.True(t, strings.HasSuffix(id, "@smtp.example.com>"))
+
+	assert.NotEqual(t, cli.newMessageID("", "a@example.com"), cli.newMessageID("", "a@example.com"))
+}
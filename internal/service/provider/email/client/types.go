@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"errors"
+)
+
+// 通用错误定义
+var (
+	ErrSendFailed       = errors.New("发送邮件失败")
+	ErrInvalidParameter = errors.New("参数无效")
+)
+
+// Client 邮件客户端接口（抽象）
+//
+//go:generate mockgen -source=./types.go -destination=./mocks/email.mock.go -package=emailmocks -typed Client
+type Client interface {
+	// Send 发送邮件，每个收件人单独投递一封，单个收件人失败不影响其他收件人
+	// 所有收件人都失败时返回错误
+	Send(ctx context.Context, req SendReq) (SendResp, error)
+}
+
+// SendReq 发送邮件请求参数
+type SendReq struct {
+	From    string   // 发件人，支持 "名称 <地址>" 格式
+	To      []string // 收件人地址
+	Subject string   // 邮件主题
+	Body    string   // 邮件正文
+	HTML    bool     // 正文是否为 HTML
+	// IdempotencyKey 幂等标识，用于生成固定的 Message-ID，重试时收件方可以据此去重
+	IdempotencyKey string
+}
+
+// SendResp 发送邮件响应参数
+type SendResp struct {
+	MessageIDs map[string]string // 投递成功的收件人 -> Message-ID
+	Failed     map[string]error  // 投递失败的收件人 -> 失败原因
+}
@@ -0,0 +1,81 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/provider"
+	"go-notification/internal/service/provider/email/client"
+	"go-notification/internal/service/template/manage"
+	"net/http"
+	"strings"
+)
+
+type emailProvider struct {
+	name        string
+	templateSvc manage.ChannelTemplateService
+	client      client.Client
+}
+
+func NewEmailProvider(name string, templateSvc manage.ChannelTemplateService, client client.Client) provider.Provider {
+	return &emailProvider{name: name, templateSvc: templateSvc, client: client}
+}
+
+func (e *emailProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	tmpl, err := e.templateSvc.GetTemplateVersionByProviderInfo(ctx, notification.Template.ID,
+		notification.Template.SendVersionID(), e.name, domain.ChannelEmail)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	activeVersion := tmpl.ActiveVersion()
+	if activeVersion == nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w: 无已发布模板", errs.ErrSendNotificationFailed, errs.ErrTemplateNotFound)
+	}
+
+	body := activeVersion.RenderContent(notification.Template.Params)
+	// 邮件渠道的签名即发件人地址
+	resp, err := e.client.Send(ctx, client.SendReq{
+		From:    activeVersion.Signature,
+		To:      notification.Receivers,
+		Subject: activeVersion.RenderSubject(notification.Template.Params),
+		Body:    body,
+		HTML:    strings.HasPrefix(http.DetectContentType([]byte(body)), "text/html"),
+
+		IdempotencyKey: notification.IdempotencyKey(),
+	})
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+	return e.buildResponse(notification, resp), nil
+}
+
+// buildResponse 按收件人返回投递结果，投递成功的收件人记录 Message-ID，重试时不再发送
+func (e *emailProvider) buildResponse(notification domain.Notification, resp client.SendResp) domain.SendResponse {
+	deliveries := make([]domain.Delivery, 0, len(notification.Receivers))
+	for _, receiver := range notification.Receivers {
+		d := domain.Delivery{
+			NotificationID: notification.ID,
+			BizID:          notification.BizID,
+			Receiver:       receiver,
+			Channel:        domain.ChannelEmail,
+			Provider:       e.name,
+		}
+		if messageID, ok := resp.MessageIDs[receiver]; ok {
+			d.Status = domain.DeliveryStatusSubmitted
+			d.SerialNo = messageID
+		} else {
+			d.Status = domain.DeliveryStatusSubmitFailed
+			if er := resp.Failed[receiver]; er != nil {
+				d.ErrMsg = er.Error()
+			}
+		}
+		deliveries = append(deliveries, d)
+	}
+	return domain.SendResponse{
+		NotificationID: notification.ID,
+		Status:         domain.DeliveriesSendStatus(deliveries),
+		Deliveries:     deliveries,
+	}
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "SEC-test"
+
+// dingTalkHandler 校验钉钉加签，code 为返回的 errcode
+func dingTalkHandler(t *testing.T, code int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		timestamp := r.URL.Query().Get("timestamp")
+		mac := hmac.New(sha256.New, []byte(testSecret))
+		mac.Write([]byte(timestamp + "\n" + testSecret))
+		if r.URL.Query().Get("sign") != base64.StdEncoding.EncodeToString(mac.Sum(nil)) {
+			code = 310000
+		}
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "markdown", body["msgtype"])
+		_, _ = fmt.Fprintf(w, `{"errcode":%d,"errmsg":"msg"}`, code)
+	}
+}
+
+// feishuHandler 校验飞书加签，code 为返回的 code
+func feishuHandler(t *testing.T, code int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		timestamp, _ := body["timestamp"].(string)
+		mac := hmac.New(sha256.New, []byte(timestamp+"\n"+testSecret))
+		if body["sign"] != base64.StdEncoding.EncodeToString(mac.Sum(nil)) {
+			code = 19021
+		}
+		assert.Equal(t, "interactive", body["msg_type"])
+		_, _ = fmt.Fprintf(w, `{"code":%d,"msg":"msg"}`, code)
+	}
+}
+
+func weComHandler(t *testing.T, code int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "markdown", body["msgtype"])
+		_, _ = fmt.Fprintf(w, `{"errcode":%d,"errmsg":"msg"}`, code)
+	}
+}
+
+func TestClient_Send(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		provider string
+		handler  http.HandlerFunc
+		wantErr  error
+	}{
+		{name: "钉钉加签成功", provider: DingTalk, handler: dingTalkHandler(t, 0)},
+		{name: "钉钉限流", provider: DingTalk, handler: dingTalkHandler(t, dingTalkCodeTooFast), wantErr: ErrRateLimited},
+		{name: "钉钉其他错误", provider: DingTalk, handler: dingTalkHandler(t, 300001), wantErr: ErrSendFailed},
+		{name: "飞书加签成功", provider: Feishu, handler: feishuHandler(t, 0)},
+		{name: "飞书限流", provider: Feishu, handler: feishuHandler(t, feishuCodeFrequencyLimited), wantErr: ErrRateLimited},
+		{name: "飞书其他错误", provider: Feishu, handler: feishuHandler(t, 19024), wantErr: ErrSendFailed},
+		{name: "企业微信成功", provider: WeCom, handler: weComHandler(t, 0)},
+		{name: "企业微信限流", provider: WeCom, handler: weComHandler(t, weComCodeFreqOutOfLimit), wantErr: ErrRateLimited},
+		{
+			name:     "HTTP 429 视为限流",
+			provider: WeCom,
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+			wantErr: ErrRateLimited,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+
+			cli, err := NewClient(tc.provider, server.URL+"/robot/send?access_token=token", testSecret, server.Client())
+			require.NoError(t, err)
+			err = cli.Send(context.Background(), Message{
+				Type:    MessageTypeMarkdown,
+				Title:   "告警",
+				Content: "**CPU** 使用率过高",
+				AtAll:   true,
+			})
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+	_, err := NewClient("unknown", "http://localhost", "", http.DefaultClient)
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+}
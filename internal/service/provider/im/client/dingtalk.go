@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// 钉钉自定义机器人错误码
+const (
+	dingTalkCodeOK = 0
+	// dingTalkCodeTooFast 发送速度太快而限流，每个机器人每分钟最多20条
+	dingTalkCodeTooFast = 130101
+)
+
+// DingTalkClient 钉钉自定义机器人
+type DingTalkClient struct {
+	webhook    string
+	secret     string
+	httpClient *http.Client
+}
+
+func NewDingTalkClient(webhook, secret string, httpClient *http.Client) *DingTalkClient {
+	return &DingTalkClient{webhook: webhook, secret: secret, httpClient: httpClient}
+}
+
+type dingTalkAt struct {
+	AtMobiles []string `json:"atMobiles,omitempty"`
+	AtUserIDs []string `json:"atUserIds,omitempty"`
+	IsAtAll   bool     `json:"isAtAll,omitempty"`
+}
+
+type dingTalkResp struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (c *DingTalkClient) Send(ctx context.Context, msg Message) error {
+	body := map[string]any{
+		"at": dingTalkAt{AtMobiles: msg.AtMobiles, AtUserIDs: msg.AtUserIDs, IsAtAll: msg.AtAll},
+	}
+	switch msg.Type {
+	case MessageTypeText:
+		body["msgtype"] = "text"
+		body["text"] = map[string]string{"content": msg.Content}
+	case MessageTypeMarkdown:
+		body["msgtype"] = "markdown"
+		body["markdown"] = map[string]string{"title": msg.Title, "text": msg.Content}
+	case MessageTypeCard:
+		body["msgtype"] = "actionCard"
+		body["actionCard"] = msg.Card
+	default:
+		return fmt.Errorf("%w: 消息类型 %s", ErrInvalidParameter, msg.Type)
+	}
+
+	target, err := c.signedURL(time.Now())
+	if err != nil {
+		return err
+	}
+	var resp dingTalkResp
+	if err = postJSON(ctx, c.httpClient, target, body, &resp); err != nil {
+		return err
+	}
+	switch resp.ErrCode {
+	case dingTalkCodeOK:
+		return nil
+	case dingTalkCodeTooFast:
+		return fmt.Errorf("%w: errcode=%d, errmsg=%s", ErrRateLimited, resp.ErrCode, resp.ErrMsg)
+	default:
+		return fmt.Errorf("%w: errcode=%d, errmsg=%s", ErrSendFailed, resp.ErrCode, resp.ErrMsg)
+	}
+}
+
+// signedURL 加签：sign = urlEncode(base64(HmacSHA256(secret, timestamp + "\n" + secret)))，时间戳为毫秒
+func (c *DingTalkClient) signedURL(now time.Time) (string, error) {
+	if c.secret == "" {
+		return c.webhook, nil
+	}
+	u, err := url.Parse(c.webhook)
+	if err != nil {
+		return "", fmt.Errorf("%w: webhook %s", ErrInvalidParameter, c.webhook)
+	}
+	timestamp := strconv.FormatInt(now.UnixMilli(), 10)
+	mac := hmac.New(sha256.New, []byte(c.secret))
+	mac.Write([]byte(timestamp + "\n" + c.secret))
+
+	query := u.Query()
+	query.Set("timestamp", timestamp)
+	query.Set("sign", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
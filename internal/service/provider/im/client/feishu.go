@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 飞书自定义机器人错误码
+const (
+	feishuCodeOK = 0
+	// feishuCodeFrequencyLimited 请求频率超限，每个机器人每分钟最多100次、每秒最多5次
+	feishuCodeFrequencyLimited = 11232
+	// feishuCodeTooManyRequest 租户或应用级别限流
+	feishuCodeTooManyRequest = 9499
+)
+
+// FeishuClient 飞书自定义机器人
+type FeishuClient struct {
+	webhook    string
+	secret     string
+	httpClient *http.Client
+}
+
+func NewFeishuClient(webhook, secret string, httpClient *http.Client) *FeishuClient {
+	return &FeishuClient{webhook: webhook, secret: secret, httpClient: httpClient}
+}
+
+type feishuResp struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (c *FeishuClient) Send(ctx context.Context, msg Message) error {
+	body := map[string]any{}
+	switch msg.Type {
+	case MessageTypeText:
+		body["msg_type"] = "text"
+		body["content"] = map[string]string{"text": c.mentions(msg) + msg.Content}
+	case MessageTypeMarkdown:
+		body["msg_type"] = "interactive"
+		body["card"] = map[string]any{
+			"header": map[string]any{
+				"title": map[string]string{"tag": "plain_text", "content": msg.Title},
+			},
+			"elements": []map[string]string{
+				{"tag": "markdown", "content": c.mentions(msg) + msg.Content},
+			},
+		}
+	case MessageTypeCard:
+		body["msg_type"] = "interactive"
+		body["card"] = msg.Card
+	default:
+		return fmt.Errorf("%w: 消息类型 %s", ErrInvalidParameter, msg.Type)
+	}
+
+	if c.secret != "" {
+		timestamp, sign := c.sign(time.Now())
+		body["timestamp"] = timestamp
+		body["sign"] = sign
+	}
+
+	var resp feishuResp
+	if err := postJSON(ctx, c.httpClient, c.webhook, body, &resp); err != nil {
+		return err
+	}
+	switch resp.Code {
+	case feishuCodeOK:
+		return nil
+	case feishuCodeFrequencyLimited, feishuCodeTooManyRequest:
+		return fmt.Errorf("%w: code=%d, msg=%s", ErrRateLimited, resp.Code, resp.Msg)
+	default:
+		return fmt.Errorf("%w: code=%d, msg=%s", ErrSendFailed, resp.Code, resp.Msg)
+	}
+}
+
+// sign 加签：以 timestamp + "\n" + secret 为密钥对空串做 HmacSHA256 后 base64，时间戳为秒
+func (c *FeishuClient) sign(now time.Time) (timestamp, sign string) {
+	timestamp = strconv.FormatInt(now.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(timestamp+"\n"+c.secret))
+	return timestamp, base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// mentions 飞书通过内容中的 at 标签提及成员，只支持 open_id/user_id
+func (c *FeishuClient) mentions(msg Message) string {
+	var sb strings.Builder
+	if msg.AtAll {
+		sb.WriteString(`<at user_id="all">所有人</at> `)
+	}
+	for _, id := range msg.AtUserIDs {
+		sb.WriteString(fmt.Sprintf(`<at user_id="%s"></at> `, id))
+	}
+	return sb.String()
+}
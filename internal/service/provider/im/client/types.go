@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// 通用错误定义
+var (
+	ErrSendFailed       = errors.New("发送群机器人消息失败")
+	ErrRateLimited      = errors.New("群机器人发送频率超限")
+	ErrInvalidParameter = errors.New("参数无效")
+)
+
+// MessageType 消息类型
+type MessageType string
+
+const (
+	MessageTypeText     MessageType = "text"
+	MessageTypeMarkdown MessageType = "markdown"
+	MessageTypeCard     MessageType = "card"
+)
+
+// Message 与厂商无关的群机器人消息
+type Message struct {
+	Type    MessageType
+	Title   string // 标题，markdown 消息使用
+	Content string // 文本或 markdown 内容
+	// Card 卡片消息体，原样作为各厂商的卡片字段下发
+	Card json.RawMessage
+
+	AtAll     bool
+	AtMobiles []string
+	AtUserIDs []string
+}
+
+// Client 群机器人客户端
+//
+//go:generate mockgen -source=./types.go -destination=./mocks/im.mock.go -package=immocks -typed Client
+type Client interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+const (
+	DingTalk = "dingtalk"
+	Feishu   = "feishu"
+	WeCom    = "wecom"
+)
+
+// NewClient 根据供应商名称创建客户端，webhook 为机器人地址，secret 为加签密钥
+func NewClient(name, webhook, secret string, httpClient *http.Client) (Client, error) {
+	switch name {
+	case DingTalk:
+		return NewDingTalkClient(webhook, secret, httpClient), nil
+	case Feishu:
+		return NewFeishuClient(webhook, secret, httpClient), nil
+	case WeCom:
+		return NewWeComClient(webhook, httpClient), nil
+	default:
+		return nil, fmt.Errorf("%w: 不支持的群机器人供应商 %s", ErrInvalidParameter, name)
+	}
+}
+
+// postJSON 发送请求并解析响应体，HTTP 429 视为限流
+func postJSON(ctx context.Context, httpClient *http.Client, url string, body any, resp any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	httpResp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSendFailed, err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("%w: HTTP %d", ErrRateLimited, httpResp.StatusCode)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: HTTP %d", ErrSendFailed, httpResp.StatusCode)
+	}
+	const maxBodyBytes = 1 << 20
+	if err = json.NewDecoder(io.LimitReader(httpResp.Body, maxBodyBytes)).Decode(resp); err != nil {
+		return fmt.Errorf("%w: 解析响应失败: %w", ErrSendFailed, err)
+	}
+	return nil
+}
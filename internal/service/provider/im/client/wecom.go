@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// 企业微信群机器人错误码
+const (
+	weComCodeOK = 0
+	// weComCodeFreqOutOfLimit 接口调用频率超限，每个机器人每分钟最多20条
+	weComCodeFreqOutOfLimit = 45009
+)
+
+// WeComClient 企业微信群机器人，没有加签机制，webhook 地址中的 key 即凭证
+type WeComClient struct {
+	webhook    string
+	httpClient *http.Client
+}
+
+func NewWeComClient(webhook string, httpClient *http.Client) *WeComClient {
+	return &WeComClient{webhook: webhook, httpClient: httpClient}
+}
+
+type weComResp struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (c *WeComClient) Send(ctx context.Context, msg Message) error {
+	body := map[string]any{}
+	switch msg.Type {
+	case MessageTypeText:
+		mentioned := msg.AtUserIDs
+		if msg.AtAll {
+			mentioned = append([]string{"@all"}, mentioned...)
+		}
+		body["msgtype"] = "text"
+		body["text"] = map[string]any{
+			"content":               msg.Content,
+			"mentioned_list":        mentioned,
+			"mentioned_mobile_list": msg.AtMobiles,
+		}
+	case MessageTypeMarkdown:
+		// markdown 消息不支持 mentioned_list，只能在内容中使用 <@userid>
+		var sb strings.Builder
+		if msg.Title != "" {
+			sb.WriteString("# " + msg.Title + "\n")
+		}
+		sb.WriteString(msg.Content)
+		for _, id := range msg.AtUserIDs {
+			sb.WriteString(fmt.Sprintf("\n<@%s>", id))
+		}
+		body["msgtype"] = "markdown"
+		body["markdown"] = map[string]string{"content": sb.String()}
+	case MessageTypeCard:
+		body["msgtype"] = "template_card"
+		body["template_card"] = msg.Card
+	default:
+		return fmt.Errorf("%w: 消息类型 %s", ErrInvalidParameter, msg.Type)
+	}
+
+	var resp weComResp
+	if err := postJSON(ctx, c.httpClient, c.webhook, body, &resp); err != nil {
+		return err
+	}
+	switch resp.ErrCode {
+	case weComCodeOK:
+		return nil
+	case weComCodeFreqOutOfLimit:
+		return fmt.Errorf("%w: errcode=%d, errmsg=%s", ErrRateLimited, resp.ErrCode, resp.ErrMsg)
+	default:
+		return fmt.Errorf("%w: errcode=%d, errmsg=%s", ErrSendFailed, resp.ErrCode, resp.ErrMsg)
+	}
+}
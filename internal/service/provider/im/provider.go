@@ -0,0 +1,87 @@
+package im
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/provider"
+	"go-notification/internal/service/provider/im/client"
+	"go-notification/internal/service/template/manage"
+	"regexp"
+	"strings"
+)
+
+// receiverAll 接收者为 all 时提及所有人
+const receiverAll = "all"
+
+var mobilePattern = regexp.MustCompile(`^\+?\d{6,15}$`)
+
+type imProvider struct {
+	name        string
+	templateSvc manage.ChannelTemplateService
+	client      client.Client
+}
+
+func NewIMProvider(name string, templateSvc manage.ChannelTemplateService, client client.Client) provider.Provider {
+	return &imProvider{name: name, templateSvc: templateSvc, client: client}
+}
+
+// Send 群由机器人地址决定，接收者只用于提及：all 提及所有人，手机号按手机号提及，其余按用户ID提及
+func (p *imProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	tmpl, err := p.templateSvc.GetTemplateVersionByProviderInfo(ctx, notification.Template.ID,
+		notification.Template.SendVersionID(), p.name, domain.ChannelIM)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	activeVersion := tmpl.ActiveVersion()
+	if activeVersion == nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w: 无已发布模板", errs.ErrSendNotificationFailed, errs.ErrTemplateNotFound)
+	}
+
+	msg := p.buildMessage(activeVersion, notification)
+	if err = p.client.Send(ctx, msg); err != nil {
+		if errors.Is(err, client.ErrRateLimited) {
+			return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrProviderRateLimited, err)
+		}
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	return domain.SendResponse{
+		NotificationID: notification.ID,
+		Status:         domain.SendStatusSucceeded,
+	}, nil
+}
+
+// buildMessage 模板内容是 JSON 对象时作为卡片消息，有主题时作为 markdown 消息，否则为文本消息
+func (p *imProvider) buildMessage(version *domain.ChannelTemplateVersion, notification domain.Notification) client.Message {
+	content := version.RenderContent(notification.Template.Params)
+	msg := client.Message{
+		Type:    client.MessageTypeText,
+		Title:   version.RenderSubject(notification.Template.Params),
+		Content: content,
+	}
+	trimmed := strings.TrimSpace(content)
+	switch {
+	case strings.HasPrefix(trimmed, "{") && json.Valid([]byte(trimmed)):
+		msg.Type = client.MessageTypeCard
+		msg.Card = json.RawMessage(trimmed)
+	case msg.Title != "":
+		msg.Type = client.MessageTypeMarkdown
+	}
+
+	for _, receiver := range notification.Receivers {
+		switch {
+		case strings.EqualFold(receiver, receiverAll):
+			msg.AtAll = true
+		case mobilePattern.MatchString(receiver):
+			msg.AtMobiles = append(msg.AtMobiles, receiver)
+		default:
+			msg.AtUserIDs = append(msg.AtUserIDs, receiver)
+		}
+	}
+	return msg
+}
@@ -0,0 +1,56 @@
+package inapp
+
+import (
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/inbox"
+	"go-notification/internal/service/provider"
+	"go-notification/internal/service/template/manage"
+)
+
+// inboxProvider 站内信供应商，由平台自身承担，渲染后直接写入收件箱
+type inboxProvider struct {
+	name        string
+	templateSvc manage.ChannelTemplateService
+	inboxSvc    inbox.Service
+}
+
+func NewInboxProvider(name string, templateSvc manage.ChannelTemplateService, inboxSvc inbox.Service) provider.Provider {
+	return &inboxProvider{name: name, templateSvc: templateSvc, inboxSvc: inboxSvc}
+}
+
+func (p *inboxProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	tmpl, err := p.templateSvc.GetTemplateVersionByProviderInfo(ctx, notification.Template.ID,
+		notification.Template.SendVersionID(), p.name, domain.ChannelInApp)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	activeVersion := tmpl.ActiveVersion()
+	if activeVersion == nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w: 无已发布模板", errs.ErrSendNotificationFailed, errs.ErrTemplateNotFound)
+	}
+
+	title := activeVersion.RenderSubject(notification.Template.Params)
+	content := activeVersion.RenderContent(notification.Template.Params)
+	messages := make([]domain.InboxMessage, 0, len(notification.Receivers))
+	for _, receiver := range notification.Receivers {
+		messages = append(messages, domain.InboxMessage{
+			BizID:          notification.BizID,
+			NotificationID: notification.ID,
+			Receiver:       receiver,
+			Title:          title,
+			Content:        content,
+		})
+	}
+	if err = p.inboxSvc.Deliver(ctx, messages); err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	return domain.SendResponse{
+		NotificationID: notification.ID,
+		Status:         domain.SendStatusSucceeded,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package limit
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"time"
+)
+
+const (
+	resultAllowed       = 0
+	resultQPSExhausted  = 1
+	resultDailyExceeded = 2
+
+	// dailyKeySlack 当日计数在日切后多保留一段时间，避免边界处的请求拿到已过期的键
+	dailyKeySlack = time.Hour
+)
+
+//go:embed lua/limit.lua
+var limitScript string
+
+// Limiter 供应商发送限制
+//
+//go:generate mockgen -source=./limiter.go -destination=./mocks/limiter.mock.go -package=limitmocks -typed Limiter
+type Limiter interface {
+	// Acquire 获取一次发送许可，超过每秒限制返回 errs.ErrProviderRateLimited，超过每日限制返回 errs.ErrProviderDailyLimitExceeded
+	Acquire(ctx context.Context, provider domain.Provider) error
+}
+
+// RedisLimiter 基于 Redis 的集群级限制：每秒请求数用令牌桶，每日请求数用按日切分的计数器
+type RedisLimiter struct {
+	client redis.Cmdable
+	// loc 供应商的日切时区，国内云厂商按北京时间零点重置
+	loc *time.Location
+	now func() time.Time
+}
+
+func NewRedisLimiter(client redis.Cmdable, loc *time.Location) *RedisLimiter {
+	if loc == nil {
+		loc = time.FixedZone("CST", 8*60*60)
+	}
+	return &RedisLimiter{client: client, loc: loc, now: time.Now}
+}
+
+func (l *RedisLimiter) Acquire(ctx context.Context, provider domain.Provider) error {
+	now := l.now().In(l.loc)
+	year, month, day := now.Date()
+	nextDay := time.Date(year, month, day+1, 0, 0, 0, 0, l.loc)
+	ttl := int64((nextDay.Sub(now) + dailyKeySlack) / time.Second)
+
+	res, err := l.client.Eval(ctx, limitScript,
+		[]string{l.qpsKey(provider), l.dailyKey(provider, now)},
+		provider.QPSLimit, provider.QPSLimit, provider.DailyLimit, ttl,
+	).Int()
+	if err != nil {
+		return err
+	}
+	switch res {
+	case resultAllowed:
+		return nil
+	case resultQPSExhausted:
+		return fmt.Errorf("%w: 供应商 %s 超过每秒 %d 次限制", errs.ErrProviderRateLimited, provider.Name, provider.QPSLimit)
+	case resultDailyExceeded:
+		return fmt.Errorf("%w: 供应商 %s 超过每日 %d 次限制", errs.ErrProviderDailyLimitExceeded, provider.Name, provider.DailyLimit)
+	default:
+		return fmt.Errorf("未知的限流结果: %d", res)
+	}
+}
+
+// qpsKey 两个键使用相同的 hash tag，保证在 Redis 集群中落在同一个槽
+func (l *RedisLimiter) qpsKey(provider domain.Provider) string {
+	return fmt.Sprintf("provider:limit:{%s:%s}:qps", provider.Channel, provider.Name)
+}
+
+func (l *RedisLimiter) dailyKey(provider domain.Provider, now time.Time) string {
+	return fmt.Sprintf("provider:limit:{%s:%s}:daily:%s", provider.Channel, provider.Name, now.Format("20060102"))
+}
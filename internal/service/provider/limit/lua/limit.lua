@@ -0,0 +1,42 @@
+-- KEYS[1] 令牌桶，KEYS[2] 当日计数
+-- ARGV[1] 每秒生成令牌数，ARGV[2] 桶容量，ARGV[3] 每日上限，ARGV[4] 当日计数过期时间（秒）
+-- 返回 0 放行，1 QPS 超限，2 每日上限
+local rate = tonumber(ARGV[1])
+local capacity = tonumber(ARGV[2])
+local dailyLimit = tonumber(ARGV[3])
+
+if dailyLimit > 0 then
+    local used = tonumber(redis.call('GET', KEYS[2]) or '0')
+    if used >= dailyLimit then
+        return 2
+    end
+end
+
+if rate > 0 then
+    -- 使用 Redis 时间，避免各节点时钟不一致
+    local t = redis.call('TIME')
+    local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+    local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+    local tokens = tonumber(bucket[1])
+    local ts = tonumber(bucket[2])
+    if tokens == nil or ts == nil then
+        tokens = capacity
+        ts = now
+    end
+    tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate / 1000)
+    local ttl = math.ceil(capacity / rate * 1000) + 1000
+    if tokens < 1 then
+        redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+        redis.call('PEXPIRE', KEYS[1], ttl)
+        return 1
+    end
+    redis.call('HSET', KEYS[1], 'tokens', tostring(tokens - 1), 'ts', now)
+    redis.call('PEXPIRE', KEYS[1], ttl)
+end
+
+if dailyLimit > 0 then
+    if redis.call('INCR', KEYS[2]) == 1 then
+        redis.call('EXPIRE', KEYS[2], ARGV[4])
+    end
+end
+return 0
@@ -0,0 +1,67 @@
+package limit
+
+import (
+	"context"
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/service/provider"
+)
+
+const (
+	limitTypeQPS   = "qps"
+	limitTypeDaily = "daily"
+)
+
+var exhaustedCounter = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "provider_limit_exhausted_total",
+		Help: "供应商因每秒或每日限制被跳过的次数",
+	},
+	[]string{"provider", "channel", "limit"},
+)
+
+// Provider 为供应商添加每秒和每日发送限制的装饰器
+// 超限时直接返回错误而不调用供应商，渠道会据此换下一个供应商
+type Provider struct {
+	provider provider.Provider
+	info     domain.Provider
+	limiter  Limiter
+	logger   logger.Logger
+}
+
+func NewProvider(info domain.Provider, provider provider.Provider, limiter Limiter, logger logger.Logger) *Provider {
+	return &Provider{
+		provider: provider,
+		info:     info,
+		limiter:  limiter,
+		logger:   logger,
+	}
+}
+
+func (p *Provider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	err := p.limiter.Acquire(ctx, p.info)
+	switch {
+	case err == nil:
+	case errors.Is(err, errs.ErrProviderRateLimited):
+		exhaustedCounter.WithLabelValues(p.info.Name, p.info.Channel.String(), limitTypeQPS).Inc()
+		return domain.SendResponse{}, err
+	case errors.Is(err, errs.ErrProviderDailyLimitExceeded):
+		exhaustedCounter.WithLabelValues(p.info.Name, p.info.Channel.String(), limitTypeDaily).Inc()
+		return domain.SendResponse{}, err
+	default:
+		// 限流组件故障时放行，避免影响正常发送
+		p.logger.Warn("获取供应商发送许可失败",
+			logger.String("provider", p.info.Name),
+			logger.Error(err))
+	}
+	return p.provider.Send(ctx, notification)
+}
+
+// Reconcile 核对只是查询，不占用发送配额
+func (p *Provider) Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error) {
+	return provider.Reconcile(ctx, p.provider, notification)
+}
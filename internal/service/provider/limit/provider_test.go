@@ -0,0 +1,108 @@
+package limit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeLimiter struct {
+	err error
+}
+
+func (f *fakeLimiter) Acquire(_ context.Context, _ domain.Provider) error {
+	return f.err
+}
+
+type countingProvider struct {
+	calls int
+}
+
+func (p *countingProvider) Send(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+	p.calls++
+	return domain.SendResponse{NotificationID: n.ID, Status: domain.SendStatusSucceeded}, nil
+}
+
+func TestProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		limitErr  error
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "放行", wantCalls: 1},
+		{name: "QPS超限", limitErr: fmt.Errorf("%w: mock", errs.ErrProviderRateLimited), wantErr: errs.ErrProviderRateLimited},
+		{name: "每日上限", limitErr: fmt.Errorf("%w: mock", errs.ErrProviderDailyLimitExceeded), wantErr: errs.ErrProviderDailyLimitExceeded},
+		{name: "限流组件故障时放行", limitErr: errors.New("redis down"), wantCalls: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			inner := &countingProvider{}
+			p := NewProvider(domain.Provider{Name: "aliyun", Channel: domain.ChannelSMS}, inner, &fakeLimiter{err: tc.limitErr}, logger.NewNopLogger())
+			_, err := p.Send(context.Background(), domain.Notification{ID: 1})
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantCalls, inner.calls)
+		})
+	}
+}
+
+type fakeRedis struct {
+	redis.Cmdable
+	res  int64
+	keys []string
+	args []any
+}
+
+func (f *fakeRedis) Eval(ctx context.Context, _ string, keys []string, args ...any) *redis.Cmd {
+	f.keys, f.args = keys, args
+	cmd := redis.NewCmd(ctx)
+	cmd.SetVal(f.res)
+	return cmd
+}
+
+func TestRedisLimiter_Acquire(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		res     int64
+		wantErr error
+	}{
+		{name: "放行", res: resultAllowed},
+		{name: "QPS超限", res: resultQPSExhausted, wantErr: errs.ErrProviderRateLimited},
+		{name: "每日上限", res: resultDailyExceeded, wantErr: errs.ErrProviderDailyLimitExceeded},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &fakeRedis{res: tc.res}
+			l := NewRedisLimiter(client, nil)
+			// 北京时间 2025-05-01 23:00，距离日切 1 小时
+			l.now = func() time.Time { return time.Date(2025, 5, 1, 15, 0, 0, 0, time.UTC) }
+
+			err := l.Acquire(context.Background(), domain.Provider{Name: "aliyun", Channel: domain.ChannelSMS, QPSLimit: 10, DailyLimit: 1000})
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, []string{
+				"provider:limit:{SMS:aliyun}:qps",
+				"provider:limit:{SMS:aliyun}:daily:20250501",
+			}, client.keys)
+			assert.Equal(t, []any{10, 10, 1000, int64(2 * 60 * 60)}, client.args)
+		})
+	}
+}
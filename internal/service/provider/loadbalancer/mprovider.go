@@ -2,7 +2,9 @@ package loadbalancer
 
 import (
 	"context"
+	"errors"
 	"go-notification/internal/domain"
+	"go-notification/internal/errs"
 	"go-notification/internal/service/provider"
 	"math/bits"
 	"sync"
@@ -16,7 +18,7 @@ const (
 
 	bitsPeruint6       = 64
 	bitsPerUint64Shift = 6
-	btiMask            = 64
+	btiMask            = 63
 	initialHealth      = true
 	recoverSecond      = 3
 )
@@ -49,6 +51,10 @@ func newMprovider(provider provider.Provider, bufferLen int) *mprovider {
 
 func (m *mprovider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	res, err := m.Provider.Send(ctx, notification)
+	if errors.Is(err, errs.ErrProviderRateLimited) || errors.Is(err, errs.ErrProviderDailyLimitExceeded) {
+		// 限流说明供应商本身正常，不计入健康统计
+		return res, err
+	}
 	if err != nil {
 		m.markFailed()
 		v := m.getFailed()
@@ -69,6 +75,11 @@ func (m *mprovider) Send(ctx context.Context, notification domain.Notification)
 	return res, err
 }
 
+// Reconcile 核对不影响健康统计
+func (m *mprovider) Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error) {
+	return provider.Reconcile(ctx, m.Provider, notification)
+}
+
 func (m *mprovider) markFailed() {
 	count := atomic.AddUint64(&m.reqCount, 1)
 	count %= m.bitCnt
@@ -94,7 +105,8 @@ func (m *mprovider) markSuccess() {
 	idx := count >> bitsPerUint64Shift
 	bitPos := count & btiMask
 	old := atomic.LoadUint64(&m.ringBuffer[idx])
-	atomic.StoreUint64(&m.ringBuffer[idx], old|(uint64(1)<<bitPos))
+	// &^ (uint64(1)<<bitPos) 将目标位清零
+	atomic.StoreUint64(&m.ringBuffer[idx], old&^(uint64(1)<<bitPos))
 }
 
 func (m *mprovider) isHealthy() bool {
@@ -0,0 +1,175 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/provider"
+	"go-notification/internal/service/provider/manage"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRefreshInterval = time.Minute
+	defaultRetryInterval   = 5 * time.Second
+	defaultLoadTimeout     = 3 * time.Second
+)
+
+var (
+	_ provider.SelectorBuilder = (*WeightedSelectorBuilder)(nil)
+	_ provider.Selector        = (*weightedSelector)(nil)
+)
+
+// weightedNode 平滑加权轮询中的一个节点
+type weightedNode struct {
+	name          string
+	provider      *mprovider
+	weight        int
+	currentWeight int
+}
+
+// WeightedSelectorBuilder 按供应商权重做平滑加权轮询的选择器构造器
+// 供应商列表及权重来自 manage.Service，定期刷新；未激活的供应商会被跳过
+// 轮询状态和健康状态在多次 Build 之间共享，每次 Build 得到的选择器在一次发送内不会重复返回同一个供应商
+type WeightedSelectorBuilder struct {
+	channel         domain.Channel
+	providerSvc     manage.Service
+	providers       map[string]provider.Provider // 供应商名称 -> 供应商实现
+	bufferLen       int
+	refreshInterval time.Duration
+
+	mu         sync.Mutex
+	nodes      []*weightedNode
+	mps        map[string]*mprovider // 刷新时复用，保留健康状态
+	nextLoadAt time.Time             // 下一次刷新的时间，加载失败后按 defaultRetryInterval 重试
+}
+
+// NewWeightedSelectorBuilder providers 为已构造好的供应商实现，按名称与数据库中的供应商配置对应
+func NewWeightedSelectorBuilder(
+	channel domain.Channel,
+	providerSvc manage.Service,
+	providers map[string]provider.Provider,
+	bufferLen int,
+	refreshInterval time.Duration,
+) *WeightedSelectorBuilder {
+	if bufferLen <= 0 {
+		bufferLen = 10
+	}
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+	return &WeightedSelectorBuilder{
+		channel:         channel,
+		providerSvc:     providerSvc,
+		providers:       providers,
+		bufferLen:       bufferLen,
+		refreshInterval: refreshInterval,
+		mps:             make(map[string]*mprovider, len(providers)),
+	}
+}
+
+func (b *WeightedSelectorBuilder) Build() (provider.Selector, error) {
+	var loadErr error
+	if b.claimReload(time.Now()) {
+		loadErr = b.reload()
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.nodes == nil {
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		return nil, fmt.Errorf("%w: 供应商列表尚未加载", errs.ErrNoAvailableProvider)
+	}
+	return &weightedSelector{builder: b, tried: make(map[string]struct{}, len(b.nodes))}, nil
+}
+
+// claimReload 到了刷新时间时返回 true，由调用方刷新，刷新期间其他调用方沿用旧的列表
+func (b *WeightedSelectorBuilder) claimReload(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if now.Before(b.nextLoadAt) {
+		return false
+	}
+	b.nextLoadAt = now.Add(b.refreshInterval)
+	return true
+}
+
+// reload 不持有锁查询供应商配置，再加锁替换节点列表，失败时沿用旧的列表并稍后重试
+func (b *WeightedSelectorBuilder) reload() error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultLoadTimeout)
+	defer cancel()
+	list, err := b.providerSvc.GetByChannel(ctx, b.channel)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err != nil {
+		b.nextLoadAt = time.Now().Add(defaultRetryInterval)
+		return err
+	}
+
+	old := make(map[string]*weightedNode, len(b.nodes))
+	for _, n := range b.nodes {
+		old[n.name] = n
+	}
+	nodes := make([]*weightedNode, 0, len(list))
+	for i := range list {
+		p, ok := b.providers[list[i].Name]
+		if !ok || list[i].Status == domain.ProviderStatusInactive || list[i].Weight <= 0 {
+			continue
+		}
+		mp, ok := b.mps[list[i].Name]
+		if !ok {
+			mp = newMprovider(p, b.bufferLen)
+			b.mps[list[i].Name] = mp
+		}
+		node := &weightedNode{name: list[i].Name, provider: mp, weight: list[i].Weight}
+		if o, ok := old[node.name]; ok {
+			node.currentWeight = o.currentWeight
+		}
+		nodes = append(nodes, node)
+	}
+	b.nodes = nodes
+	return nil
+}
+
+// pick 在健康且未尝试过的节点中按平滑加权轮询选出一个
+func (b *WeightedSelectorBuilder) pick(tried map[string]struct{}) *weightedNode {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var (
+		best  *weightedNode
+		total int
+	)
+	for _, n := range b.nodes {
+		if _, ok := tried[n.name]; ok || !n.provider.isHealthy() {
+			continue
+		}
+		n.currentWeight += n.weight
+		total += n.weight
+		if best == nil || n.currentWeight > best.currentWeight {
+			best = n
+		}
+	}
+	if best != nil {
+		best.currentWeight -= total
+	}
+	return best
+}
+
+// weightedSelector 一次发送内使用的选择器，失败后换下一个供应商
+type weightedSelector struct {
+	builder *WeightedSelectorBuilder
+	tried   map[string]struct{}
+}
+
+func (s *weightedSelector) Next(_ context.Context, _ domain.Notification) (provider.Provider, error) {
+	node := s.builder.pick(s.tried)
+	if node == nil {
+		return nil, fmt.Errorf("%w", errs.ErrNoAvailableProvider)
+	}
+	s.tried[node.name] = struct{}{}
+	return node.provider, nil
+}
@@ -0,0 +1,206 @@
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/provider"
+	"go-notification/internal/service/provider/manage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProviderService struct {
+	manage.Service
+	providers []domain.Provider
+	err       error
+	calls     int
+	// block 不为空时查询会等待 block 关闭
+	block chan struct{}
+}
+
+func (f *fakeProviderService) GetByChannel(_ context.Context, _ domain.Channel) ([]domain.Provider, error) {
+	f.calls++
+	if f.block != nil {
+		<-f.block
+	}
+	return f.providers, f.err
+}
+
+type namedProvider struct {
+	name string
+	err  error
+}
+
+func (p *namedProvider) Send(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+	if p.err != nil {
+		return domain.SendResponse{}, p.err
+	}
+	return domain.SendResponse{NotificationID: n.ID, Status: domain.SendStatusSucceeded}, nil
+}
+
+func TestWeightedSelectorBuilder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		providers []domain.Provider
+		rounds    int
+		want      map[string]int
+	}{
+		{
+			name: "按80/20分配",
+			providers: []domain.Provider{
+				{Name: "aliyun", Weight: 4, Status: domain.ProviderStatusActive},
+				{Name: "tencent", Weight: 1, Status: domain.ProviderStatusActive},
+			},
+			rounds: 100,
+			want:   map[string]int{"aliyun": 80, "tencent": 20},
+		},
+		{
+			name: "跳过未激活的供应商",
+			providers: []domain.Provider{
+				{Name: "aliyun", Weight: 4, Status: domain.ProviderStatusActive},
+				{Name: "tencent", Weight: 1, Status: domain.ProviderStatusInactive},
+			},
+			rounds: 10,
+			want:   map[string]int{"aliyun": 10},
+		},
+		{
+			name: "跳过没有实现的供应商",
+			providers: []domain.Provider{
+				{Name: "unknown", Weight: 10, Status: domain.ProviderStatusActive},
+				{Name: "tencent", Weight: 1, Status: domain.ProviderStatusActive},
+			},
+			rounds: 10,
+			want:   map[string]int{"tencent": 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			impls := map[string]provider.Provider{
+				"aliyun":  &namedProvider{name: "aliyun"},
+				"tencent": &namedProvider{name: "tencent"},
+			}
+			builder := NewWeightedSelectorBuilder(domain.ChannelSMS, &fakeProviderService{providers: tc.providers}, impls, 0, 0)
+
+			got := make(map[string]int)
+			for i := 0; i < tc.rounds; i++ {
+				s, err := builder.Build()
+				require.NoError(t, err)
+				p, err := s.Next(context.Background(), domain.Notification{})
+				require.NoError(t, err)
+				got[p.(*mprovider).Provider.(*namedProvider).name]++
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWeightedSelector_Next(t *testing.T) {
+	t.Parallel()
+
+	svc := &fakeProviderService{providers: []domain.Provider{
+		{Name: "aliyun", Weight: 4, Status: domain.ProviderStatusActive},
+		{Name: "tencent", Weight: 1, Status: domain.ProviderStatusActive},
+	}}
+	impls := map[string]provider.Provider{
+		"aliyun":  &namedProvider{name: "aliyun", err: errors.New("mock error")},
+		"tencent": &namedProvider{name: "tencent"},
+	}
+	builder := NewWeightedSelectorBuilder(domain.ChannelSMS, svc, impls, 1, 0)
+
+	// 一次发送内依次返回不同的供应商，全部尝试过后返回错误
+	s, err := builder.Build()
+	require.NoError(t, err)
+	first, err := s.Next(context.Background(), domain.Notification{})
+	require.NoError(t, err)
+	second, err := s.Next(context.Background(), domain.Notification{})
+	require.NoError(t, err)
+	assert.NotSame(t, first, second)
+	_, err = s.Next(context.Background(), domain.Notification{})
+	assert.ErrorIs(t, err, errs.ErrNoAvailableProvider)
+	assert.Equal(t, 1, svc.calls)
+
+	// 持续失败的供应商被标记为不健康后不再被选中
+	aliyun := builder.mps["aliyun"]
+	for aliyun.isHealthy() {
+		_, _ = aliyun.Send(context.Background(), domain.Notification{})
+	}
+	for i := 0; i < 10; i++ {
+		s, err = builder.Build()
+		require.NoError(t, err)
+		p, err := s.Next(context.Background(), domain.Notification{})
+		require.NoError(t, err)
+		assert.Equal(t, "tencent", p.(*mprovider).Provider.(*namedProvider).name)
+	}
+}
+
+func TestWeightedSelectorBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	svc := &fakeProviderService{err: errors.New("db error")}
+	builder := NewWeightedSelectorBuilder(domain.ChannelSMS, svc, map[string]provider.Provider{}, 0, 0)
+	_, err := builder.Build()
+	assert.Error(t, err)
+	// 加载失败后等待重试间隔，不会每次构造都查询数据库
+	_, err = builder.Build()
+	assert.ErrorIs(t, err, errs.ErrNoAvailableProvider)
+	assert.Equal(t, 1, svc.calls)
+
+	// 首次加载成功后，刷新失败沿用旧列表
+	svc.err = nil
+	svc.providers = []domain.Provider{{Name: "aliyun", Weight: 1, Status: domain.ProviderStatusActive}}
+	builder.providers["aliyun"] = &namedProvider{name: "aliyun"}
+	builder.nextLoadAt = time.Time{}
+	_, err = builder.Build()
+	require.NoError(t, err)
+	svc.err = errors.New("db error")
+	builder.nextLoadAt = time.Time{}
+	s, err := builder.Build()
+	require.NoError(t, err)
+	_, err = s.Next(context.Background(), domain.Notification{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, svc.calls)
+}
+
+func TestWeightedSelectorBuilder_BuildWhileReloading(t *testing.T) {
+	t.Parallel()
+
+	svc := &fakeProviderService{providers: []domain.Provider{{Name: "aliyun", Weight: 1, Status: domain.ProviderStatusActive}}}
+	builder := NewWeightedSelectorBuilder(domain.ChannelSMS, svc,
+		map[string]provider.Provider{"aliyun": &namedProvider{name: "aliyun"}}, 0, 0)
+	_, err := builder.Build()
+	require.NoError(t, err)
+
+	// 刷新时查询数据库不持有锁，其他发送沿用旧列表，不被阻塞
+	svc.block = make(chan struct{})
+	builder.nextLoadAt = time.Time{}
+	reloaded := make(chan struct{})
+	go func() {
+		defer close(reloaded)
+		_, _ = builder.Build()
+	}()
+	require.Eventually(t, func() bool {
+		builder.mu.Lock()
+		defer builder.mu.Unlock()
+		return !builder.nextLoadAt.IsZero()
+	}, time.Second, time.Millisecond)
+
+	s, err := builder.Build()
+	require.NoError(t, err)
+	_, err = s.Next(context.Background(), domain.Notification{})
+	assert.NoError(t, err)
+
+	close(svc.block)
+	<-reloaded
+	assert.Equal(t, 2, svc.calls)
+}
@@ -109,3 +109,8 @@ func (p Provider) Send(ctx context.Context, notification domain.Notification) (d
 
 	return resp, err
 }
+
+// Reconcile 核对不计入发送指标
+func (p Provider) Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error) {
+	return provider.Reconcile(ctx, p.provider, notification)
+}
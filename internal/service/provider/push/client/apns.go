@@ -0,0 +1,146 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"github.com/golang-jwt/jwt/v4"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// apnsTokenTTL 苹果要求鉴权令牌至少20分钟、至多60分钟刷新一次
+	apnsTokenTTL = 50 * time.Minute
+	apnsMaxBody  = 4 << 10
+)
+
+// APNsClient 基于 HTTP/2 和 JWT 鉴权的 APNs 客户端
+type APNsClient struct {
+	endpoint   string
+	teamID     string
+	keyID      string
+	topic      string
+	key        *ecdsa.PrivateKey
+	httpClient *http.Client
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+// NewAPNsClient privateKeyPEM 为苹果开发者后台下载的 .p8 私钥内容，topic 一般为应用的 Bundle ID
+func NewAPNsClient(endpoint, teamID, keyID, topic, privateKeyPEM string, httpClient *http.Client) (*APNsClient, error) {
+	key, err := jwt.ParseECPrivateKeyFromPEM([]byte(privateKeyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("%w: 解析APNs私钥失败: %w", ErrInvalidParameter, err)
+	}
+	return &APNsClient{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		teamID:     teamID,
+		keyID:      keyID,
+		topic:      topic,
+		key:        key,
+		httpClient: httpClient,
+	}, nil
+}
+
+type apnsAlert struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+type apnsErrorResp struct {
+	Reason string `json:"reason"`
+}
+
+func (c *APNsClient) Send(ctx context.Context, msg Message) (string, error) {
+	if msg.Token == "" {
+		return "", fmt.Errorf("%w: 设备令牌为空", ErrInvalidParameter)
+	}
+	// 自定义数据与 aps 同级
+	payload := make(map[string]any, len(msg.Data)+1)
+	for k, v := range msg.Data {
+		payload[k] = v
+	}
+	payload["aps"] = map[string]any{
+		"alert": apnsAlert{Title: msg.Title, Body: msg.Body},
+		"sound": "default",
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+
+	token, err := c.authToken(time.Now())
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/3/device/"+msg.Token, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+	req.Header.Set("authorization", "bearer "+token)
+	req.Header.Set("apns-topic", c.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("content-type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrSendFailed, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		// apns-id 由 APNs 生成，可以用来排查推送记录
+		return resp.Header.Get("apns-id"), nil
+	}
+
+	var errResp apnsErrorResp
+	_ = json.NewDecoder(io.LimitReader(resp.Body, apnsMaxBody)).Decode(&errResp)
+	return "", c.toError(resp.StatusCode, errResp.Reason)
+}
+
+func (c *APNsClient) toError(status int, reason string) error {
+	switch {
+	case status == http.StatusGone,
+		reason == "BadDeviceToken",
+		reason == "DeviceTokenNotForTopic",
+		reason == "Unregistered":
+		return fmt.Errorf("%w: status=%d, reason=%s", ErrInvalidToken, status, reason)
+	case status == http.StatusTooManyRequests:
+		return fmt.Errorf("%w: status=%d, reason=%s", ErrRateLimited, status, reason)
+	case status == http.StatusForbidden:
+		// 令牌过期或签名错误，清掉缓存下次重新签发
+		c.mu.Lock()
+		c.token = ""
+		c.mu.Unlock()
+		return fmt.Errorf("%w: status=%d, reason=%s", ErrAuthFailed, status, reason)
+	default:
+		return fmt.Errorf("%w: status=%d, reason=%s", ErrSendFailed, status, reason)
+	}
+}
+
+// authToken 获取 ES256 签名的鉴权令牌，有效期内复用
+func (c *APNsClient) authToken(now time.Time) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && now.Sub(c.issuedAt) < apnsTokenTTL {
+		return c.token, nil
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": c.teamID,
+		"iat": now.Unix(),
+	})
+	t.Header["kid"] = c.keyID
+	signed, err := t.SignedString(c.key)
+	if err != nil {
+		return "", fmt.Errorf("%w: 签发APNs令牌失败: %w", ErrAuthFailed, err)
+	}
+	c.token, c.issuedAt = signed, now
+	return signed, nil
+}
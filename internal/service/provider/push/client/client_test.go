@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHTTP2Server 启动只接受 HTTP/2 请求的本地 TLS 服务
+func newHTTP2Server(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			w.WriteHeader(http.StatusHTTPVersionNotSupported)
+			return
+		}
+		handler(w, r)
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func ecKeyPEM(t *testing.T) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func rsaKeyPEM(t *testing.T) (*rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func TestAPNsClient_Send(t *testing.T) {
+	t.Parallel()
+
+	key, keyPEM := ecKeyPEM(t)
+
+	testCases := []struct {
+		name    string
+		token   string
+		status  int
+		reason  string
+		wantErr error
+	}{
+		{name: "推送成功", token: "device-ok", status: http.StatusOK},
+		{name: "令牌已注销", token: "device-gone", status: http.StatusGone, reason: "Unregistered", wantErr: ErrInvalidToken},
+		{name: "令牌格式错误", token: "device-bad", status: http.StatusBadRequest, reason: "BadDeviceToken", wantErr: ErrInvalidToken},
+		{name: "频率超限", token: "device-busy", status: http.StatusTooManyRequests, reason: "TooManyRequests", wantErr: ErrRateLimited},
+		{name: "其他错误", token: "device-err", status: http.StatusBadRequest, reason: "PayloadTooLarge", wantErr: ErrSendFailed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newHTTP2Server(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/3/device/"+tc.token, r.URL.Path)
+				assert.Equal(t, "com.example.app", r.Header.Get("apns-topic"))
+				assert.Equal(t, "alert", r.Header.Get("apns-push-type"))
+
+				raw := strings.TrimPrefix(r.Header.Get("authorization"), "bearer ")
+				parsed, err := jwt.Parse(raw, func(token *jwt.Token) (any, error) {
+					assert.Equal(t, "KEY123", token.Header["kid"])
+					return &key.PublicKey, nil
+				}, jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}))
+				assert.NoError(t, err)
+				assert.Equal(t, "TEAM123", parsed.Claims.(jwt.MapClaims)["iss"])
+
+				var body map[string]any
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				alert := body["aps"].(map[string]any)["alert"].(map[string]any)
+				assert.Equal(t, "标题", alert["title"])
+				assert.Equal(t, "正文", alert["body"])
+				assert.Equal(t, "123", body["orderId"])
+
+				if tc.status == http.StatusOK {
+					w.Header().Set("apns-id", "apns-1")
+				}
+				w.WriteHeader(tc.status)
+				if tc.reason != "" {
+					_, _ = fmt.Fprintf(w, `{"reason":%q}`, tc.reason)
+				}
+			})
+
+			c, err := NewAPNsClient(server.URL, "TEAM123", "KEY123", "com.example.app", keyPEM, server.Client())
+			require.NoError(t, err)
+
+			messageID, err := c.Send(context.Background(), Message{
+				Token: tc.token,
+				Title: "标题",
+				Body:  "正文",
+				Data:  map[string]string{"orderId": "123"},
+			})
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err == nil {
+				assert.Equal(t, "apns-1", messageID)
+			}
+		})
+	}
+}
+
+func TestFCMClient_Send(t *testing.T) {
+	t.Parallel()
+
+	key, keyPEM := rsaKeyPEM(t)
+
+	testCases := []struct {
+		name    string
+		token   string
+		status  int
+		code    string
+		wantErr error
+	}{
+		{name: "推送成功", token: "device-ok", status: http.StatusOK},
+		{name: "令牌已注销", token: "device-gone", status: http.StatusNotFound, code: "UNREGISTERED", wantErr: ErrInvalidToken},
+		{name: "频率超限", token: "device-busy", status: http.StatusTooManyRequests, code: "QUOTA_EXCEEDED", wantErr: ErrRateLimited},
+		{name: "其他错误", token: "device-err", status: http.StatusInternalServerError, code: "INTERNAL", wantErr: ErrSendFailed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tokenRequests int
+			var server *httptest.Server
+			server = newHTTP2Server(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/token" {
+					tokenRequests++
+					assert.NoError(t, r.ParseForm())
+					assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+					parsed, err := jwt.Parse(r.PostForm.Get("assertion"), func(*jwt.Token) (any, error) {
+						return &key.PublicKey, nil
+					}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}))
+					assert.NoError(t, err)
+					claims := parsed.Claims.(jwt.MapClaims)
+					assert.Equal(t, "sa@example.iam.gserviceaccount.com", claims["iss"])
+					assert.Equal(t, server.URL+"/token", claims["aud"])
+					_, _ = fmt.Fprint(w, `{"access_token":"access-1","expires_in":3600}`)
+					return
+				}
+
+				assert.Equal(t, "/v1/projects/demo/messages:send", r.URL.Path)
+				assert.Equal(t, "Bearer access-1", r.Header.Get("Authorization"))
+				var body struct {
+					Message struct {
+						Token        string            `json:"token"`
+						Notification map[string]string `json:"notification"`
+						Data         map[string]string `json:"data"`
+					} `json:"message"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tc.token, body.Message.Token)
+				assert.Equal(t, "标题", body.Message.Notification["title"])
+				assert.Equal(t, "正文", body.Message.Notification["body"])
+				assert.Equal(t, "123", body.Message.Data["orderId"])
+
+				w.WriteHeader(tc.status)
+				if tc.code != "" {
+					_, _ = fmt.Fprintf(w, `{"error":{"code":%d,"status":"ERR","details":[{"errorCode":%q}]}}`, tc.status, tc.code)
+					return
+				}
+				_, _ = fmt.Fprint(w, `{"name":"projects/demo/messages/1"}`)
+			})
+
+			c, err := NewFCMClient(server.URL, "demo", "sa@example.iam.gserviceaccount.com", keyPEM, server.URL+"/token", server.Client())
+			require.NoError(t, err)
+
+			// 发两次，验证访问令牌被复用
+			for i := 0; i < 2; i++ {
+				messageID, er := c.Send(context.Background(), Message{
+					Token: tc.token,
+					Title: "标题",
+					Body:  "正文",
+					Data:  map[string]string{"orderId": "123"},
+				})
+				assert.ErrorIs(t, er, tc.wantErr)
+				if er == nil {
+					assert.Equal(t, "projects/demo/messages/1", messageID)
+				}
+			}
+			assert.Equal(t, 1, tokenRequests)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"github.com/golang-jwt/jwt/v4"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultFCMTokenURL Google OAuth2 令牌地址
+	DefaultFCMTokenURL = "https://oauth2.googleapis.com/token"
+	fcmScope           = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmAssertionTTL    = time.Hour
+	// fcmTokenLeeway 提前刷新访问令牌，避免请求途中过期
+	fcmTokenLeeway = time.Minute
+	fcmMaxBody     = 16 << 10
+)
+
+// FCMClient 基于 HTTP v1 API 的 FCM 客户端，使用服务账号签发 JWT 换取访问令牌
+type FCMClient struct {
+	endpoint    string
+	projectID   string
+	clientEmail string
+	tokenURL    string
+	key         *rsa.PrivateKey
+	httpClient  *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewFCMClient clientEmail 和 privateKeyPEM 来自服务账号 JSON 中的 client_email 和 private_key
+func NewFCMClient(endpoint, projectID, clientEmail, privateKeyPEM, tokenURL string, httpClient *http.Client) (*FCMClient, error) {
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(privateKeyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("%w: 解析FCM私钥失败: %w", ErrInvalidParameter, err)
+	}
+	if tokenURL == "" {
+		tokenURL = DefaultFCMTokenURL
+	}
+	return &FCMClient{
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		projectID:   projectID,
+		clientEmail: clientEmail,
+		tokenURL:    tokenURL,
+		key:         key,
+		httpClient:  httpClient,
+	}, nil
+}
+
+// fcmSendResp 推送成功时返回的消息名，格式为 projects/<projectID>/messages/<messageID>
+type fcmSendResp struct {
+	Name string `json:"name"`
+}
+
+type fcmErrorResp struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// errorCode FCM 的具体错误码在 details 中
+func (r fcmErrorResp) errorCode() string {
+	for _, d := range r.Error.Details {
+		if d.ErrorCode != "" {
+			return d.ErrorCode
+		}
+	}
+	return r.Error.Status
+}
+
+func (c *FCMClient) Send(ctx context.Context, msg Message) (string, error) {
+	if msg.Token == "" {
+		return "", fmt.Errorf("%w: 设备令牌为空", ErrInvalidParameter)
+	}
+	body, err := json.Marshal(map[string]any{
+		"message": map[string]any{
+			"token": msg.Token,
+			"notification": map[string]string{
+				"title": msg.Title,
+				"body":  msg.Body,
+			},
+			"data": msg.Data,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+
+	accessToken, err := c.token(ctx)
+	if err != nil {
+		return "", err
+	}
+	target := fmt.Sprintf("%s/v1/projects/%s/messages:send", c.endpoint, c.projectID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrSendFailed, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		var sendResp fcmSendResp
+		_ = json.NewDecoder(io.LimitReader(resp.Body, fcmMaxBody)).Decode(&sendResp)
+		return sendResp.Name, nil
+	}
+
+	var errResp fcmErrorResp
+	_ = json.NewDecoder(io.LimitReader(resp.Body, fcmMaxBody)).Decode(&errResp)
+	code := errResp.errorCode()
+	switch {
+	case code == "UNREGISTERED" || resp.StatusCode == http.StatusNotFound:
+		return "", fmt.Errorf("%w: status=%d, code=%s", ErrInvalidToken, resp.StatusCode, code)
+	case code == "QUOTA_EXCEEDED" || resp.StatusCode == http.StatusTooManyRequests:
+		return "", fmt.Errorf("%w: status=%d, code=%s", ErrRateLimited, resp.StatusCode, code)
+	case resp.StatusCode == http.StatusUnauthorized:
+		c.mu.Lock()
+		c.accessToken = ""
+		c.mu.Unlock()
+		return "", fmt.Errorf("%w: status=%d, code=%s", ErrAuthFailed, resp.StatusCode, code)
+	default:
+		return "", fmt.Errorf("%w: status=%d, code=%s, message=%s", ErrSendFailed, resp.StatusCode, code, errResp.Error.Message)
+	}
+}
+
+// token 获取访问令牌，有效期内复用
+func (c *FCMClient) token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if c.accessToken != "" && now.Add(fcmTokenLeeway).Before(c.expiresAt) {
+		return c.accessToken, nil
+	}
+
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   c.clientEmail,
+		"scope": fcmScope,
+		"aud":   c.tokenURL,
+		"iat":   now.Unix(),
+		"exp":   now.Add(fcmAssertionTTL).Unix(),
+	}).SignedString(c.key)
+	if err != nil {
+		return "", fmt.Errorf("%w: 签发FCM断言失败: %w", ErrAuthFailed, err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrAuthFailed, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrAuthFailed, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%w: 获取访问令牌 status=%d", ErrAuthFailed, resp.StatusCode)
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, fcmMaxBody)).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("%w: 解析访问令牌失败: %w", ErrAuthFailed, err)
+	}
+	c.accessToken = tokenResp.AccessToken
+	c.expiresAt = now.Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	return c.accessToken, nil
+}
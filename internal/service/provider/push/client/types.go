@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"errors"
+)
+
+// 通用错误定义
+var (
+	ErrSendFailed       = errors.New("推送失败")
+	ErrInvalidToken     = errors.New("设备令牌无效或已注销")
+	ErrRateLimited      = errors.New("推送频率超限")
+	ErrInvalidParameter = errors.New("参数无效")
+	ErrAuthFailed       = errors.New("推送鉴权失败")
+)
+
+// Message 推送到单个设备的消息
+type Message struct {
+	Token string            // 设备令牌
+	Title string            // 标题
+	Body  string            // 正文
+	Data  map[string]string // 透传给应用的自定义数据
+}
+
+// Client 推送客户端
+//
+//go:generate mockgen -source=./types.go -destination=./mocks/push.mock.go -package=pushmocks -typed Client
+type Client interface {
+	// Send 推送单条消息，返回推送服务生成的消息ID，令牌无效时返回 ErrInvalidToken
+	Send(ctx context.Context, msg Message) (string, error)
+}
@@ -0,0 +1,109 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/provider"
+	"go-notification/internal/service/provider/push/client"
+	"go-notification/internal/service/template/manage"
+)
+
+type pushProvider struct {
+	name        string
+	templateSvc manage.ChannelTemplateService
+	client      client.Client
+	tokenRepo   repository.PushTokenRepository
+	logger      logger.Logger
+}
+
+func NewPushProvider(name string, templateSvc manage.ChannelTemplateService, client client.Client, tokenRepo repository.PushTokenRepository, logger logger.Logger) provider.Provider {
+	return &pushProvider{
+		name:        name,
+		templateSvc: templateSvc,
+		client:      client,
+		tokenRepo:   tokenRepo,
+		logger:      logger,
+	}
+}
+
+// Send 接收者为设备令牌，模板主题作为标题、内容作为正文，模板参数作为透传数据
+// 无效令牌记录下来供业务方清理，按设备返回推送结果，全部设备失败才返回错误
+func (p *pushProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	tmpl, err := p.templateSvc.GetTemplateVersionByProviderInfo(ctx, notification.Template.ID,
+		notification.Template.SendVersionID(), p.name, domain.ChannelPush)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	activeVersion := tmpl.ActiveVersion()
+	if activeVersion == nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w: 无已发布模板", errs.ErrSendNotificationFailed, errs.ErrTemplateNotFound)
+	}
+
+	title := activeVersion.RenderSubject(notification.Template.Params)
+	body := activeVersion.RenderContent(notification.Template.Params)
+
+	var (
+		lastErr error
+		invalid []domain.InvalidPushToken
+	)
+	deliveries := make([]domain.Delivery, 0, len(notification.Receivers))
+	for _, token := range notification.Receivers {
+		d := domain.Delivery{
+			NotificationID: notification.ID,
+			BizID:          notification.BizID,
+			Receiver:       token,
+			Channel:        domain.ChannelPush,
+			Provider:       p.name,
+			Status:         domain.DeliveryStatusSubmitted,
+		}
+		d.SerialNo, err = p.client.Send(ctx, client.Message{
+			Token: token,
+			Title: title,
+			Body:  body,
+			Data:  notification.Template.Params,
+		})
+		if err != nil {
+			lastErr = err
+			d.Status = domain.DeliveryStatusSubmitFailed
+			d.ErrMsg = err.Error()
+		}
+		if errors.Is(err, client.ErrInvalidToken) {
+			invalid = append(invalid, domain.InvalidPushToken{
+				BizID:    notification.BizID,
+				Provider: p.name,
+				Token:    token,
+				Reason:   err.Error(),
+			})
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if len(invalid) > 0 {
+		if err1 := p.tokenRepo.MarkInvalid(ctx, invalid); err1 != nil {
+			p.logger.Warn("记录无效推送令牌失败",
+				logger.String("provider", p.name),
+				logger.Int64("bizID", notification.BizID),
+				logger.Error(err1))
+		}
+	}
+
+	status := domain.DeliveriesSendStatus(deliveries)
+	if status == domain.SendStatusFailed {
+		if errors.Is(lastErr, client.ErrRateLimited) {
+			return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrProviderRateLimited, lastErr)
+		}
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, lastErr)
+	}
+
+	return domain.SendResponse{
+		NotificationID: notification.ID,
+		Status:         status,
+		Deliveries:     deliveries,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package record
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/provider"
+)
+
+var _ provider.Reconciler = (*Provider)(nil)
+
+// Provider 在调用供应商之前记录接收者使用的供应商的装饰器
+// 服务在发送过程中崩溃时，超时核对任务据此找到应该向哪个供应商查询发送结果
+type Provider struct {
+	name         string
+	provider     provider.Provider
+	deliveryRepo repository.DeliveryRepository
+	logger       logger.Logger
+}
+
+func NewProvider(name string, provider provider.Provider, deliveryRepo repository.DeliveryRepository, logger logger.Logger) *Provider {
+	return &Provider{
+		name:         name,
+		provider:     provider,
+		deliveryRepo: deliveryRepo,
+		logger:       logger,
+	}
+}
+
+func (p *Provider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	err := p.deliveryRepo.RecordProvider(ctx, notification.ID, notification.Receivers, p.name)
+	if err != nil {
+		// 记录失败只影响超时核对，不影响正常发送
+		p.logger.Warn("记录接收者使用的供应商失败",
+			logger.Int64("notificationID", notification.ID),
+			logger.String("provider", p.name),
+			logger.Error(err))
+	}
+	return p.provider.Send(ctx, notification)
+}
+
+func (p *Provider) Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error) {
+	return provider.Reconcile(ctx, p.provider, notification)
+}
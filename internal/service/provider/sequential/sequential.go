@@ -19,7 +19,7 @@ type selector struct {
 	providers []provider.Provider
 }
 
-func (s selector) Next(_ context.Context, _ domain.Notification) (provider.Provider, error) {
+func (s *selector) Next(_ context.Context, _ domain.Notification) (provider.Provider, error) {
 	if len(s.providers) == s.idx {
 		return nil, fmt.Errorf("%w", errs.ErrNoAvailableProvider)
 	}
@@ -6,6 +6,7 @@ import (
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	dysmsapi "github.com/alibabacloud-go/dysmsapi-20170525/v5/client"
 	"github.com/alibabacloud-go/tea/tea"
+	"strconv"
 	"strings"
 )
 
@@ -166,6 +167,9 @@ func (a *AliyunSMS) Send(req SendReq) (SendResp, error) {
 		TemplateCode:  tea.String(req.TemplateID),
 		TemplateParam: tea.String(templateParam),
 	}
+	if req.OutID != "" {
+		request.OutId = tea.String(req.OutID)
+	}
 
 	response, err := a.client.SendSms(request)
 	if err != nil {
@@ -188,13 +192,122 @@ func (a *AliyunSMS) Send(req SendReq) (SendResp, error) {
 		// 去丢可能的+86前缀
 		cleanPhone := strings.TrimPrefix(phone, "+86")
 		result.PhoneNumbers[cleanPhone] = SendRespStatus{
-			Code:    *response.Body.Code,
-			Message: *response.Body.Message,
+			Code:     *response.Body.Code,
+			Message:  *response.Body.Message,
+			SerialNo: tea.StringValue(response.Body.BizId),
+			Reject:   aliyunRejectReasons[*response.Body.Code],
+		}
+	}
+	return result, nil
+}
+
+// aliyunSendStatus 阿里云回执状态，1:等待回执，2:发送失败，3:发送成功
+var aliyunSendStatus = map[int64]SendStatus{
+	1: SendStatusWaiting,
+	2: SendStatusFailed,
+	3: SendStatusSuccess,
+}
+
+// aliyunRejectReasons 阿里云错误码 -> 拒绝原因
+var aliyunRejectReasons = map[string]RejectReason{
+	"isv.MOBILE_NUMBER_ILLEGAL":   RejectReasonInvalidPhone,
+	"isv.BLACK_KEY_CONTROL_LIMIT": RejectReasonInvalidPhone,
+	"isv.SMS_TEMPLATE_ILLEGAL":    RejectReasonTemplate,
+	"isv.SMS_SIGNATURE_ILLEGAL":   RejectReasonTemplate,
+}
+
+func (a *AliyunSMS) QuerySendDetails(req QuerySendDetailReq) (QuerySendDetailResp, error) {
+	// https://help.aliyun.com/zh/sms/developer-reference/api-dysmsapi-2017-05-25-querysenddetails
+	if req.PhoneNumber == "" || req.SendDate == "" {
+		return QuerySendDetailResp{}, fmt.Errorf("%w: 手机号和发送日期不能为空", ErrInvalidParameter)
+	}
+	request := &dysmsapi.QuerySendDetailsRequest{
+		PhoneNumber: tea.String(strings.TrimPrefix(req.PhoneNumber, "+86")),
+		SendDate:    tea.String(req.SendDate),
+		PageSize:    tea.Int64(int64(req.PageSize)),
+		CurrentPage: tea.Int64(int64(req.CurrentPage)),
+	}
+	if req.BizID != "" {
+		request.BizId = tea.String(req.BizID)
+	}
+
+	response, err := a.client.QuerySendDetails(request)
+	if err != nil {
+		return QuerySendDetailResp{}, fmt.Errorf("%w: %w", ErrQuerySendDetails, err)
+	}
+	if response.Body == nil || response.Body.Code == nil || !strings.EqualFold(*response.Body.Code, OK) {
+		return QuerySendDetailResp{}, fmt.Errorf("%w: %v", ErrQuerySendDetails, "响应异常")
+	}
+
+	total, _ := strconv.Atoi(tea.StringValue(response.Body.TotalCount))
+	result := QuerySendDetailResp{
+		RequestID:  tea.StringValue(response.Body.RequestId),
+		TotalCount: total,
+	}
+	if response.Body.SmsSendDetailDTOs == nil {
+		return result, nil
+	}
+	for _, dto := range response.Body.SmsSendDetailDTOs.SmsSendDetailDTO {
+		status, ok := aliyunSendStatus[tea.Int64Value(dto.SendStatus)]
+		if !ok {
+			status = SendStatusWaiting
 		}
+		result.SmsSendDetailDTOs = append(result.SmsSendDetailDTOs, SendDetail{
+			PhoneNum:     strings.TrimPrefix(tea.StringValue(dto.PhoneNum), "+86"),
+			SendStatus:   status,
+			Content:      tea.StringValue(dto.Content),
+			TemplateCode: tea.StringValue(dto.TemplateCode),
+			SendDate:     tea.StringValue(dto.SendDate),
+			ReceiveDate:  tea.StringValue(dto.ReceiveDate),
+			ErrCode:      tea.StringValue(dto.ErrCode),
+			OutID:        tea.StringValue(dto.OutId),
+			BizID:        req.BizID,
+		})
 	}
 	return result, nil
 }
 
+// aliyunReport 阿里云短信状态报告（SmsReport）推送的单条回执
+type aliyunReport struct {
+	PhoneNumber string `json:"phone_number"`
+	SendTime    string `json:"send_time"`
+	ReportTime  string `json:"report_time"`
+	Success     bool   `json:"success"`
+	ErrCode     string `json:"err_code"`
+	ErrMsg      string `json:"err_msg"`
+	BizID       string `json:"biz_id"`
+	OutID       string `json:"out_id"`
+}
+
+// aliyunReportAck 阿里云要求返回 code 为 0 表示接收成功，否则会重推
+var aliyunReportAck = []byte(`{"code":0,"msg":"成功"}`)
+
+func (a *AliyunSMS) ParseReports(body []byte) ([]SendDetail, []byte, error) {
+	// https://help.aliyun.com/zh/sms/developer-reference/configure-delivery-receipts-1
+	var reports []aliyunReport
+	if err := json.Unmarshal(body, &reports); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+	details := make([]SendDetail, 0, len(reports))
+	for _, r := range reports {
+		status := SendStatusFailed
+		if r.Success {
+			status = SendStatusSuccess
+		}
+		details = append(details, SendDetail{
+			PhoneNum:    strings.TrimPrefix(r.PhoneNumber, "+86"),
+			SendStatus:  status,
+			Description: r.ErrMsg,
+			SendDate:    r.SendTime,
+			ReceiveDate: r.ReportTime,
+			ErrCode:     r.ErrCode,
+			OutID:       r.OutID,
+			BizID:       r.BizID,
+		})
+	}
+	return details, aliyunReportAck, nil
+}
+
 func (a *AliyunSMS) handleResponse(response *dysmsapi.QuerySmsTemplateListResponse, requestIdMap map[string]bool, results map[string]QueryTemplateStatusResp) bool {
 	var needStop bool
 	for _, template := range response.Body.SmsTemplateList {
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
@@ -141,6 +142,12 @@ func (t TencentCloudSMS) Send(req SendReq) (SendResp, error) {
 		request.TemplateParamSet = templateParamPtrs
 	}
 
+	// 用户的 session 内容，会原样出现在回执和发送状态查询中，用作幂等标识
+	// ExtendCode 是短信码号扩展号，只能是数字且需要单独开通，不适合作为幂等标识
+	if req.OutID != "" {
+		request.SessionContext = &req.OutID
+	}
+
 	response, err := t.client.SendSms(request)
 	if err != nil {
 		return SendResp{}, fmt.Errorf("%w: %w", ErrSendFailed, err)
@@ -159,9 +166,132 @@ func (t TencentCloudSMS) Send(req SendReq) (SendResp, error) {
 	for i := range response.Response.SendStatusSet {
 		status := response.Response.SendStatusSet[i]
 		result.PhoneNumbers[strings.TrimPrefix(*status.PhoneNumber, "+86")] = SendRespStatus{
-			Code:    *status.Code,
-			Message: *status.Message,
+			Code:     *status.Code,
+			Message:  *status.Message,
+			SerialNo: stringValue(status.SerialNo),
+			Reject:   tencentRejectReasons[*status.Code],
+		}
+	}
+	return result, nil
+}
+
+// tencentReportStatus 腾讯云回执状态，SUCCESS:用户接收成功，FAIL:用户接收失败
+func tencentReportStatus(reportStatus string) SendStatus {
+	switch strings.ToUpper(reportStatus) {
+	case "SUCCESS":
+		return SendStatusSuccess
+	case "FAIL":
+		return SendStatusFailed
+	default:
+		return SendStatusWaiting
+	}
+}
+
+func (t TencentCloudSMS) QuerySendDetails(req QuerySendDetailReq) (QuerySendDetailResp, error) {
+	// https://cloud.tencent.com/document/api/382/55985
+	if req.PhoneNumber == "" || req.BeginTime <= 0 {
+		return QuerySendDetailResp{}, fmt.Errorf("%w: 手机号和起始时间不能为空", ErrInvalidParameter)
+	}
+	phoneNumber := req.PhoneNumber
+	if !strings.HasPrefix(phoneNumber, "+") {
+		phoneNumber = "+86" + phoneNumber
+	}
+	beginTime := uint64(req.BeginTime)
+	request := sms.NewPullSmsSendStatusByPhoneNumberRequest()
+	request.SmsSdkAppId = t.appID
+	request.PhoneNumber = &phoneNumber
+	request.BeginTime = &beginTime
+	request.Offset = &req.Offset
+	request.Limit = &req.Limit
+	if req.EndTime > 0 {
+		endTime := uint64(req.EndTime)
+		request.EndTime = &endTime
+	}
+
+	response, err := t.client.PullSmsSendStatusByPhoneNumber(request)
+	if err != nil {
+		return QuerySendDetailResp{}, fmt.Errorf("%w: %w", ErrQuerySendDetails, err)
+	}
+
+	result := QuerySendDetailResp{
+		RequestID: stringValue(response.Response.RequestId),
+	}
+	for _, status := range response.Response.PullSmsSendStatusSet {
+		if status == nil {
+			continue
+		}
+		sendStatus := tencentReportStatus(stringValue(status.ReportStatus))
+		detail := SendDetail{
+			PhoneNum:     strings.TrimPrefix(stringValue(status.PhoneNumber), "+86"),
+			SendStatus:   sendStatus,
+			Description:  stringValue(status.Description),
+			SerialNo:     stringValue(status.SerialNo),
+			OutID:        stringValue(status.SessionContext),
+			ReportStatus: int(sendStatus),
 		}
+		if status.UserReceiveTime != nil {
+			detail.UserReceiveTime = strconv.FormatUint(*status.UserReceiveTime, 10)
+		}
+		result.SmsSendDetailDTOs = append(result.SmsSendDetailDTOs, detail)
 	}
+	result.TotalCount = len(result.SmsSendDetailDTOs)
 	return result, nil
 }
+
+// tencentReport 腾讯云短信下发状态回调的单条回执
+type tencentReport struct {
+	UserReceiveTime string `json:"user_receive_time"`
+	NationCode      string `json:"nationcode"`
+	Mobile          string `json:"mobile"`
+	ReportStatus    string `json:"report_status"`
+	ErrMsg          string `json:"errmsg"`
+	Description     string `json:"description"`
+	SID             string `json:"sid"`
+	Ext             string `json:"ext"` // 发送时的 SessionContext
+}
+
+// tencentReportAck 腾讯云要求返回 result 为 0 表示接收成功
+var tencentReportAck = []byte(`{"result":0,"errmsg":"OK"}`)
+
+// tencentRejectReasons 腾讯云错误码 -> 拒绝原因
+var tencentRejectReasons = map[string]RejectReason{
+	"InvalidParameterValue.IncorrectPhoneNumber":     RejectReasonInvalidPhone,
+	"FailedOperation.PhoneNumberInBlacklist":         RejectReasonInvalidPhone,
+	"FailedOperation.TemplateIncorrectOrUnapproved":  RejectReasonTemplate,
+	"FailedOperation.SignatureIncorrectOrUnapproved": RejectReasonTemplate,
+}
+
+func (t TencentCloudSMS) ParseReports(body []byte) ([]SendDetail, []byte, error) {
+	// https://cloud.tencent.com/document/product/382/52077
+	var reports []tencentReport
+	if err := json.Unmarshal(body, &reports); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidParameter, err)
+	}
+	details := make([]SendDetail, 0, len(reports))
+	for _, r := range reports {
+		phone := r.Mobile
+		if r.NationCode != "" && r.NationCode != "86" {
+			phone = "+" + r.NationCode + r.Mobile
+		}
+		status := tencentReportStatus(r.ReportStatus)
+		details = append(details, SendDetail{
+			PhoneNum:        phone,
+			SendStatus:      status,
+			Description:     r.Description,
+			ErrCode:         r.ErrMsg,
+			SerialNo:        r.SID,
+			OutID:           r.Ext,
+			ReportStatus:    int(status),
+			UserReceiveTime: r.UserReceiveTime,
+		})
+	}
+	return details, tencentReportAck, nil
+}
+
+// stringValue 安全地解引用腾讯云 SDK 返回的字符串指针
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
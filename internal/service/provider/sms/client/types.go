@@ -75,6 +75,10 @@ type Client interface {
 	BatchQueryTemplateStatus(req BatchQueryTemplateStatusReq) (BatchQueryTemplateStatusResp, error)
 	// Send 发送短信
 	Send(req SendReq) (SendResp, error)
+	// QuerySendDetails 查询单个手机号的发送详情（回执）
+	QuerySendDetails(req QuerySendDetailReq) (QuerySendDetailResp, error)
+	// ParseReports 解析供应商推送的回执，ack 为需要返回给供应商的应答内容
+	ParseReports(body []byte) (details []SendDetail, ack []byte, err error)
 }
 
 // CreateTemplateReq 创建短信模版请求参数
@@ -114,6 +118,7 @@ type SendReq struct {
 	SignName      string            // 签名名称，阿里云、腾讯云共用
 	TemplateID    string            // 模版 ID，阿里云、腾讯云共用
 	TemplateParam map[string]string // 模版参数，阿里云、腾讯云共用，key-value 形式
+	OutID         string            // 幂等标识，阿里云为 OutId，腾讯云为 SessionContext，会原样出现在发送详情和回执中
 }
 
 // SendResp 发送短信响应参数
@@ -123,10 +128,21 @@ type SendResp struct {
 }
 
 type SendRespStatus struct {
-	Code    string
-	Message string
+	Code     string
+	Message  string
+	SerialNo string       // 回执 ID，阿里云为整批共用的 BizId，腾讯云为每个号码的 SerialNo
+	Reject   RejectReason // 供应商拒绝发送的原因
 }
 
+// RejectReason 供应商拒绝发送的原因，区分出重试也不会成功的情况
+type RejectReason int
+
+const (
+	RejectReasonNone         RejectReason = iota // 没有被拒绝或原因未知
+	RejectReasonInvalidPhone                     // 手机号码无效或在黑名单中
+	RejectReasonTemplate                         // 模板或签名未通过审核
+)
+
 // QuerySendDetailReq 查询短信发送详情请求参数
 type QuerySendDetailReq struct {
 	PhoneNumber string // 手机号，阿里云、腾讯云共用
@@ -150,15 +166,17 @@ type QuerySendDetailResp struct {
 
 // SendDetail 短信发送详情
 type SendDetail struct {
-	PhoneNum   string // 手机号码，阿里云、腾讯云共用
-	SendStatus int    // 发送状态，腾讯云共用（1:等待回执，2:发送失败，3:发送成功）
-	Content    string // 短信内容，阿里云、腾讯云共用
+	PhoneNum    string     // 手机号码（去掉+86），阿里云、腾讯云共用
+	SendStatus  SendStatus // 发送状态，已转换为内部状态，阿里云、腾讯云共用
+	Content     string     // 短信内容，阿里云、腾讯云共用
+	Description string     // 状态描述，阿里云为 err_msg，腾讯云为 Description
 	// 下面内容阿里云独有
 	TemplateCode string // 模版CODE
 	SendDate     string // 发送时间
 	ReceiveDate  string // 接收时间
-	ErrCode      string // 错误码
-	OutID        string // 外部流水扩展字段
+	ErrCode      string // 错误码，腾讯云为 errmsg
+	OutID        string // 外部流水扩展字段，腾讯云为 SessionContext
+	BizID        string // 发送回执ID
 	// 以下为腾讯云独有
 	SerialNo        string // 发送序列号
 	ReportStatus    int    // 实际是否收到短信接收状态
@@ -9,8 +9,16 @@ import (
 	"go-notification/internal/service/provider/sms/client"
 	"go-notification/internal/service/template/manage"
 	"strings"
+	"time"
 )
 
+const (
+	// firstQueryDelay 发送成功后首次主动拉取回执的延迟，给供应商推送回执留出时间
+	firstQueryDelay = time.Minute
+)
+
+var _ provider.Reconciler = (*smsProvider)(nil)
+
 type smsProvider struct {
 	name        string
 	templateSvc manage.ChannelTemplateService
@@ -22,14 +30,15 @@ func NewSmsProvider(name string, templateSvc manage.ChannelTemplateService, clie
 }
 
 func (s *smsProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
-	tmpl, err := s.templateSvc.GetTemplateByIDAndProviderInfo(ctx, notification.Template.ID, s.name, domain.ChannelSMS)
+	tmpl, err := s.templateSvc.GetTemplateVersionByProviderInfo(ctx, notification.Template.ID,
+		notification.Template.SendVersionID(), s.name, domain.ChannelSMS)
 	if err != nil {
-		return domain.SendResponse{}, errs.ErrSendNotificationFailed
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
 	}
 
 	activeVersion := tmpl.ActiveVersion()
 	if activeVersion == nil {
-		return domain.SendResponse{}, fmt.Errorf("%w: 无已发布模板", errs.ErrSendNotificationFailed)
+		return domain.SendResponse{}, fmt.Errorf("%w: %w: 无已发布模板", errs.ErrSendNotificationFailed, errs.ErrTemplateNotFound)
 	}
 
 	const first = 0
@@ -38,19 +47,78 @@ func (s *smsProvider) Send(ctx context.Context, notification domain.Notification
 		SignName:      activeVersion.Signature,
 		TemplateID:    activeVersion.Providers[first].ProviderTemplateID,
 		TemplateParam: notification.Template.Params,
+		OutID:         notification.IdempotencyKey(),
 	})
 	if err != nil {
 		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
 	}
 
-	for _, status := range resp.PhoneNumbers {
-		if !strings.EqualFold(status.Code, "OK") {
-			return domain.SendResponse{}, fmt.Errorf("%w: Code = %s, Message = %s", errs.ErrSendNotificationFailed, status.Code, status.Message)
+	return s.buildResponse(notification, resp)
+}
+
+// buildResponse 按号码返回发送结果，部分号码失败时不影响其他号码，全部失败才返回错误以便换供应商重试
+func (s *smsProvider) buildResponse(notification domain.Notification, resp client.SendResp) (domain.SendResponse, error) {
+	nextQueryTime := time.Now().Add(firstQueryDelay).UnixMilli()
+	deliveries := make([]domain.Delivery, 0, len(resp.PhoneNumbers))
+	var failed int
+	var lastFailed client.SendRespStatus
+	for phone, status := range resp.PhoneNumbers {
+		d := domain.Delivery{
+			NotificationID: notification.ID,
+			BizID:          notification.BizID,
+			Receiver:       phone,
+			Channel:        domain.ChannelSMS,
+			Provider:       s.name,
+			SerialNo:       status.SerialNo,
+		}
+		switch {
+		case !strings.EqualFold(status.Code, client.OK):
+			failed++
+			// 有可以重试的失败时以它为准，避免整条通知不再重试
+			if lastFailed.Code == "" || status.Reject == client.RejectReasonNone {
+				lastFailed = status
+			}
+			d.Status = domain.DeliveryStatusSubmitFailed
+			d.ErrCode = status.Code
+			d.ErrMsg = status.Message
+		case status.SerialNo == "":
+			// 没有回执ID无法匹配回执
+			d.Status = domain.DeliveryStatusSubmitted
+		default:
+			d.Status = domain.DeliveryStatusWaiting
+			d.NextQueryTime = nextQueryTime
 		}
+		deliveries = append(deliveries, d)
 	}
 
+	if failed == len(deliveries) {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, rejectError(lastFailed))
+	}
+	status := domain.SendStatusSucceeded
+	if failed > 0 {
+		status = domain.SendStatusPartiallySucceeded
+	}
 	return domain.SendResponse{
 		NotificationID: notification.ID,
-		Status:         domain.SendStatusSucceeded,
+		Status:         status,
+		Deliveries:     deliveries,
 	}, nil
 }
+
+// Reconcile 按幂等标识逐个号码查询发送详情，命中的号码说明上一次请求已经被供应商受理
+func (s *smsProvider) Reconcile(_ context.Context, notification domain.Notification) ([]domain.Delivery, error) {
+	return reconcile(s.name, s.client, notification)
+}
+
+// rejectError 供应商明确拒绝的原因转换为不可重试的错误
+func rejectError(status client.SendRespStatus) error {
+	err := fmt.Errorf("Code = %s, Message = %s", status.Code, status.Message)
+	switch status.Reject {
+	case client.RejectReasonInvalidPhone:
+		return fmt.Errorf("%w: %w", errs.ErrInvalidReceiver, err)
+	case client.RejectReasonTemplate:
+		return fmt.Errorf("%w: %w", errs.ErrTemplateVersionNotApprovedByProvider, err)
+	default:
+		return err
+	}
+}
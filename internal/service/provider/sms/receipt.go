@@ -0,0 +1,116 @@
+package sms
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"go-notification/internal/service/delivery"
+	"go-notification/internal/service/provider/sms/client"
+	"strconv"
+	"time"
+)
+
+var _ delivery.ReceiptSource = (*ReceiptSource)(nil)
+
+const (
+	receiptPageSize   = 50
+	receiptTimeLayout = "2006-01-02 15:04:05"
+)
+
+// ReceiptSource 短信回执来源，支持主动拉取和解析供应商推送
+type ReceiptSource struct {
+	name   string
+	client client.Client
+	loc    *time.Location
+}
+
+func NewReceiptSource(name string, client client.Client) *ReceiptSource {
+	// 阿里云、腾讯云回执中的时间均为北京时间
+	return &ReceiptSource{name: name, client: client, loc: time.FixedZone("CST", 8*60*60)}
+}
+
+func (r *ReceiptSource) QueryReceipts(_ context.Context, deliveries []domain.Delivery) ([]domain.DeliveryReport, error) {
+	reports := make([]domain.DeliveryReport, 0, len(deliveries))
+	for i := range deliveries {
+		d := deliveries[i]
+		sendTime := time.UnixMilli(d.Ctime).In(r.loc)
+		resp, err := r.client.QuerySendDetails(client.QuerySendDetailReq{
+			PhoneNumber: d.Receiver,
+			BizID:       d.SerialNo,
+			SendDate:    sendTime.Format("20060102"),
+			PageSize:    receiptPageSize,
+			CurrentPage: 1,
+			BeginTime:   sendTime.Add(-time.Minute).Unix(),
+			EndTime:     time.Now().Unix(),
+			Limit:       receiptPageSize,
+		})
+		if err != nil {
+			// 单个号码查询失败不影响其他号码，等下一轮再拉取
+			continue
+		}
+		for _, detail := range resp.SmsSendDetailDTOs {
+			if detail.SendStatus == client.SendStatusWaiting ||
+				(detail.SerialNo != d.SerialNo && detail.BizID != d.SerialNo) {
+				continue
+			}
+			reports = append(reports, r.toReport(detail, d.SerialNo))
+		}
+	}
+	return reports, nil
+}
+
+func (r *ReceiptSource) Reconcile(_ context.Context, notification domain.Notification) ([]domain.Delivery, error) {
+	return reconcile(r.name, r.client, notification)
+}
+
+func (r *ReceiptSource) ParseReceipts(body []byte) ([]domain.DeliveryReport, []byte, error) {
+	details, ack, err := r.client.ParseReports(body)
+	if err != nil {
+		return nil, nil, err
+	}
+	reports := make([]domain.DeliveryReport, 0, len(details))
+	for _, detail := range details {
+		if detail.SendStatus == client.SendStatusWaiting {
+			continue
+		}
+		serialNo := detail.SerialNo
+		if serialNo == "" {
+			serialNo = detail.BizID
+		}
+		reports = append(reports, r.toReport(detail, serialNo))
+	}
+	return reports, ack, nil
+}
+
+func (r *ReceiptSource) toReport(detail client.SendDetail, serialNo string) domain.DeliveryReport {
+	status := domain.DeliveryStatusUndelivered
+	if detail.SendStatus == client.SendStatusSuccess {
+		status = domain.DeliveryStatusDelivered
+	}
+	return domain.DeliveryReport{
+		Provider:   r.name,
+		SerialNo:   serialNo,
+		Receiver:   domain.TrimPhonePrefix(detail.PhoneNum),
+		Status:     status,
+		ErrCode:    detail.ErrCode,
+		ErrMsg:     detail.Description,
+		ReportTime: r.reportTime(detail),
+	}
+}
+
+// reportTime 阿里云为 yyyy-MM-dd HH:mm:ss 格式，腾讯云为秒级时间戳
+func (r *ReceiptSource) reportTime(detail client.SendDetail) int64 {
+	if detail.UserReceiveTime != "" {
+		if sec, err := strconv.ParseInt(detail.UserReceiveTime, 10, 64); err == nil {
+			return sec * 1000
+		}
+		if t, err := time.ParseInLocation(receiptTimeLayout, detail.UserReceiveTime, r.loc); err == nil {
+			return t.UnixMilli()
+		}
+	}
+	if detail.ReceiveDate != "" {
+		if t, err := time.ParseInLocation(receiptTimeLayout, detail.ReceiveDate, r.loc); err == nil {
+			return t.UnixMilli()
+		}
+	}
+	return time.Now().UnixMilli()
+}
@@ -0,0 +1,96 @@
+package sms
+
+import (
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/provider/sms/client"
+	"time"
+)
+
+const (
+	// reconcileWindow 向供应商核对的时间范围，阿里云按天查询，会查询今天和昨天
+	reconcileWindow   = 48 * time.Hour
+	reconcilePageSize = 50
+	// reconcileMaxPages 每个号码每天最多查询的页数，超过仍然没有找到时无法确认是否发送过，按核对失败处理
+	reconcileMaxPages = 20
+)
+
+// reconcile 逐个号码查询发送详情，按幂等标识找到通知对应的记录
+// 重发前的核对和发送超时的核对共用
+func reconcile(name string, c client.Client, notification domain.Notification) ([]domain.Delivery, error) {
+	key := notification.IdempotencyKey()
+	now := time.Now()
+	var deliveries []domain.Delivery
+	for _, phone := range notification.Receivers {
+		for _, day := range []time.Time{now, now.Add(-24 * time.Hour)} {
+			d, ok, err := findByPhone(name, c, notification, phone, key, day, now)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				deliveries = append(deliveries, d)
+				break
+			}
+		}
+	}
+	return deliveries, nil
+}
+
+// findByPhone 逐页查询号码当天的发送详情，直到找到幂等标识对应的记录或者没有更多记录
+func findByPhone(name string, c client.Client, notification domain.Notification, phone, key string, day, now time.Time) (domain.Delivery, bool, error) {
+	for page := 1; page <= reconcileMaxPages; page++ {
+		resp, err := c.QuerySendDetails(client.QuerySendDetailReq{
+			PhoneNumber: phone,
+			SendDate:    day.Format("20060102"),
+			PageSize:    reconcilePageSize,
+			CurrentPage: page,
+			BeginTime:   now.Add(-reconcileWindow).Unix(),
+			EndTime:     now.Unix(),
+			Offset:      uint64((page - 1) * reconcilePageSize),
+			Limit:       reconcilePageSize,
+		})
+		if err != nil {
+			return domain.Delivery{}, false, fmt.Errorf("%w: %w", errs.ErrExternalServiceError, err)
+		}
+		if d, ok := findByOutID(name, notification, phone, key, resp.SmsSendDetailDTOs); ok {
+			return d, true, nil
+		}
+		if len(resp.SmsSendDetailDTOs) < reconcilePageSize {
+			return domain.Delivery{}, false, nil
+		}
+	}
+	return domain.Delivery{}, false, fmt.Errorf("%w: 号码 %s 的发送记录超过 %d 页", errs.ErrExternalServiceError, phone, reconcileMaxPages)
+}
+
+func findByOutID(name string, notification domain.Notification, phone, key string, details []client.SendDetail) (domain.Delivery, bool) {
+	for _, detail := range details {
+		if detail.OutID != key {
+			continue
+		}
+		d := domain.Delivery{
+			NotificationID: notification.ID,
+			BizID:          notification.BizID,
+			Receiver:       phone,
+			Channel:        domain.ChannelSMS,
+			Provider:       name,
+			SerialNo:       detail.SerialNo,
+			ErrCode:        detail.ErrCode,
+			ErrMsg:         detail.Description,
+		}
+		switch {
+		case detail.SendStatus == client.SendStatusSuccess:
+			d.Status = domain.DeliveryStatusDelivered
+		case detail.SendStatus == client.SendStatusFailed:
+			d.Status = domain.DeliveryStatusUndelivered
+		case d.SerialNo != "":
+			d.Status = domain.DeliveryStatusWaiting
+			d.NextQueryTime = time.Now().Add(firstQueryDelay).UnixMilli()
+		default:
+			// 阿里云按号码查询时不返回 BizId，无法再匹配回执
+			d.Status = domain.DeliveryStatusSubmitted
+		}
+		return d, true
+	}
+	return domain.Delivery{}, false
+}
@@ -0,0 +1,94 @@
+package sms
+
+import (
+	"fmt"
+	"testing"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/provider/sms/client"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQueryClient 号码当天有 total 条发送记录，第 hit 条是要核对的通知，hit 为 0 表示没有发送过
+type fakeQueryClient struct {
+	client.Client
+	total   int
+	hit     int
+	outID   string
+	queried int
+}
+
+func (c *fakeQueryClient) QuerySendDetails(req client.QuerySendDetailReq) (client.QuerySendDetailResp, error) {
+	c.queried++
+	start := (req.CurrentPage - 1) * req.PageSize
+	end := min(start+req.PageSize, c.total)
+	var details []client.SendDetail
+	for i := start + 1; i <= end; i++ {
+		detail := client.SendDetail{PhoneNum: req.PhoneNumber, OutID: fmt.Sprintf("other-%d", i), SerialNo: fmt.Sprintf("serial-%d", i)}
+		if i == c.hit {
+			detail.OutID = c.outID
+		}
+		details = append(details, detail)
+	}
+	return client.QuerySendDetailResp{TotalCount: c.total, SmsSendDetailDTOs: details}, nil
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	n := domain.Notification{ID: 1, BizID: 2, Key: "key", Receivers: []string{"13800000000"}, Channel: domain.ChannelSMS}
+
+	testCases := []struct {
+		name           string
+		total          int
+		hit            int
+		wantErr        error
+		wantSerialNo   string
+		wantNotFound   bool
+		wantMaxQueries int
+	}{
+		{
+			name:           "记录在后面的分页中",
+			total:          3*reconcilePageSize + 10,
+			hit:            2*reconcilePageSize + 5,
+			wantSerialNo:   fmt.Sprintf("serial-%d", 2*reconcilePageSize+5),
+			wantMaxQueries: 3,
+		},
+		{
+			name:         "查完所有分页都没有记录",
+			total:        2*reconcilePageSize + 1,
+			wantNotFound: true,
+			// 今天和昨天各查询三页
+			wantMaxQueries: 6,
+		},
+		{
+			name:    "记录太多无法确认",
+			total:   (reconcileMaxPages + 1) * reconcilePageSize,
+			wantErr: errs.ErrExternalServiceError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &fakeQueryClient{total: tc.total, hit: tc.hit, outID: n.IdempotencyKey()}
+			deliveries, err := reconcile("aliyun", c, n)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			assert.LessOrEqual(t, c.queried, tc.wantMaxQueries)
+			if tc.wantNotFound {
+				assert.Empty(t, deliveries)
+				return
+			}
+			require.Len(t, deliveries, 1)
+			assert.Equal(t, tc.wantSerialNo, deliveries[0].SerialNo)
+			assert.Equal(t, domain.DeliveryStatusWaiting, deliveries[0].Status)
+		})
+	}
+}
@@ -51,6 +51,22 @@ func (p Provider) Send(ctx context.Context, notification domain.Notification) (d
 			attribute.String("notification.status", string(response.Status)),
 		)
 	}
-	
+
 	return response, err
 }
+
+func (p Provider) Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error) {
+	ctx, span := p.tracer.Start(ctx, "Provider.Reconcile",
+		trace.WithAttributes(
+			attribute.String("provider.name", p.name),
+			attribute.Int64("notification.id", notification.ID),
+		))
+	defer span.End()
+
+	deliveries, err := provider.Reconcile(ctx, p.provider, notification)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return deliveries, err
+}
@@ -13,6 +13,22 @@ type Provider interface {
 	Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error)
 }
 
+// Reconciler 支持按幂等标识向供应商核对发送结果的供应商
+// 重发之前先核对，避免上一次请求已经被供应商受理但没有拿到响应时重复发送
+type Reconciler interface {
+	// Reconcile 返回供应商侧已经受理的接收者及其状态，没有受理的接收者不返回
+	Reconcile(ctx context.Context, notification domain.Notification) ([]domain.Delivery, error)
+}
+
+// Reconcile 供应商实现了 Reconciler 时向供应商核对，否则视为没有受理记录
+func Reconcile(ctx context.Context, p Provider, notification domain.Notification) ([]domain.Delivery, error) {
+	r, ok := p.(Reconciler)
+	if !ok {
+		return nil, nil
+	}
+	return r.Reconcile(ctx, notification)
+}
+
 // Selector 供应商选择接口
 type Selector interface {
 	// Next 获取下一个供应商，无可用供应商时返回错误
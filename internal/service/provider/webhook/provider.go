@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/config"
+	"go-notification/internal/service/provider"
+	"go-notification/internal/service/template/manage"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// HeaderSignature 请求体签名，格式为 sha256=<hex>
+	HeaderSignature = "X-Notification-Signature"
+	// HeaderTimestamp 签名时间戳（秒），业务方应拒绝时间差过大的请求以防重放
+	HeaderTimestamp = "X-Notification-Timestamp"
+	// HeaderNotificationID 通知ID
+	HeaderNotificationID = "X-Notification-Id"
+	// HeaderIdempotencyKey 幂等标识，同一通知重试时保持不变，业务方据此去重
+	HeaderIdempotencyKey = "X-Notification-Idempotency-Key"
+
+	defaultTimeout = 5 * time.Second
+	// maxDrainBytes 最多读取的响应体长度，用于连接复用
+	maxDrainBytes = 4 << 10
+)
+
+// Payload 推送给业务方的请求体
+type Payload struct {
+	NotificationID    int64             `json:"notificationId"`
+	BizID             int64             `json:"bizId"`
+	Key               string            `json:"key"`
+	TemplateID        int64             `json:"templateId"`
+	TemplateVersionID int64             `json:"templateVersionId"`
+	Content           string            `json:"content"` // 渲染后的模板内容
+	Params            map[string]string `json:"params"`
+}
+
+// Sign 计算签名，签名内容为 "<timestamp>.<body>"，业务方按同样的方式校验
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type webhookProvider struct {
+	name        string
+	templateSvc manage.ChannelTemplateService
+	configSvc   config.BusinessConfigService
+	client      *http.Client
+}
+
+func NewWebhookProvider(name string, templateSvc manage.ChannelTemplateService, configSvc config.BusinessConfigService, client *http.Client) provider.Provider {
+	return &webhookProvider{name: name, templateSvc: templateSvc, configSvc: configSvc, client: client}
+}
+
+func (w *webhookProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	tmpl, err := w.templateSvc.GetTemplateVersionByProviderInfo(ctx, notification.Template.ID,
+		notification.Template.SendVersionID(), w.name, domain.ChannelWebhook)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	activeVersion := tmpl.ActiveVersion()
+	if activeVersion == nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w: 无已发布模板", errs.ErrSendNotificationFailed, errs.ErrTemplateNotFound)
+	}
+
+	bizConfig, err := w.configSvc.GetByID(ctx, notification.BizID)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+	cfg := bizConfig.WebhookConfig
+	if cfg == nil || cfg.Secret == "" {
+		return domain.SendResponse{}, fmt.Errorf("%w: 未配置WEBHOOK签名密钥", errs.ErrSendNotificationFailed)
+	}
+	timeout := defaultTimeout
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Millisecond
+	}
+
+	body, err := json.Marshal(Payload{
+		NotificationID:    notification.ID,
+		BizID:             notification.BizID,
+		Key:               notification.Key,
+		TemplateID:        notification.Template.ID,
+		TemplateVersionID: activeVersion.Id,
+		Content:           activeVersion.RenderContent(notification.Template.Params),
+		Params:            notification.Template.Params,
+	})
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	// 逐个接收地址发送，单个地址失败不影响其他地址，全部失败才返回错误以便重试
+	deliveries := make([]domain.Delivery, 0, len(notification.Receivers))
+	var lastErr error
+	for _, receiver := range notification.Receivers {
+		d := domain.Delivery{
+			NotificationID: notification.ID,
+			BizID:          notification.BizID,
+			Receiver:       receiver,
+			Channel:        domain.ChannelWebhook,
+			Provider:       w.name,
+			Status:         domain.DeliveryStatusSubmitted,
+		}
+		if err = w.post(ctx, receiver, notification, cfg.Secret, timeout, body); err != nil {
+			lastErr = err
+			d.Status = domain.DeliveryStatusSubmitFailed
+			d.ErrMsg = err.Error()
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	status := domain.DeliveriesSendStatus(deliveries)
+	if status == domain.SendStatusFailed {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, lastErr)
+	}
+	return domain.SendResponse{
+		NotificationID: notification.ID,
+		Status:         status,
+		Deliveries:     deliveries,
+	}, nil
+}
+
+// post 向单个接收地址发送请求，非2xx响应视为失败
+func (w *webhookProvider) post(ctx context.Context, target string, notification domain.Notification, secret string, timeout time.Duration, body []byte) error {
+	u, err := url.Parse(target)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: 接收地址 %s", errs.ErrInvalidParameter, target)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+	req.Header.Set(HeaderNotificationID, strconv.FormatInt(notification.ID, 10))
+	req.Header.Set(HeaderIdempotencyKey, notification.IdempotencyKey())
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("请求 %s 失败: %w", target, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBytes))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("请求 %s 响应状态码 %d", target, resp.StatusCode)
+	}
+	return nil
+}
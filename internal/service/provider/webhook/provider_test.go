@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/service/config"
+	"go-notification/internal/service/template/manage"
+)
+
+type stubTemplateService struct {
+	manage.ChannelTemplateService
+}
+
+func (s stubTemplateService) GetTemplateVersionByProviderInfo(_ context.Context, templateID, _ int64, _ string, _ domain.Channel) (domain.ChannelTemplate, error) {
+	return domain.ChannelTemplate{
+		ID:              templateID,
+		ActiveVersionID: 2,
+		Versions: []domain.ChannelTemplateVersion{
+			{Id: 2, Content: "订单 ${orderId} 已发货"},
+		},
+	}, nil
+}
+
+type stubConfigService struct {
+	config.BusinessConfigService
+	cfg *domain.WebhookConfig
+}
+
+func (s stubConfigService) GetByID(_ context.Context, id int64) (domain.BusinessConfig, error) {
+	return domain.BusinessConfig{ID: id, WebhookConfig: s.cfg}, nil
+}
+
+func TestWebhookProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	const secret = "biz-secret"
+	testCases := []struct {
+		name    string
+		cfg     *domain.WebhookConfig
+		handler http.HandlerFunc
+		// 接收地址的路径，为空表示只有一个接收地址
+		paths          []string
+		wantErr        error
+		wantStatus     domain.SendStatus
+		wantDeliveries []domain.DeliveryStatus
+	}{
+		{
+			name: "签名正确且2xx",
+			cfg:  &domain.WebhookConfig{Secret: secret},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				ts := r.Header.Get(HeaderTimestamp)
+				if r.Header.Get(HeaderSignature) != Sign(secret, ts, body) {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				var payload Payload
+				if json.Unmarshal(body, &payload) != nil || payload.Content != "订单 123 已发货" ||
+					r.Header.Get(HeaderIdempotencyKey) != "ntf-1" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+			wantStatus:     domain.SendStatusSucceeded,
+			wantDeliveries: []domain.DeliveryStatus{domain.DeliveryStatusSubmitted},
+		},
+		{
+			name: "部分接收地址失败时继续发送其他地址",
+			cfg:  &domain.WebhookConfig{Secret: secret},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/fail" {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+			paths:      []string{"/a", "/fail", "/b"},
+			wantStatus: domain.SendStatusPartiallySucceeded,
+			wantDeliveries: []domain.DeliveryStatus{
+				domain.DeliveryStatusSubmitted,
+				domain.DeliveryStatusSubmitFailed,
+				domain.DeliveryStatusSubmitted,
+			},
+		},
+		{
+			name: "非2xx视为失败",
+			cfg:  &domain.WebhookConfig{Secret: secret},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "超过业务方配置的超时时间",
+			cfg:  &domain.WebhookConfig{Secret: secret, Timeout: 50},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+				w.WriteHeader(http.StatusOK)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name:    "未配置签名密钥",
+			handler: func(w http.ResponseWriter, _ *http.Request) {},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+
+			receivers := []string{server.URL}
+			if len(tc.paths) > 0 {
+				receivers = make([]string, 0, len(tc.paths))
+				for _, path := range tc.paths {
+					receivers = append(receivers, server.URL+path)
+				}
+			}
+			p := NewWebhookProvider("webhook", stubTemplateService{}, stubConfigService{cfg: tc.cfg}, server.Client())
+			resp, err := p.Send(context.Background(), domain.Notification{
+				ID:        1,
+				BizID:     1,
+				Key:       "order-123",
+				Receivers: receivers,
+				Channel:   domain.ChannelWebhook,
+				Template:  domain.Template{ID: 1, Params: map[string]string{"orderId": "123"}},
+			})
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			require.Equal(t, tc.wantStatus, resp.Status)
+			require.Len(t, resp.Deliveries, len(tc.wantDeliveries))
+			for i, d := range resp.Deliveries {
+				assert.Equal(t, receivers[i], d.Receiver)
+				assert.Equal(t, tc.wantDeliveries[i], d.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package pushtoken
+
+import (
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/repository"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// ListResult 无效令牌分页结果
+type ListResult struct {
+	Tokens     []domain.InvalidPushToken
+	NextCursor int64 // 下一页游标
+	HasMore    bool  // 是否还有更多数据
+}
+
+// Service 推送设备令牌服务，业务方按标记顺序拉取无效令牌进行清理
+type Service interface {
+	// ListInvalid 按ID正序分页查询业务方的无效令牌，cursor 为上一页最后一条记录的ID
+	ListInvalid(ctx context.Context, bizID, cursor int64, limit int) (ListResult, error)
+}
+
+type service struct {
+	repo repository.PushTokenRepository
+}
+
+func NewService(repo repository.PushTokenRepository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) ListInvalid(ctx context.Context, bizID, cursor int64, limit int) (ListResult, error) {
+	if bizID <= 0 {
+		return ListResult{}, fmt.Errorf("%w: 业务ID", errs.ErrInvalidParameter)
+	}
+	if cursor < 0 {
+		return ListResult{}, fmt.Errorf("%w: 游标", errs.ErrInvalidParameter)
+	}
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	// 多查一条用于判断是否还有下一页
+	tokens, err := s.repo.FindInvalid(ctx, bizID, cursor, limit+1)
+	if err != nil {
+		return ListResult{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+	}
+
+	res := ListResult{Tokens: tokens}
+	if len(tokens) > limit {
+		res.Tokens = tokens[:limit]
+		res.HasMore = true
+	}
+	if len(res.Tokens) > 0 {
+		res.NextCursor = res.Tokens[len(res.Tokens)-1].ID
+	}
+	return res, nil
+}
@@ -0,0 +1,101 @@
+package pushtoken
+
+import (
+	"context"
+	"testing"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePushTokenRepo struct {
+	repository.PushTokenRepository
+	tokens []domain.InvalidPushToken
+}
+
+func (r *fakePushTokenRepo) FindInvalid(_ context.Context, bizID, startID int64, limit int) ([]domain.InvalidPushToken, error) {
+	var res []domain.InvalidPushToken
+	for _, t := range r.tokens {
+		if t.BizID == bizID && t.ID > startID && len(res) < limit {
+			res = append(res, t)
+		}
+	}
+	return res, nil
+}
+
+func TestService_ListInvalid(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakePushTokenRepo{tokens: []domain.InvalidPushToken{
+		{ID: 1, BizID: 1, Token: "token-1"},
+		{ID: 2, BizID: 2, Token: "token-2"},
+		{ID: 3, BizID: 1, Token: "token-3"},
+		{ID: 4, BizID: 1, Token: "token-4"},
+	}}
+
+	testCases := []struct {
+		name           string
+		bizID          int64
+		cursor         int64
+		limit          int
+		wantErr        error
+		wantIDs        []int64
+		wantNextCursor int64
+		wantHasMore    bool
+	}{
+		{
+			name:           "首页还有更多数据",
+			bizID:          1,
+			limit:          2,
+			wantIDs:        []int64{1, 3},
+			wantNextCursor: 3,
+			wantHasMore:    true,
+		},
+		{
+			name:           "按游标查询最后一页",
+			bizID:          1,
+			cursor:         3,
+			limit:          2,
+			wantIDs:        []int64{4},
+			wantNextCursor: 4,
+		},
+		{
+			name:    "没有数据",
+			bizID:   3,
+			wantIDs: []int64{},
+		},
+		{
+			name:    "业务ID不合法",
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "游标不合法",
+			bizID:   1,
+			cursor:  -1,
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewService(repo).ListInvalid(t.Context(), tc.bizID, tc.cursor, tc.limit)
+			require.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			ids := make([]int64, 0, len(res.Tokens))
+			for _, token := range res.Tokens {
+				ids = append(ids, token.ID)
+			}
+			assert.Equal(t, tc.wantIDs, ids)
+			assert.Equal(t, tc.wantNextCursor, res.NextCursor)
+			assert.Equal(t, tc.wantHasMore, res.HasMore)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package quiethours
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/service/config"
+	"go-notification/internal/service/template/manage"
+	"time"
+)
+
+// Service 按业务方配置的免打扰时段计算通知允许发送的时间，立即发送和调度发送共用
+// 查询业务配置或者模板失败时照常发送，不因为免打扰阻塞发送
+type Service interface {
+	// Defer 与 notifications 一一对应返回允许发送的时间，零值表示不需要推迟
+	// 同一批通知的业务配置和模板只查询一次
+	Defer(ctx context.Context, notifications []domain.Notification, now time.Time) []time.Time
+}
+
+type service struct {
+	configSvc   config.BusinessConfigService
+	templateSvc manage.ChannelTemplateService
+	logger      logger.Logger
+}
+
+func NewService(configSvc config.BusinessConfigService, templateSvc manage.ChannelTemplateService, logger logger.Logger) Service {
+	return &service{configSvc: configSvc, templateSvc: templateSvc, logger: logger}
+}
+
+func (s *service) Defer(ctx context.Context, notifications []domain.Notification, now time.Time) []time.Time {
+	res := make([]time.Time, len(notifications))
+	bizIDs := make([]int64, 0, len(notifications))
+	seen := make(map[int64]struct{}, len(notifications))
+	for i := range notifications {
+		if _, ok := seen[notifications[i].BizID]; !ok {
+			seen[notifications[i].BizID] = struct{}{}
+			bizIDs = append(bizIDs, notifications[i].BizID)
+		}
+	}
+	configs, err := s.configSvc.GetByIDs(ctx, bizIDs)
+	if err != nil {
+		s.logger.Warn("查询业务配置失败，跳过免打扰检查", logger.Error(err))
+		return res
+	}
+
+	businessTypes := make(map[int64]domain.BusinessType)
+	for i := range notifications {
+		n := notifications[i]
+		quietHours := configs[n.BizID].QuietHours
+		if quietHours == nil || len(quietHours.Rules) == 0 {
+			continue
+		}
+		businessType, err1 := s.businessType(ctx, n.Template.ID, businessTypes)
+		if err1 != nil {
+			s.logger.Warn("查询模板业务类型失败，跳过免打扰检查",
+				logger.Int64("notificationID", n.ID),
+				logger.Int64("templateID", n.Template.ID),
+				logger.Error(err1))
+			continue
+		}
+		if at, deferred := quietHours.Defer(now, n.Channel, businessType, n.ReceiverTimezone); deferred {
+			res[i] = at
+		}
+	}
+	return res
+}
+
+// businessType 同一批通知的模板只查询一次
+func (s *service) businessType(ctx context.Context, templateID int64, cache map[int64]domain.BusinessType) (domain.BusinessType, error) {
+	if businessType, ok := cache[templateID]; ok {
+		return businessType, nil
+	}
+	template, err := s.templateSvc.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return 0, err
+	}
+	cache[templateID] = template.BusinessType
+	return template.BusinessType, nil
+}
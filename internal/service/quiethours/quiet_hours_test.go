@@ -0,0 +1,99 @@
+package quiethours
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/service/config"
+	"go-notification/internal/service/template/manage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeConfigSvc struct {
+	config.BusinessConfigService
+	configs map[int64]domain.BusinessConfig
+	err     error
+}
+
+func (s *fakeConfigSvc) GetByIDs(_ context.Context, _ []int64) (map[int64]domain.BusinessConfig, error) {
+	return s.configs, s.err
+}
+
+type fakeTemplateSvc struct {
+	manage.ChannelTemplateService
+	err   error
+	calls int
+}
+
+func (s *fakeTemplateSvc) GetTemplateByID(_ context.Context, templateID int64) (domain.ChannelTemplate, error) {
+	s.calls++
+	return domain.ChannelTemplate{ID: templateID, BusinessType: domain.BusinessTypePromotion}, s.err
+}
+
+func TestService_Defer(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 17, 23, 30, 0, 0, time.UTC)
+	configs := map[int64]domain.BusinessConfig{
+		1: {
+			ID: 1,
+			QuietHours: &domain.QuietHoursConfig{
+				Rules: []domain.QuietHoursRule{
+					{Start: "22:00", End: "08:00", Timezone: "UTC"},
+				},
+			},
+		},
+	}
+	notifications := []domain.Notification{
+		{ID: 1, BizID: 1, Channel: domain.ChannelSMS, Template: domain.Template{ID: 1}},
+		{ID: 2, BizID: 2, Channel: domain.ChannelSMS, Template: domain.Template{ID: 1}},
+		{ID: 3, BizID: 1, Channel: domain.ChannelEmail, Template: domain.Template{ID: 1}},
+	}
+	morning := time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		configErr     error
+		templateErr   error
+		want          []time.Time
+		wantTemplates int
+	}{
+		{
+			name:          "同一批通知的模板只查询一次",
+			want:          []time.Time{morning, {}, morning},
+			wantTemplates: 1,
+		},
+		{
+			name:      "查询业务配置失败时照常发送",
+			configErr: errors.New("db error"),
+			want:      []time.Time{{}, {}, {}},
+		},
+		{
+			name:          "查询模板失败时照常发送",
+			templateErr:   errors.New("db error"),
+			want:          []time.Time{{}, {}, {}},
+			wantTemplates: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			templateSvc := &fakeTemplateSvc{err: tc.templateErr}
+			svc := NewService(&fakeConfigSvc{configs: configs, err: tc.configErr}, templateSvc, logger.NewNopLogger())
+
+			got := svc.Defer(t.Context(), notifications, now)
+			assert.Len(t, got, len(tc.want))
+			for i := range tc.want {
+				assert.True(t, tc.want[i].Equal(got[i]), "第 %d 条通知推迟到 %s", i, got[i])
+			}
+			assert.Equal(t, tc.wantTemplates, templateSvc.calls)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/quiethours"
+	"time"
+)
+
+// QuietHours 调度发送前把落在业务方免打扰时段内的通知推迟到允许发送的时间
+type QuietHours struct {
+	quietHoursSvc quiethours.Service
+	repo          repository.NotificationRepository
+	log           logger.Logger
+}
+
+func NewQuietHours(
+	quietHoursSvc quiethours.Service,
+	repo repository.NotificationRepository,
+	log logger.Logger,
+) *QuietHours {
+	return &QuietHours{
+		quietHoursSvc: quietHoursSvc,
+		repo:          repo,
+		log:           log,
+	}
+}
+
+// Filter 推迟落在免打扰时段内的通知，返回现在可以发送的通知
+func (q *QuietHours) Filter(ctx context.Context, notifications []domain.Notification, now time.Time) []domain.Notification {
+	deferTo := q.quietHoursSvc.Defer(ctx, notifications, now)
+	ready := make([]domain.Notification, 0, len(notifications))
+	for i := range notifications {
+		if deferTo[i].IsZero() {
+			ready = append(ready, notifications[i])
+			continue
+		}
+		q.deferTo(ctx, notifications[i], deferTo[i])
+	}
+	return ready
+}
+
+// deferTo 保持原来的发送时间范围，推迟到 at 开始发送
+func (q *QuietHours) deferTo(ctx context.Context, n domain.Notification, at time.Time) {
+	n.DeferTo(at)
+	ctx = domain.CtxWithTransitionError(ctx, fmt.Errorf("%w，推迟到 %s", errs.ErrQuietHours, at.Format(time.RFC3339)))
+	if err := q.repo.Defer(ctx, n); err != nil {
+		// 版本号不匹配说明刚好被取消或者改期，以业务方的修改为准
+		q.log.Warn("免打扰时段内推迟发送失败",
+			logger.Int64("notificationID", n.ID),
+			logger.Error(err))
+	}
+}
@@ -0,0 +1,161 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/config"
+	"go-notification/internal/service/quiethours"
+	"go-notification/internal/service/template/manage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeConfigSvc struct {
+	config.BusinessConfigService
+	configs map[int64]domain.BusinessConfig
+}
+
+func (s *fakeConfigSvc) GetByIDs(_ context.Context, _ []int64) (map[int64]domain.BusinessConfig, error) {
+	return s.configs, nil
+}
+
+type fakeTemplateSvc struct {
+	manage.ChannelTemplateService
+	businessTypes map[int64]domain.BusinessType
+}
+
+func (s *fakeTemplateSvc) GetTemplateByID(_ context.Context, templateID int64) (domain.ChannelTemplate, error) {
+	return domain.ChannelTemplate{ID: templateID, BusinessType: s.businessTypes[templateID]}, nil
+}
+
+type fakeDeferRepo struct {
+	repository.NotificationRepository
+	deferred []domain.Notification
+	causes   []error
+}
+
+func (r *fakeDeferRepo) Defer(ctx context.Context, n domain.Notification) error {
+	_, cause := domain.TransitionFromCtx(ctx)
+	r.deferred = append(r.deferred, n)
+	r.causes = append(r.causes, cause)
+	return nil
+}
+
+func TestQuietHours_Filter(t *testing.T) {
+	t.Parallel()
+
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	// 北京时间 23:30，落在 22:00 到次日 08:00 的免打扰时段内
+	now := time.Date(2026, 10, 17, 23, 30, 0, 0, shanghai)
+
+	const (
+		promotionTemplateID    = 1
+		verificationTemplateID = 2
+	)
+	configs := map[int64]domain.BusinessConfig{
+		1: {
+			ID: 1,
+			QuietHours: &domain.QuietHoursConfig{
+				Rules: []domain.QuietHoursRule{
+					{
+						Channels:      []domain.Channel{domain.ChannelSMS},
+						BusinessTypes: []domain.BusinessType{domain.BusinessTypePromotion},
+						Start:         "22:00",
+						End:           "08:00",
+						Timezone:      "Asia/Shanghai",
+					},
+				},
+			},
+		},
+	}
+	newNotification := func(bizID int64, channel domain.Channel, templateID int64, receiverTimezone string) domain.Notification {
+		return domain.Notification{
+			ID:               1,
+			BizID:            bizID,
+			Key:              "key",
+			Channel:          channel,
+			Template:         domain.Template{ID: templateID},
+			Status:           domain.SendStatusPending,
+			ScheduledSTime:   now.Add(-time.Minute),
+			ScheduledETime:   now.Add(9 * time.Minute),
+			ReceiverTimezone: receiverTimezone,
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		notification domain.Notification
+		wantDeferTo  time.Time
+	}{
+		{
+			name:         "营销短信推迟到免打扰时段结束",
+			notification: newNotification(1, domain.ChannelSMS, promotionTemplateID, ""),
+			wantDeferTo:  time.Date(2026, 10, 18, 8, 0, 0, 0, shanghai),
+		},
+		{
+			name:         "验证码不受免打扰限制",
+			notification: newNotification(1, domain.ChannelSMS, verificationTemplateID, ""),
+		},
+		{
+			name:         "规则之外的渠道照常发送",
+			notification: newNotification(1, domain.ChannelEmail, promotionTemplateID, ""),
+		},
+		{
+			name:         "按接收者时区不在免打扰时段内",
+			notification: newNotification(1, domain.ChannelSMS, promotionTemplateID, "Europe/London"),
+		},
+		{
+			name:         "按接收者时区推迟",
+			notification: newNotification(1, domain.ChannelSMS, promotionTemplateID, "Asia/Tokyo"),
+			// 东京时间 00:30，推迟到东京时间 08:00
+			wantDeferTo: time.Date(2026, 10, 18, 7, 0, 0, 0, shanghai),
+		},
+		{
+			name:         "业务方没有配置免打扰",
+			notification: newNotification(2, domain.ChannelSMS, promotionTemplateID, ""),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeDeferRepo{}
+			q := NewQuietHours(
+				quiethours.NewService(
+					&fakeConfigSvc{configs: configs},
+					&fakeTemplateSvc{businessTypes: map[int64]domain.BusinessType{
+						promotionTemplateID:    domain.BusinessTypePromotion,
+						verificationTemplateID: domain.BusinessTypeVerificationCode,
+					}},
+					logger.NewNopLogger(),
+				),
+				repo,
+				logger.NewNopLogger(),
+			)
+
+			ready := q.Filter(t.Context(), []domain.Notification{tc.notification}, now)
+
+			if tc.wantDeferTo.IsZero() {
+				assert.Equal(t, []domain.Notification{tc.notification}, ready)
+				assert.Empty(t, repo.deferred)
+				return
+			}
+			assert.Empty(t, ready)
+			require.Len(t, repo.deferred, 1)
+			deferred := repo.deferred[0]
+			assert.True(t, tc.wantDeferTo.Equal(deferred.ScheduledSTime), "推迟到 %s", deferred.ScheduledSTime)
+			// 保持原来的发送时间范围
+			assert.Equal(t, 10*time.Minute, deferred.ScheduledETime.Sub(deferred.ScheduledSTime))
+			assert.ErrorIs(t, repo.causes[0], errs.ErrQuietHours)
+		})
+	}
+}
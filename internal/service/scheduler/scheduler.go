@@ -3,6 +3,7 @@ package scheduler
 import (
 	"context"
 	"github.com/meoying/dlock-go"
+	"go-notification/internal/domain"
 	"go-notification/internal/pkg/logger"
 	"go-notification/internal/pkg/loopjob"
 	"go-notification/internal/service/notification"
@@ -19,17 +20,19 @@ type NotificationScheduler interface {
 type staticScheduler struct {
 	notificationSvc notification.Service
 	sender          sender.NotificationSender
+	quietHours      *QuietHours
 	dclient         dlock.Client
 	log             logger.Logger
 
 	batchSize int
 }
 
-func NewScheduler(notificationSvc notification.Service, sender sender.NotificationSender, dclient dlock.Client, log logger.Logger) NotificationScheduler {
+func NewScheduler(notificationSvc notification.Service, sender sender.NotificationSender, quietHours *QuietHours, dclient dlock.Client, log logger.Logger) NotificationScheduler {
 	const defaultBatchSize = 10
 	return &staticScheduler{
 		notificationSvc: notificationSvc,
 		sender:          sender,
+		quietHours:      quietHours,
 		dclient:         dclient,
 		batchSize:       defaultBatchSize,
 	}
@@ -46,7 +49,7 @@ func (s *staticScheduler) Start(ctx context.Context) {
 // processPendingNotifications 处理待发送的通知
 func (s *staticScheduler) processPendingNotifications(ctx context.Context) error {
 	const defaultTimeout = 3 * time.Second
-	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	ctx, cancel := context.WithTimeout(domain.CtxWithTransitionActor(ctx, domain.TransitionActorScheduler), defaultTimeout)
 	defer cancel()
 	const offset = 0
 	notifications, err := s.notificationSvc.FindReadyNotifications(ctx, offset, s.batchSize)
@@ -57,6 +60,16 @@ func (s *staticScheduler) processPendingNotifications(ctx context.Context) error
 		time.Sleep(time.Second)
 		return nil
 	}
+	// 免打扰时段内的通知推迟发送
+	notifications = s.quietHours.Filter(ctx, notifications, time.Now())
+	if len(notifications) == 0 {
+		return nil
+	}
+	// 先按版本号抢占，避免发送已被取消或改期的通知
+	notifications, err = s.notificationSvc.MarkSending(ctx, notifications)
+	if err != nil {
+		return err
+	}
 	_, err = s.sender.BatchSend(ctx, notifications)
 	return err
 }
@@ -3,6 +3,7 @@ package scheduler
 import (
 	"context"
 	"github.com/meoying/dlock-go"
+	"go-notification/internal/domain"
 	"go-notification/internal/errs"
 	"go-notification/internal/pkg/batchSize"
 	"go-notification/internal/pkg/bitring"
@@ -19,6 +20,7 @@ import (
 type ShardingScheduler struct {
 	repo              repository.NotificationRepository
 	sender            sender.NotificationSender
+	quietHours        *QuietHours
 	minLoopDuration   time.Duration
 	batchSize         atomic.Uint64
 	batchSizeAdjuster batchSize.Adjuster
@@ -31,6 +33,7 @@ type ShardingScheduler struct {
 func NewShardingScheduler(
 	repo repository.NotificationRepository,
 	sender sender.NotificationSender,
+	quietHours *QuietHours,
 	dclient dlock.Client,
 	shardingStrategy sharding.ShardingStrategy,
 	sem loopjob.ResourceSemaphore,
@@ -44,6 +47,7 @@ func NewShardingScheduler(
 	s := &ShardingScheduler{
 		repo:              repo,
 		sender:            sender,
+		quietHours:        quietHours,
 		minLoopDuration:   minLoopDuration,
 		batchSizeAdjuster: batchSizeAdjuster,
 		errorEvents:       errorEvents,
@@ -95,7 +99,7 @@ func (s *ShardingScheduler) loop(ctx context.Context) error {
 func (s *ShardingScheduler) batchSendReadyNotifications(ctx context.Context) (int, error) {
 	const defaultTimeout = 3 * time.Second
 
-	loopCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	loopCtx, cancel := context.WithTimeout(domain.CtxWithTransitionActor(ctx, domain.TransitionActorScheduler), defaultTimeout)
 	defer cancel()
 
 	const offset = 0
@@ -108,6 +112,16 @@ func (s *ShardingScheduler) batchSendReadyNotifications(ctx context.Context) (in
 		return 0, nil
 	}
 
-	_, err = s.sender.BatchSend(ctx, notifications)
+	// 免打扰时段内的通知推迟发送
+	ready := s.quietHours.Filter(loopCtx, notifications, time.Now())
+	if len(ready) == 0 {
+		return len(notifications), nil
+	}
+	// 先按版本号抢占，避免发送已被取消或改期的通知
+	claimed, err := s.repo.MarkSending(loopCtx, ready)
+	if err != nil {
+		return 0, err
+	}
+	_, err = s.sender.BatchSend(ctx, claimed)
 	return len(notifications), err
 }
@@ -0,0 +1,67 @@
+package sender
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/service/inbox"
+)
+
+// InboxPushSender 站内信发送成功后发布推送事件的装饰器，实时推送网关据此通知在线的接收者
+type InboxPushSender struct {
+	sender    NotificationSender
+	publisher inbox.Publisher
+	logger    logger.Logger
+}
+
+func NewInboxPushSender(sender NotificationSender, publisher inbox.Publisher, logger logger.Logger) *InboxPushSender {
+	return &InboxPushSender{sender: sender, publisher: publisher, logger: logger}
+}
+
+func (s *InboxPushSender) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	resp, err := s.sender.Send(ctx, notification)
+	if err == nil && resp.Status == domain.SendStatusSucceeded {
+		s.publish(ctx, notification, resp.DeliveredChannel)
+	}
+	return resp, err
+}
+
+func (s *InboxPushSender) BatchSend(ctx context.Context, notifications []domain.Notification) ([]domain.SendResponse, error) {
+	responses, err := s.sender.BatchSend(ctx, notifications)
+	if err != nil {
+		return responses, err
+	}
+
+	notificationMap := make(map[int64]domain.Notification, len(notifications))
+	for i := range notifications {
+		notificationMap[notifications[i].ID] = notifications[i]
+	}
+	for i := range responses {
+		if responses[i].Status != domain.SendStatusSucceeded {
+			continue
+		}
+		if n, ok := notificationMap[responses[i].NotificationID]; ok {
+			s.publish(ctx, n, responses[i].DeliveredChannel)
+		}
+	}
+	return responses, nil
+}
+
+// publish 推送只是尽力而为，失败时客户端重连后仍可按游标补齐
+// 以实际送达的渠道为准，降级到站内信的通知同样需要推送
+func (s *InboxPushSender) publish(ctx context.Context, notification domain.Notification, delivered domain.Channel) {
+	if delivered == "" {
+		delivered = notification.Channel
+	}
+	if !delivered.IsInApp() {
+		return
+	}
+	err := s.publisher.Publish(ctx, inbox.PushEvent{
+		BizID:          notification.BizID,
+		NotificationID: notification.ID,
+		Receivers:      notification.Receivers,
+	})
+	if err != nil {
+		s.logger.Warn("发布站内信推送事件失败", logger.Error(err), logger.Int64("notificationID", notification.ID))
+	}
+}
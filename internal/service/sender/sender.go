@@ -2,15 +2,20 @@ package sender
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/ecodeclub/ekit/pool"
 	"go-notification/internal/domain"
+	"go-notification/internal/errs"
 	"go-notification/internal/pkg/logger"
 	"go-notification/internal/repository"
 	"go-notification/internal/service/channel"
 	configSvc "go-notification/internal/service/config"
+	"go-notification/internal/service/frequencycap"
 	"go-notification/internal/service/notification/callback"
+	"slices"
 	"sync"
+	"time"
 )
 
 // NotificationSender 通知发送接口
@@ -23,48 +28,91 @@ type NotificationSender interface {
 	BatchSend(ctx context.Context, notifications []domain.Notification) ([]domain.SendResponse, error)
 }
 
+// maxErrMsgLen 接收者发送记录中错误描述的最大长度
+const maxErrMsgLen = 512
+
+// nonRetryableErrs 重试也不会成功的错误，发送失败时不自动重试
+var nonRetryableErrs = []error{
+	errs.ErrInvalidParameter,
+	errs.ErrInvalidReceiver,
+	errs.ErrTemplateNotFound,
+	errs.ErrTemplateVersionNotApprovedByPlatform,
+	errs.ErrTemplateVersionNotApprovedByProvider,
+}
+
 type sender struct {
-	repo        repository.NotificationRepository
-	configSvc   configSvc.BusinessConfigService
-	callbackSvc callback.Service
-	channel     channel.Channel
-	taskPool    pool.TaskPool
-	logger      logger.Logger
+	repo           repository.NotificationRepository
+	deliveryRepo   repository.DeliveryRepository
+	deadLetterRepo repository.DeadLetterRepository
+	configSvc      configSvc.BusinessConfigService
+	callbackSvc    callback.Service
+	channel        channel.Channel
+	frequencyCap   frequencycap.Service
+	taskPool       pool.TaskPool
+	logger         logger.Logger
 }
 
 // NewSender 创建通知发送器
 func NewSender(
 	repo repository.NotificationRepository,
+	deliveryRepo repository.DeliveryRepository,
+	deadLetterRepo repository.DeadLetterRepository,
 	configSvc configSvc.BusinessConfigService,
 	callbackSvc callback.Service,
 	channel channel.Channel,
+	frequencyCap frequencycap.Service,
 	taskPool pool.TaskPool,
 	logger logger.Logger,
 ) NotificationSender {
-	return &sender{repo: repo, configSvc: configSvc, callbackSvc: callbackSvc, channel: channel, taskPool: taskPool, logger: logger}
+	return &sender{repo: repo, deliveryRepo: deliveryRepo, deadLetterRepo: deadLetterRepo, configSvc: configSvc, callbackSvc: callbackSvc, channel: channel, frequencyCap: frequencyCap, taskPool: taskPool, logger: logger}
 }
 
 func (s *sender) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
-	resp := domain.SendResponse{
-		NotificationID: notification.ID,
+	if resp, capped, err := s.checkFrequencyCap(ctx, notification); capped {
+		return resp, err
 	}
-	_, err := s.channel.Send(ctx, notification)
-	if err != nil {
-		s.logger.Error("发送失败 %w", logger.Error(err))
-		resp.Status = domain.SendStatusFailed
-		notification.Status = domain.SendStatusFailed
+	resp, sendErr := s.send(ctx, notification)
+	notification.Status = resp.Status
+	notification.DeliveredChannel = resp.DeliveredChannel
+	notification.Deliveries = resp.Deliveries
+
+	if resp.Status == domain.SendStatusFailed && s.prepareRetry(ctx, &notification, sendErr) {
+		// 等待自动重试，最终结果确定后才回调业务方
+		err := s.repo.MarkRetry(domain.CtxWithTransitionError(ctx, sendErr), notification)
+		if errors.Is(err, errs.ErrNotificationVersionMismatch) {
+			s.logger.Warn("通知已经不是发送中状态，忽略本次发送结果",
+				logger.Int64("notificationID", notification.ID))
+			return resp, nil
+		}
+		if err != nil {
+			return domain.SendResponse{}, err
+		}
+		resp.Status = notification.Status
+		return resp, nil
+	}
+
+	var err error
+	if resp.Status == domain.SendStatusFailed {
 		// 如果是 FAILED，你需要把 quota 加回去
-		err = s.repo.MarkFailed(ctx, notification)
+		err = s.repo.MarkFailed(domain.CtxWithTransitionError(ctx, sendErr), notification)
 	} else {
-		resp.Status = domain.SendStatusSucceeded
-		notification.Status = domain.SendStatusSucceeded
 		err = s.repo.MarkSuccess(ctx, notification)
 	}
 
 	// 更新发送状态
+	if errors.Is(err, errs.ErrNotificationVersionMismatch) {
+		// 发送超时后已经被核对任务标记了最终状态，死信和回调都由核对任务处理
+		s.logger.Warn("通知已经不是发送中状态，忽略本次发送结果",
+			logger.Int64("notificationID", notification.ID))
+		return resp, nil
+	}
 	if err != nil {
 		return domain.SendResponse{}, err
 	}
+	if resp.Status == domain.SendStatusFailed {
+		s.frequencyCap.Release(ctx, notification)
+		s.saveDeadLetter(ctx, notification, sendErr)
+	}
 
 	// 得到准确的发送结果，发起回调，发送成功和失败都应该回调
 
@@ -78,8 +126,9 @@ func (s *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 	}
 
 	// 并发发送通知
-	var succeedMu, failedMu sync.Mutex
-	var succeeded, failed []domain.SendResponse
+	var succeedMu, failedMu, cappedMu sync.Mutex
+	var succeeded, failed, capped []domain.SendResponse
+	sendErrs := make(map[int64]error)
 
 	var wg sync.WaitGroup
 	wg.Add(len(notifications))
@@ -87,20 +136,22 @@ func (s *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 		n := notifications[i]
 		err := s.taskPool.Submit(ctx, pool.TaskFunc(func(ctx context.Context) error {
 			defer wg.Done()
-			_, err := s.channel.Send(ctx, n)
-			if err != nil {
-				resp := domain.SendResponse{
-					NotificationID: n.ID,
-					Status:         domain.SendStatusFailed,
+			if resp, ok, err := s.checkFrequencyCap(ctx, n); ok {
+				if err != nil {
+					s.logger.Warn("标记频率受限的通知失败", logger.Int64("notificationID", n.ID), logger.Error(err))
 				}
+				cappedMu.Lock()
+				capped = append(capped, resp)
+				cappedMu.Unlock()
+				return nil
+			}
+			resp, sendErr := s.send(ctx, n)
+			if resp.Status == domain.SendStatusFailed {
 				failedMu.Lock()
 				failed = append(failed, resp)
+				sendErrs[n.ID] = sendErr
 				failedMu.Unlock()
 			} else {
-				resp := domain.SendResponse{
-					NotificationID: n.ID,
-					Status:         domain.SendStatusSucceeded,
-				}
 				succeedMu.Lock()
 				succeeded = append(succeeded, resp)
 				succeedMu.Unlock()
@@ -133,17 +184,297 @@ func (s *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 
 	succeedNotifications := s.getUpdatedNotifications(succeeded, notificationsMap)
 	failedNotifications := s.getUpdatedNotifications(failed, notificationsMap)
+	failedNotifications = s.batchMarkRetry(ctx, failedNotifications, sendErrs, failed)
 
-	// 更新发送状态
-	err = s.batchUpdateStatus(ctx, succeedNotifications, failedNotifications)
+	// 更新发送状态，已经被超时核对等路径标记过的通知不再处理死信和回调
+	succeedNotifications, failedNotifications, err = s.batchUpdateStatus(ctx, succeedNotifications, failedNotifications)
 	if err != nil {
 		return nil, err
 	}
+	for i := range failedNotifications {
+		s.frequencyCap.Release(ctx, failedNotifications[i])
+		s.saveDeadLetter(ctx, failedNotifications[i], sendErrs[failedNotifications[i].ID])
+	}
 	// 得到准确的发送结果，发起调用，发送成功和发送失败都应该回调
 	_ = s.callbackSvc.SendCallbackByNotifications(ctx, append(succeedNotifications, failedNotifications...))
 
 	// 合并结果并返回
-	return append(succeeded, failed...), nil
+	return slices.Concat(succeeded, failed, capped), nil
+}
+
+// checkFrequencyCap 发送前检查接收者的发送频率，超过上限时标记为频率受限、归还额度并回调业务方，不再发送
+// 第二个返回值表示是否超过上限
+func (s *sender) checkFrequencyCap(ctx context.Context, notification domain.Notification) (domain.SendResponse, bool, error) {
+	if s.frequencyCap.Acquire(ctx, notification) {
+		return domain.SendResponse{}, false, nil
+	}
+	notification.Status = domain.SendStatusFrequencyCapped
+	resp := domain.SendResponse{NotificationID: notification.ID, Status: notification.Status}
+	err := s.repo.MarkFailed(domain.CtxWithTransitionError(ctx, errs.ErrFrequencyCapped), notification)
+	if errors.Is(err, errs.ErrNotificationVersionMismatch) {
+		s.logger.Warn("通知已经不是发送中状态，忽略频率检查结果",
+			logger.Int64("notificationID", notification.ID))
+		return resp, true, nil
+	}
+	if err != nil {
+		return resp, true, err
+	}
+	_ = s.callbackSvc.SendCallbackByNotification(ctx, notification)
+	return resp, true, nil
+}
+
+// send 只发送给尚未被供应商受理的接收者，并根据所有接收者的记录汇总发送状态
+// 业务方重试部分成功或失败的通知时，已经受理的接收者不会被重复发送
+// 返回的错误为本次提交供应商的错误，用于判断是否可以自动重试
+func (s *sender) send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	resp := domain.SendResponse{
+		NotificationID:   notification.ID,
+		DeliveredChannel: notification.DeliveredChannel,
+	}
+	existing, err := s.deliveryRepo.FindByNotificationIDs(ctx, []int64{notification.ID})
+	if err != nil {
+		s.logger.Warn("查询接收者发送记录失败，发送给全部接收者",
+			logger.Int64("notificationID", notification.ID),
+			logger.Error(err))
+	}
+	previous := existing[notification.ID]
+
+	target := notification
+	target.Receivers = domain.UnsubmittedReceivers(notification.Receivers, previous)
+	if len(target.Receivers) > 0 && domain.HasAttempted(target.Receivers, previous) {
+		// 上一次提交可能已经被供应商受理，只是没有拿到响应，重发前先核对
+		reconciled, er := s.reconcile(ctx, target)
+		if er != nil {
+			s.logger.Warn("向供应商核对失败，本次不重发",
+				logger.Int64("notificationID", notification.ID),
+				logger.Error(er))
+			resp.Deliveries = previous
+			resp.Status = domain.DeliveriesSendStatus(previous)
+			return resp, nil
+		}
+		previous = domain.MergeDeliveries(previous, reconciled)
+		target.Receivers = domain.UnsubmittedReceivers(target.Receivers, reconciled)
+	}
+
+	var results []domain.Delivery
+	var sendErr error
+	if len(target.Receivers) > 0 {
+		if err = s.deliveryRepo.IncrAttempts(ctx, target.ID, target.Receivers); err != nil {
+			s.logger.Warn("更新接收者提交次数失败",
+				logger.Int64("notificationID", notification.ID),
+				logger.Error(err))
+		}
+		var sent domain.SendResponse
+		sent, sendErr = s.deliver(ctx, target)
+		if sendErr != nil {
+			s.logger.Error("发送失败", logger.Int64("notificationID", notification.ID), logger.Error(sendErr))
+		} else {
+			resp.DeliveredChannel = sent.DeliveredChannel
+		}
+		results = s.receiverResults(target, sent, sendErr)
+		s.saveDeliveries(ctx, notification.ID, results)
+	}
+
+	resp.Deliveries = domain.MergeDeliveries(previous, results)
+	resp.Status = domain.DeliveriesSendStatus(resp.Deliveries)
+	return resp, sendErr
+}
+
+// prepareRetry 失败可以重试且没有超过重试策略的次数时，设置下一次自动重试的时间
+func (s *sender) prepareRetry(ctx context.Context, notification *domain.Notification, err error) bool {
+	if !retryable(err) {
+		return false
+	}
+	bizConfig, er := s.configSvc.GetByID(ctx, notification.BizID)
+	if er != nil {
+		s.logger.Warn("获取业务配置失败，不进行自动重试",
+			logger.Int64("bizID", notification.BizID),
+			logger.Error(er))
+		return false
+	}
+	interval, ok := notification.NextRetryInterval(bizConfig.ChannelConfig)
+	if !ok {
+		return false
+	}
+	notification.RetryCount++
+	notification.ScheduleResend(time.Now().Add(interval))
+	return true
+}
+
+// batchMarkRetry 标记可以自动重试的通知，返回最终失败的通知
+func (s *sender) batchMarkRetry(ctx context.Context, notifications []domain.Notification,
+	sendErrs map[int64]error, responses []domain.SendResponse,
+) []domain.Notification {
+	retrying := make(map[int64]domain.SendStatus, len(notifications))
+	final := make([]domain.Notification, 0, len(notifications))
+	for i := range notifications {
+		n := notifications[i]
+		if !s.prepareRetry(ctx, &n, sendErrs[n.ID]) {
+			final = append(final, notifications[i])
+			continue
+		}
+		if err := s.repo.MarkRetry(ctx, n); err != nil {
+			s.logger.Warn("标记通知自动重试失败",
+				logger.Int64("notificationID", n.ID),
+				logger.Error(err))
+			// 已经被超时核对标记了最终状态时不再更新，否则按最终失败处理
+			if !errors.Is(err, errs.ErrNotificationVersionMismatch) {
+				final = append(final, notifications[i])
+			}
+			continue
+		}
+		retrying[n.ID] = n.Status
+	}
+	for i := range responses {
+		if status, ok := retrying[responses[i].NotificationID]; ok {
+			responses[i].Status = status
+		}
+	}
+	return final
+}
+
+// saveDeadLetter 最终失败的通知写入死信，便于排查和重放
+func (s *sender) saveDeadLetter(ctx context.Context, notification domain.Notification, err error) {
+	// 重新查询接收者记录，带上累计的提交次数
+	if deliveries, er := s.deliveryRepo.FindByNotificationIDs(ctx, []int64{notification.ID}); er == nil && len(deliveries[notification.ID]) > 0 {
+		notification.Deliveries = deliveries[notification.ID]
+	}
+	if er := s.deadLetterRepo.Save(ctx, domain.NewDeadLetter(notification, err)); er != nil {
+		s.logger.Warn("写入死信失败",
+			logger.Int64("notificationID", notification.ID),
+			logger.Error(er))
+	}
+}
+
+// retryable 没有错误时说明发送结果未知或被供应商逐个拒绝，可以重试
+func retryable(err error) bool {
+	for _, target := range nonRetryableErrs {
+		if errors.Is(err, target) {
+			return false
+		}
+	}
+	return true
+}
+
+// reconcile 按幂等标识向供应商核对，返回已经被受理的接收者
+func (s *sender) reconcile(ctx context.Context, target domain.Notification) ([]domain.Delivery, error) {
+	accepted, err := s.channel.Reconcile(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	byReceiver := make(map[string]domain.Delivery, len(accepted))
+	for _, d := range accepted {
+		byReceiver[domain.TrimPhonePrefix(d.Receiver)] = d
+	}
+	results := make([]domain.Delivery, 0, len(accepted))
+	for _, receiver := range target.Receivers {
+		d, ok := byReceiver[domain.TrimPhonePrefix(receiver)]
+		if !ok {
+			continue
+		}
+		results = append(results, s.withReceiver(target, receiver, target.Channel, d))
+	}
+	s.saveDeliveries(ctx, target.ID, results)
+	return results, nil
+}
+
+// receiverResults 将发送结果展开到每个接收者，供应商没有区分接收者时视为全部受理
+func (s *sender) receiverResults(target domain.Notification, sent domain.SendResponse, err error) []domain.Delivery {
+	byReceiver := make(map[string]domain.Delivery, len(sent.Deliveries))
+	for _, d := range sent.Deliveries {
+		byReceiver[domain.TrimPhonePrefix(d.Receiver)] = d
+	}
+	ch := sent.DeliveredChannel
+	if ch == "" {
+		ch = target.Channel
+	}
+
+	results := make([]domain.Delivery, 0, len(target.Receivers))
+	for _, receiver := range target.Receivers {
+		d, ok := byReceiver[domain.TrimPhonePrefix(receiver)]
+		switch {
+		case err != nil:
+			d = domain.Delivery{Status: domain.DeliveryStatusSubmitFailed, ErrMsg: truncate(err.Error(), maxErrMsgLen)}
+		case !ok:
+			d = domain.Delivery{Status: domain.DeliveryStatusSubmitted}
+		}
+		results = append(results, s.withReceiver(target, receiver, ch, d))
+	}
+	return results
+}
+
+// withReceiver 以通知中的接收者为准，保证能覆盖创建通知时生成的记录
+func (s *sender) withReceiver(target domain.Notification, receiver string, ch domain.Channel, d domain.Delivery) domain.Delivery {
+	d.NotificationID = target.ID
+	d.BizID = target.BizID
+	d.Receiver = receiver
+	d.Channel = ch
+	d.ErrMsg = truncate(d.ErrMsg, maxErrMsgLen)
+	return d
+}
+
+func (s *sender) saveDeliveries(ctx context.Context, notificationID int64, deliveries []domain.Delivery) {
+	if err := s.deliveryRepo.BatchCreate(ctx, deliveries); err != nil {
+		s.logger.Warn("更新接收者发送记录失败",
+			logger.Int64("notificationID", notificationID),
+			logger.Error(err))
+	}
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
+
+// deliver 通过通知指定的渠道发送，响应中带上实际送达的渠道
+// 业务方开启渠道降级后，主渠道发送失败或被禁用时，按优先级尝试其他启用且关联了模板的渠道
+func (s *sender) deliver(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	cfg := s.fallbackConfig(ctx, notification)
+	if cfg == nil {
+		resp, err := s.channel.Send(ctx, notification)
+		resp.DeliveredChannel = notification.Channel
+		return resp, err
+	}
+
+	err := fmt.Errorf("%w: 没有可用的降级渠道", errs.ErrNoAvailableChannel)
+	for _, ch := range cfg.FallbackChannels(notification.Channel) {
+		// 没有关联模板或接收者格式不符合的渠道直接跳过
+		n, fbErr := notification.WithFallbackChannel(ch)
+		if fbErr != nil {
+			continue
+		}
+		var resp domain.SendResponse
+		if resp, err = s.channel.Send(ctx, n); err == nil {
+			resp.DeliveredChannel = ch
+			return resp, nil
+		}
+		s.logger.Warn("渠道发送失败，尝试降级",
+			logger.Int64("notificationID", notification.ID),
+			logger.String("channel", ch.String()),
+			logger.Error(err))
+	}
+	return domain.SendResponse{}, err
+}
+
+// fallbackConfig 业务方未开启渠道降级时返回 nil
+func (s *sender) fallbackConfig(ctx context.Context, notification domain.Notification) *domain.ChannelConfig {
+	if len(notification.FallbackTemplates) == 0 {
+		return nil
+	}
+	bizConfig, err := s.configSvc.GetByID(ctx, notification.BizID)
+	if err != nil {
+		s.logger.Warn("获取业务配置失败，不进行渠道降级",
+			logger.Int64("bizID", notification.BizID),
+			logger.Error(err))
+		return nil
+	}
+	if bizConfig.ChannelConfig == nil || !bizConfig.ChannelConfig.Fallback {
+		return nil
+	}
+	return bizConfig.ChannelConfig
 }
 
 // getUpdatedNotifications 获取更新字段后的实体
@@ -152,23 +483,30 @@ func (s *sender) getUpdatedNotifications(responses []domain.SendResponse, notifi
 	for i := range responses {
 		if n, ok := notificationsMap[responses[i].NotificationID]; ok {
 			n.Status = responses[i].Status
+			n.DeliveredChannel = responses[i].DeliveredChannel
+			n.Deliveries = responses[i].Deliveries
 			notifications = append(notifications, n)
 		}
 	}
 	return notifications
 }
 
-func (s *sender) batchUpdateStatus(ctx context.Context, succeedNotifications []domain.Notification, failedNotifications []domain.Notification) error {
-	if len(succeedNotifications) > 0 || len(succeedNotifications) > 0 {
-		err := s.repo.BatchUpdateStatusSucceededOrFailed(ctx, succeedNotifications, failedNotifications)
-		if err != nil {
-			s.logger.Warn("批量更新通知状态失败",
-				logger.Error(err),
-				logger.Any("succeddNotifications", succeedNotifications),
-				logger.Any("failedNotifications", failedNotifications),
-			)
-			return fmt.Errorf("批量更新通知状态失败：%w", err)
-		}
+// batchUpdateStatus 批量更新状态，返回实际更新了状态的成功和失败通知
+func (s *sender) batchUpdateStatus(ctx context.Context, succeedNotifications []domain.Notification, failedNotifications []domain.Notification) ([]domain.Notification, []domain.Notification, error) {
+	if len(succeedNotifications) == 0 && len(failedNotifications) == 0 {
+		return nil, nil, nil
+	}
+	ids, err := s.repo.BatchUpdateStatusSucceededOrFailed(ctx, succeedNotifications, failedNotifications)
+	if err != nil {
+		s.logger.Warn("批量更新通知状态失败",
+			logger.Error(err),
+			logger.Any("succeddNotifications", succeedNotifications),
+			logger.Any("failedNotifications", failedNotifications),
+		)
+		return nil, nil, fmt.Errorf("批量更新通知状态失败：%w", err)
+	}
+	notUpdated := func(n domain.Notification) bool {
+		return !slices.Contains(ids, n.ID)
 	}
-	return nil
+	return slices.DeleteFunc(succeedNotifications, notUpdated), slices.DeleteFunc(failedNotifications, notUpdated), nil
 }
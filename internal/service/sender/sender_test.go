@@ -0,0 +1,691 @@
+package sender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/pkg/retry"
+	"go-notification/internal/repository"
+	configsvc "go-notification/internal/service/config"
+	"go-notification/internal/service/frequencycap"
+	"go-notification/internal/service/notification/callback"
+
+	"github.com/ecodeclub/ekit/pool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRepo struct {
+	repository.NotificationRepository
+	// finished 为 true 时通知已经被超时核对标记了最终状态
+	finished  bool
+	succeeded []domain.Notification
+	failed    []domain.Notification
+	retrying  []domain.Notification
+	// finishedIDs 中的通知已经被超时核对标记了最终状态，批量更新时跳过
+	finishedIDs map[int64]bool
+}
+
+func (r *fakeRepo) BatchGetByID(_ context.Context, ids []int64) (map[int64]domain.Notification, error) {
+	res := make(map[int64]domain.Notification, len(ids))
+	for _, id := range ids {
+		res[id] = domain.Notification{ID: id, BizID: 2, Channel: domain.ChannelSMS, Version: 1}
+	}
+	return res, nil
+}
+
+func (r *fakeRepo) BatchUpdateStatusSucceededOrFailed(_ context.Context, succeeded, failed []domain.Notification) ([]int64, error) {
+	var ids []int64
+	for _, n := range succeeded {
+		if !r.finishedIDs[n.ID] {
+			r.succeeded = append(r.succeeded, n)
+			ids = append(ids, n.ID)
+		}
+	}
+	for _, n := range failed {
+		if !r.finishedIDs[n.ID] {
+			r.failed = append(r.failed, n)
+			ids = append(ids, n.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeRepo) MarkSuccess(_ context.Context, n domain.Notification) error {
+	if r.finished {
+		return fmt.Errorf("%w: 通知 %d 不是发送中状态", errs.ErrNotificationVersionMismatch, n.ID)
+	}
+	r.succeeded = append(r.succeeded, n)
+	return nil
+}
+
+func (r *fakeRepo) MarkFailed(_ context.Context, n domain.Notification) error {
+	if r.finished {
+		return fmt.Errorf("%w: 通知 %d 不是发送中状态", errs.ErrNotificationVersionMismatch, n.ID)
+	}
+	r.failed = append(r.failed, n)
+	return nil
+}
+
+func (r *fakeRepo) MarkRetry(_ context.Context, n domain.Notification) error {
+	r.retrying = append(r.retrying, n)
+	return nil
+}
+
+// fakeDeliveryRepo 按接收者保存发送记录
+type fakeDeliveryRepo struct {
+	repository.DeliveryRepository
+	deliveries map[string]domain.Delivery
+}
+
+func (r *fakeDeliveryRepo) BatchCreate(_ context.Context, deliveries []domain.Delivery) error {
+	for _, d := range deliveries {
+		// 与数据库一致，覆盖记录时保留提交次数
+		d.Attempts = r.deliveries[d.Receiver].Attempts
+		r.deliveries[d.Receiver] = d
+	}
+	return nil
+}
+
+func (r *fakeDeliveryRepo) IncrAttempts(_ context.Context, notificationID int64, receivers []string) error {
+	for _, receiver := range receivers {
+		d := r.deliveries[receiver]
+		d.NotificationID = notificationID
+		d.Receiver = receiver
+		d.Attempts++
+		r.deliveries[receiver] = d
+	}
+	return nil
+}
+
+func (r *fakeDeliveryRepo) FindByNotificationIDs(_ context.Context, ids []int64) (map[int64][]domain.Delivery, error) {
+	res := make(map[int64][]domain.Delivery)
+	for _, d := range r.deliveries {
+		res[d.NotificationID] = append(res[d.NotificationID], d)
+	}
+	return res, nil
+}
+
+type fakeDeadLetterRepo struct {
+	repository.DeadLetterRepository
+	saved []domain.DeadLetter
+}
+
+func (r *fakeDeadLetterRepo) Save(_ context.Context, dl domain.DeadLetter) error {
+	r.saved = append(r.saved, dl)
+	return nil
+}
+
+type fakeConfigService struct {
+	configsvc.BusinessConfigService
+	cfg *domain.ChannelConfig
+}
+
+func (f *fakeConfigService) GetByID(_ context.Context, id int64) (domain.BusinessConfig, error) {
+	return domain.BusinessConfig{ID: id, ChannelConfig: f.cfg}, nil
+}
+
+type fakeCallbackService struct {
+	callback.Service
+	notifications []domain.Notification
+}
+
+func (f *fakeCallbackService) SendCallbackByNotification(_ context.Context, n domain.Notification) error {
+	f.notifications = append(f.notifications, n)
+	return nil
+}
+
+func (f *fakeCallbackService) SendCallbackByNotifications(_ context.Context, ns []domain.Notification) error {
+	f.notifications = append(f.notifications, ns...)
+	return nil
+}
+
+// fakeFrequencyCap capped 为 true 时所有通知都超过上限，released 记录撤销了发送次数的通知
+type fakeFrequencyCap struct {
+	frequencycap.Service
+	capped   bool
+	released []int64
+}
+
+func (f *fakeFrequencyCap) Acquire(_ context.Context, _ domain.Notification) bool {
+	return !f.capped
+}
+
+func (f *fakeFrequencyCap) Release(_ context.Context, n domain.Notification) {
+	f.released = append(f.released, n.ID)
+}
+
+// syncTaskPool 在提交时同步执行任务
+type syncTaskPool struct {
+	pool.TaskPool
+}
+
+func (syncTaskPool) Submit(ctx context.Context, task pool.Task) error {
+	return task.Run(ctx)
+}
+
+// fakeChannel 记录每次发送使用的渠道和模板，failing 中的渠道发送失败，rejected 中的接收者被供应商拒绝
+// accepted 中的接收者在核对时视为供应商已经受理，sendErr 为发送失败时返回的错误
+type fakeChannel struct {
+	failing      map[domain.Channel]bool
+	sendErr      error
+	rejected     map[string]bool
+	accepted     map[string]bool
+	reconcileErr error
+	tried        []domain.Notification
+}
+
+func (c *fakeChannel) Reconcile(_ context.Context, n domain.Notification) ([]domain.Delivery, error) {
+	if c.reconcileErr != nil {
+		return nil, c.reconcileErr
+	}
+	var res []domain.Delivery
+	for _, r := range n.Receivers {
+		if c.accepted[r] {
+			res = append(res, domain.Delivery{Receiver: r, Status: domain.DeliveryStatusWaiting, SerialNo: "serial-" + r})
+		}
+	}
+	return res, nil
+}
+
+func (c *fakeChannel) Send(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+	c.tried = append(c.tried, n)
+	if c.failing[n.Channel] {
+		if c.sendErr != nil {
+			return domain.SendResponse{}, c.sendErr
+		}
+		return domain.SendResponse{}, errors.New("mock error")
+	}
+	resp := domain.SendResponse{NotificationID: n.ID, Status: domain.SendStatusSucceeded}
+	if len(c.rejected) == 0 {
+		return resp, nil
+	}
+	for _, r := range n.Receivers {
+		d := domain.Delivery{Receiver: r, Status: domain.DeliveryStatusWaiting}
+		if c.rejected[r] {
+			d.Status = domain.DeliveryStatusSubmitFailed
+			resp.Status = domain.SendStatusPartiallySucceeded
+		}
+		resp.Deliveries = append(resp.Deliveries, d)
+	}
+	return resp, nil
+}
+
+func TestSender_SendWithFallback(t *testing.T) {
+	t.Parallel()
+
+	fallbackCfg := &domain.ChannelConfig{
+		Fallback: true,
+		Channels: []domain.ChannelItem{
+			{Channel: "SMS", Priority: 1, Enabled: true},
+			{Channel: "IM", Priority: 3, Enabled: true},
+			{Channel: "IN_APP", Priority: 2, Enabled: true},
+		},
+	}
+	fallbackTemplates := map[domain.Channel]int64{
+		domain.ChannelIM:    20,
+		domain.ChannelInApp: 30,
+	}
+
+	testCases := []struct {
+		name          string
+		cfg           *domain.ChannelConfig
+		templates     map[domain.Channel]int64
+		failing       map[domain.Channel]bool
+		wantStatus    domain.SendStatus
+		wantDelivered domain.Channel
+		wantTried     []int64 // 依次尝试的模板ID
+	}{
+		{
+			name:          "主渠道成功",
+			cfg:           fallbackCfg,
+			templates:     fallbackTemplates,
+			wantStatus:    domain.SendStatusSucceeded,
+			wantDelivered: domain.ChannelSMS,
+			wantTried:     []int64{10},
+		},
+		{
+			name:          "按优先级降级",
+			cfg:           fallbackCfg,
+			templates:     fallbackTemplates,
+			failing:       map[domain.Channel]bool{domain.ChannelSMS: true},
+			wantStatus:    domain.SendStatusSucceeded,
+			wantDelivered: domain.ChannelInApp,
+			wantTried:     []int64{10, 30},
+		},
+		{
+			name: "主渠道被禁用",
+			cfg: &domain.ChannelConfig{
+				Fallback: true,
+				Channels: []domain.ChannelItem{
+					{Channel: "SMS", Priority: 1, Enabled: false},
+					{Channel: "IM", Priority: 2, Enabled: true},
+				},
+			},
+			templates:     fallbackTemplates,
+			wantStatus:    domain.SendStatusSucceeded,
+			wantDelivered: domain.ChannelIM,
+			wantTried:     []int64{20},
+		},
+		{
+			name:          "跳过没有关联模板的渠道",
+			cfg:           fallbackCfg,
+			templates:     map[domain.Channel]int64{domain.ChannelIM: 20},
+			failing:       map[domain.Channel]bool{domain.ChannelSMS: true},
+			wantStatus:    domain.SendStatusSucceeded,
+			wantDelivered: domain.ChannelIM,
+			wantTried:     []int64{10, 20},
+		},
+		{
+			name: "跳过接收者格式不符合的渠道",
+			cfg: &domain.ChannelConfig{
+				Fallback: true,
+				Channels: []domain.ChannelItem{
+					{Channel: "SMS", Priority: 1, Enabled: true},
+					{Channel: "EMAIL", Priority: 2, Enabled: true},
+					{Channel: "IN_APP", Priority: 3, Enabled: true},
+				},
+			},
+			// 手机号不能作为邮箱地址，邮件渠道被跳过
+			templates:     map[domain.Channel]int64{domain.ChannelEmail: 40, domain.ChannelInApp: 30},
+			failing:       map[domain.Channel]bool{domain.ChannelSMS: true},
+			wantStatus:    domain.SendStatusSucceeded,
+			wantDelivered: domain.ChannelInApp,
+			wantTried:     []int64{10, 30},
+		},
+		{
+			name:       "所有渠道都失败",
+			cfg:        fallbackCfg,
+			templates:  fallbackTemplates,
+			failing:    map[domain.Channel]bool{domain.ChannelSMS: true, domain.ChannelIM: true, domain.ChannelInApp: true},
+			wantStatus: domain.SendStatusFailed,
+			wantTried:  []int64{10, 30, 20},
+		},
+		{
+			name:       "业务未开启降级",
+			cfg:        &domain.ChannelConfig{Channels: fallbackCfg.Channels},
+			templates:  fallbackTemplates,
+			failing:    map[domain.Channel]bool{domain.ChannelSMS: true},
+			wantStatus: domain.SendStatusFailed,
+			wantTried:  []int64{10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeRepo{}
+			callbackSvc := &fakeCallbackService{}
+			ch := &fakeChannel{failing: tc.failing}
+			deliveryRepo := &fakeDeliveryRepo{deliveries: map[string]domain.Delivery{}}
+			s := NewSender(repo, deliveryRepo, &fakeDeadLetterRepo{}, &fakeConfigService{cfg: tc.cfg}, callbackSvc, ch, &fakeFrequencyCap{}, nil, logger.NewNopLogger())
+
+			resp, err := s.Send(context.Background(), domain.Notification{
+				ID:                1,
+				BizID:             2,
+				Receivers:         []string{"13800000000"},
+				Channel:           domain.ChannelSMS,
+				Template:          domain.Template{ID: 10, VersionID: 11},
+				FallbackTemplates: tc.templates,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, resp.Status)
+			assert.Equal(t, tc.wantDelivered, resp.DeliveredChannel)
+
+			tried := make([]int64, 0, len(ch.tried))
+			for _, n := range ch.tried {
+				tried = append(tried, n.Template.ID)
+			}
+			assert.Equal(t, tc.wantTried, tried)
+
+			require.Len(t, callbackSvc.notifications, 1)
+			assert.Equal(t, tc.wantDelivered, callbackSvc.notifications[0].DeliveredChannel)
+			if tc.wantStatus == domain.SendStatusSucceeded {
+				require.Len(t, repo.succeeded, 1)
+				assert.Equal(t, tc.wantDelivered, repo.succeeded[0].DeliveredChannel)
+			}
+		})
+	}
+}
+
+func TestSender_RetryOnlyFailedReceivers(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeRepo{}
+	deliveryRepo := &fakeDeliveryRepo{deliveries: map[string]domain.Delivery{}}
+	ch := &fakeChannel{rejected: map[string]bool{"13800000002": true}}
+	s := NewSender(repo, deliveryRepo, &fakeDeadLetterRepo{}, &fakeConfigService{}, &fakeCallbackService{}, ch, &fakeFrequencyCap{}, nil, logger.NewNopLogger())
+	n := domain.Notification{
+		ID:        1,
+		BizID:     2,
+		Receivers: []string{"13800000001", "13800000002"},
+		Channel:   domain.ChannelSMS,
+		Template:  domain.Template{ID: 10, VersionID: 11},
+	}
+
+	// 第一次发送，一个号码被供应商拒绝
+	resp, err := s.Send(t.Context(), n)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusPartiallySucceeded, resp.Status)
+	assert.Len(t, resp.Deliveries, 2)
+	require.Len(t, repo.succeeded, 1)
+	assert.Equal(t, domain.SendStatusPartiallySucceeded, repo.succeeded[0].Status)
+
+	// 业务方重试，只发送失败的号码
+	ch.rejected = map[string]bool{}
+	resp, err = s.Send(t.Context(), n)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+	require.Len(t, ch.tried, 2)
+	assert.Equal(t, []string{"13800000002"}, ch.tried[1].Receivers)
+	assert.Equal(t, domain.DeliveryStatusSubmitted, deliveryRepo.deliveries["13800000002"].Status)
+	assert.Equal(t, domain.DeliveryStatusWaiting, deliveryRepo.deliveries["13800000001"].Status)
+}
+
+func TestSender_SendAfterTimeoutReconciled(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeRepo{finished: true}
+	deadLetterRepo := &fakeDeadLetterRepo{}
+	callbackSvc := &fakeCallbackService{}
+	ch := &fakeChannel{failing: map[domain.Channel]bool{domain.ChannelSMS: true}, sendErr: errs.ErrInvalidReceiver}
+	s := NewSender(repo, &fakeDeliveryRepo{deliveries: map[string]domain.Delivery{}}, deadLetterRepo,
+		&fakeConfigService{}, callbackSvc, ch, &fakeFrequencyCap{}, nil, logger.NewNopLogger())
+
+	// 发送结果晚于超时核对到达，以核对结果为准，不再写死信和回调
+	resp, err := s.Send(t.Context(), domain.Notification{
+		ID:        1,
+		BizID:     2,
+		Receivers: []string{"13800000001"},
+		Channel:   domain.ChannelSMS,
+		Template:  domain.Template{ID: 10, VersionID: 11},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusFailed, resp.Status)
+	assert.Empty(t, repo.failed)
+	assert.Empty(t, deadLetterRepo.saved)
+	assert.Empty(t, callbackSvc.notifications)
+}
+
+func TestSender_ReconcileBeforeResend(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		accepted     map[string]bool
+		reconcileErr error
+		wantStatus   domain.SendStatus
+		wantTried    [][]string
+	}{
+		{
+			name:       "供应商已受理部分号码，只重发未受理的",
+			accepted:   map[string]bool{"13800000001": true},
+			wantStatus: domain.SendStatusSucceeded,
+			wantTried:  [][]string{{"13800000001", "13800000002"}, {"13800000002"}},
+		},
+		{
+			name:       "供应商已受理全部号码，不再重发",
+			accepted:   map[string]bool{"13800000001": true, "13800000002": true},
+			wantStatus: domain.SendStatusSucceeded,
+			wantTried:  [][]string{{"13800000001", "13800000002"}},
+		},
+		{
+			name:         "核对失败，本次不重发",
+			reconcileErr: errors.New("mock error"),
+			wantStatus:   domain.SendStatusFailed,
+			wantTried:    [][]string{{"13800000001", "13800000002"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeRepo{}
+			deliveryRepo := &fakeDeliveryRepo{deliveries: map[string]domain.Delivery{}}
+			ch := &fakeChannel{failing: map[domain.Channel]bool{domain.ChannelSMS: true}}
+			s := NewSender(repo, deliveryRepo, &fakeDeadLetterRepo{}, &fakeConfigService{}, &fakeCallbackService{}, ch, &fakeFrequencyCap{}, nil, logger.NewNopLogger())
+			n := domain.Notification{
+				ID:        1,
+				BizID:     2,
+				Receivers: []string{"13800000001", "13800000002"},
+				Channel:   domain.ChannelSMS,
+				Template:  domain.Template{ID: 10, VersionID: 11},
+			}
+
+			// 第一次提交没有拿到供应商的响应，但供应商实际可能已经受理
+			resp, err := s.Send(t.Context(), n)
+			require.NoError(t, err)
+			assert.Equal(t, domain.SendStatusFailed, resp.Status)
+
+			ch.failing = nil
+			ch.accepted = tc.accepted
+			ch.reconcileErr = tc.reconcileErr
+			resp, err = s.Send(t.Context(), n)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, resp.Status)
+
+			tried := make([][]string, 0, len(ch.tried))
+			for _, n := range ch.tried {
+				tried = append(tried, n.Receivers)
+			}
+			assert.Equal(t, tc.wantTried, tried)
+			for r := range tc.accepted {
+				assert.Equal(t, "serial-"+r, deliveryRepo.deliveries[r].SerialNo)
+				assert.Equal(t, 1, deliveryRepo.deliveries[r].Attempts)
+			}
+		})
+	}
+}
+
+func TestSender_AutoRetry(t *testing.T) {
+	t.Parallel()
+
+	cfg := &domain.ChannelConfig{
+		RetryPolicy: &retry.Config{
+			Type:          "fixed",
+			FixedInterval: &retry.FixedIntervalConfig{MaxRetries: 2, Interval: time.Minute},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		cfg          *domain.ChannelConfig
+		retryCount   int32
+		sendErr      error
+		wantRetry    bool
+		wantStatus   domain.SendStatus
+		wantCallback bool
+		wantCategory domain.ErrCategory
+	}{
+		{
+			name:       "可重试的错误等待自动重试，不回调",
+			cfg:        cfg,
+			wantRetry:  true,
+			wantStatus: domain.SendStatusPending,
+		},
+		{
+			name:         "达到最大重试次数后标记失败并回调",
+			cfg:          cfg,
+			retryCount:   2,
+			wantStatus:   domain.SendStatusFailed,
+			wantCallback: true,
+			wantCategory: domain.ErrCategoryProviderError,
+		},
+		{
+			name:         "接收者无效不重试",
+			cfg:          cfg,
+			sendErr:      fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, errs.ErrInvalidReceiver),
+			wantStatus:   domain.SendStatusFailed,
+			wantCallback: true,
+			wantCategory: domain.ErrCategoryInvalidReceiver,
+		},
+		{
+			name:         "模板被供应商拒绝不重试",
+			cfg:          cfg,
+			sendErr:      fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, errs.ErrTemplateVersionNotApprovedByProvider),
+			wantStatus:   domain.SendStatusFailed,
+			wantCallback: true,
+			wantCategory: domain.ErrCategoryTemplate,
+		},
+		{
+			name:         "没有配置重试策略",
+			wantStatus:   domain.SendStatusFailed,
+			wantCallback: true,
+			wantCategory: domain.ErrCategoryProviderError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeRepo{}
+			cb := &fakeCallbackService{}
+			ch := &fakeChannel{failing: map[domain.Channel]bool{domain.ChannelSMS: true}, sendErr: tc.sendErr}
+			deliveryRepo := &fakeDeliveryRepo{deliveries: map[string]domain.Delivery{}}
+			deadLetterRepo := &fakeDeadLetterRepo{}
+			s := NewSender(repo, deliveryRepo, deadLetterRepo, &fakeConfigService{cfg: tc.cfg}, cb, ch, &fakeFrequencyCap{}, nil, logger.NewNopLogger())
+
+			resp, err := s.Send(t.Context(), domain.Notification{
+				ID:         1,
+				BizID:      2,
+				Receivers:  []string{"13800000001"},
+				Channel:    domain.ChannelSMS,
+				Template:   domain.Template{ID: 10, VersionID: 11},
+				RetryCount: tc.retryCount,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, resp.Status)
+
+			if tc.wantRetry {
+				require.Len(t, repo.retrying, 1)
+				assert.Equal(t, tc.retryCount+1, repo.retrying[0].RetryCount)
+				assert.True(t, repo.retrying[0].ScheduledSTime.After(time.Now()))
+				// 调度积压时重试也不能过期
+				assert.GreaterOrEqual(t, repo.retrying[0].ScheduledETime.Sub(repo.retrying[0].ScheduledSTime), time.Hour)
+				assert.Empty(t, repo.failed)
+			} else {
+				assert.Empty(t, repo.retrying)
+				assert.Len(t, repo.failed, 1)
+			}
+			assert.Equal(t, tc.wantCallback, len(cb.notifications) == 1)
+
+			// 最终失败时写入死信，等待重试时不写入
+			if tc.wantCategory == "" {
+				assert.Empty(t, deadLetterRepo.saved)
+				return
+			}
+			require.Len(t, deadLetterRepo.saved, 1)
+			dl := deadLetterRepo.saved[0]
+			assert.Equal(t, int64(1), dl.NotificationID)
+			assert.Equal(t, tc.wantCategory, dl.ErrCategory)
+			assert.Equal(t, tc.retryCount, dl.RetryCount)
+			require.Len(t, dl.Attempts, 1)
+			assert.Equal(t, 1, dl.Attempts[0].Attempts)
+		})
+	}
+}
+
+func TestSender_BatchSendAfterTimeoutReconciled(t *testing.T) {
+	t.Parallel()
+
+	// 通知 2 已经被超时核对标记了最终状态，以核对结果为准，不再写死信和回调
+	repo := &fakeRepo{finishedIDs: map[int64]bool{2: true}}
+	deadLetterRepo := &fakeDeadLetterRepo{}
+	callbackSvc := &fakeCallbackService{}
+	ch := &fakeChannel{failing: map[domain.Channel]bool{domain.ChannelSMS: true}, sendErr: errs.ErrInvalidReceiver}
+	s := NewSender(repo, &fakeDeliveryRepo{deliveries: map[string]domain.Delivery{}}, deadLetterRepo,
+		&fakeConfigService{}, callbackSvc, ch, &fakeFrequencyCap{}, syncTaskPool{}, logger.NewNopLogger())
+
+	notifications := make([]domain.Notification, 0, 2)
+	for _, id := range []int64{1, 2} {
+		notifications = append(notifications, domain.Notification{
+			ID:        id,
+			BizID:     2,
+			Receivers: []string{fmt.Sprintf("1380000000%d", id)},
+			Channel:   domain.ChannelSMS,
+			Template:  domain.Template{ID: 10, VersionID: 11},
+		})
+	}
+	resps, err := s.BatchSend(t.Context(), notifications)
+	require.NoError(t, err)
+	assert.Len(t, resps, 2)
+
+	require.Len(t, repo.failed, 1)
+	assert.Equal(t, int64(1), repo.failed[0].ID)
+	require.Len(t, deadLetterRepo.saved, 1)
+	assert.Equal(t, int64(1), deadLetterRepo.saved[0].NotificationID)
+	require.Len(t, callbackSvc.notifications, 1)
+	assert.Equal(t, int64(1), callbackSvc.notifications[0].ID)
+}
+
+func TestSender_FrequencyCap(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		capped       bool
+		failing      bool
+		wantStatus   domain.SendStatus
+		wantSent     bool
+		wantReleased []int64
+	}{
+		{
+			name:       "超过上限时标记为频率受限，不发送",
+			capped:     true,
+			wantStatus: domain.SendStatusFrequencyCapped,
+		},
+		{
+			name:       "发送成功时计入发送次数",
+			wantStatus: domain.SendStatusSucceeded,
+			wantSent:   true,
+		},
+		{
+			name:         "最终发送失败时撤销发送次数",
+			failing:      true,
+			wantStatus:   domain.SendStatusFailed,
+			wantSent:     true,
+			wantReleased: []int64{1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeRepo{}
+			cb := &fakeCallbackService{}
+			frequencyCap := &fakeFrequencyCap{capped: tc.capped}
+			ch := &fakeChannel{failing: map[domain.Channel]bool{domain.ChannelSMS: tc.failing}, sendErr: errs.ErrInvalidReceiver}
+			s := NewSender(repo, &fakeDeliveryRepo{deliveries: map[string]domain.Delivery{}}, &fakeDeadLetterRepo{},
+				&fakeConfigService{}, cb, ch, frequencyCap, nil, logger.NewNopLogger())
+
+			resp, err := s.Send(t.Context(), domain.Notification{
+				ID:        1,
+				BizID:     2,
+				Receivers: []string{"13800000001"},
+				Channel:   domain.ChannelSMS,
+				Template:  domain.Template{ID: 10, VersionID: 11},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, resp.Status)
+			assert.Equal(t, tc.wantSent, len(ch.tried) > 0)
+			assert.Equal(t, tc.wantReleased, frequencyCap.released)
+			// 频率受限和发送失败一样归还额度并回调业务方
+			require.Len(t, cb.notifications, 1)
+			assert.Equal(t, tc.wantStatus, cb.notifications[0].Status)
+			if tc.capped {
+				require.Len(t, repo.failed, 1)
+				assert.Equal(t, domain.SendStatusFrequencyCapped, repo.failed[0].Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package sendstrategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/repository"
+)
+
+// DigestSendStrategy 汇总发送策略，只保存等待合并的通知，由汇总任务到点合并为一条汇总通知发送
+// 等待合并的通知不扣减额度，也不计入发送频率，合并后的汇总通知按一条通知扣减额度
+type DigestSendStrategy struct {
+	repo repository.NotificationRepository
+}
+
+func NewDigestSendStrategy(repo repository.NotificationRepository) *DigestSendStrategy {
+	return &DigestSendStrategy{repo: repo}
+}
+
+// Send 保存等待合并的通知
+func (d *DigestSendStrategy) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	notification.SetSendTime()
+	created, err := d.repo.CreateDigestItem(ctx, notification)
+	if err == nil {
+		return domain.SendResponse{
+			NotificationID: created.ID,
+			Status:         created.Status,
+		}, nil
+	}
+	if !errors.Is(err, errs.ErrNotificationDuplicate) {
+		return domain.SendResponse{}, fmt.Errorf("创建汇总通知失败: %w", err)
+	}
+
+	// 唯一索引冲突表示业务方重试，返回已有通知的状态
+	found, err := d.repo.GetByKey(ctx, notification.BizID, notification.Key)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("获取通知失败: %w", err)
+	}
+	return domain.SendResponse{
+		NotificationID: found.ID,
+		Status:         found.Status,
+	}, nil
+}
+
+// BatchSend 逐条保存等待合并的通知
+func (d *DigestSendStrategy) BatchSend(ctx context.Context, notifications []domain.Notification) ([]domain.SendResponse, error) {
+	if len(notifications) == 0 {
+		return nil, fmt.Errorf("%w: 通知列表不能为空", errs.ErrInvalidParameter)
+	}
+	responses := make([]domain.SendResponse, 0, len(notifications))
+	for i := range notifications {
+		resp, err := d.Send(ctx, notifications[i])
+		if err != nil {
+			return responses, err
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
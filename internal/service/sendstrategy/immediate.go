@@ -7,22 +7,31 @@ import (
 	"go-notification/internal/domain"
 	"go-notification/internal/errs"
 	"go-notification/internal/repository"
+	"go-notification/internal/service/quiethours"
 	"go-notification/internal/service/sender"
+	"time"
 )
 
 // ImmediateSendStrategy 立即发送策略
 // 同步立刻发送，异步接口选择了这个立即发送策略也不会生效
+// 落在业务方免打扰时段内的通知不立即发送，创建为待发送的通知，由调度在时段结束后发送
 type ImmediateSendStrategy struct {
-	repo   repository.NotificationRepository
-	sender sender.NotificationSender
+	repo       repository.NotificationRepository
+	sender     sender.NotificationSender
+	quietHours quiethours.Service
 }
 
-func NewImmediateSendStrategy(repo repository.NotificationRepository, sender sender.NotificationSender) *ImmediateSendStrategy {
-	return &ImmediateSendStrategy{repo: repo, sender: sender}
+func NewImmediateSendStrategy(repo repository.NotificationRepository, sender sender.NotificationSender, quietHours quiethours.Service) *ImmediateSendStrategy {
+	return &ImmediateSendStrategy{repo: repo, sender: sender, quietHours: quietHours}
 }
 
 func (i *ImmediateSendStrategy) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	notification.SetSendTime()
+	if at := i.quietHours.Defer(ctx, []domain.Notification{notification}, time.Now())[0]; !at.IsZero() {
+		return i.deferSend(ctx, notification, at)
+	}
+	// 创建即为发送中，业务方不能再取消或改期
+	notification.Status = domain.SendStatusSending
 	created, err := i.repo.Create(ctx, notification)
 
 	if err == nil {
@@ -48,6 +57,14 @@ func (i *ImmediateSendStrategy) Send(ctx context.Context, notification domain.No
 		}, nil
 	}
 
+	// 已存在的通知发送频率超过上限，不再发送
+	if found.Status == domain.SendStatusFrequencyCapped {
+		return domain.SendResponse{
+			NotificationID: found.ID,
+			Status:         found.Status,
+		}, nil
+	}
+
 	// 已存在的通知状态为发送发送中的则直接返回错误
 	if found.Status == domain.SendStatusSending {
 		return domain.SendResponse{}, fmt.Errorf("发送失败 %w", errs.ErrSendNotificationFailed)
@@ -65,14 +82,43 @@ func (i *ImmediateSendStrategy) Send(ctx context.Context, notification domain.No
 
 }
 
+// deferSend 免打扰时段内创建为待发送的通知，推迟到 at 开始发送
+func (i *ImmediateSendStrategy) deferSend(ctx context.Context, notification domain.Notification, at time.Time) (domain.SendResponse, error) {
+	notification.Status = domain.SendStatusPending
+	notification.DeferTo(at)
+	ctx = domain.CtxWithTransitionError(ctx, fmt.Errorf("%w，推迟到 %s", errs.ErrQuietHours, at.Format(time.RFC3339)))
+	created, err := i.repo.Create(ctx, notification)
+	if err == nil {
+		return domain.SendResponse{NotificationID: created.ID, Status: created.Status}, nil
+	}
+	if !errors.Is(err, errs.ErrNotificationDuplicate) {
+		return domain.SendResponse{}, fmt.Errorf("创建通知失败: %w", err)
+	}
+	// 业务方重试，以已经存在的通知为准
+	found, err := i.repo.GetByKey(ctx, notification.BizID, notification.Key)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("获取通知失败: %w", err)
+	}
+	return domain.SendResponse{NotificationID: found.ID, Status: found.Status}, nil
+}
+
 // BatchSend 批量发送通知，其中每个通知的发送策略必须相同
 func (i *ImmediateSendStrategy) BatchSend(ctx context.Context, notifications []domain.Notification) ([]domain.SendResponse, error) {
 	if len(notifications) == 0 {
 		return nil, fmt.Errorf("%w: 通知列表不能为空", errs.ErrSendNotificationFailed)
 	}
 
-	for i := range notifications {
-		notifications[i].SetSendTime()
+	for j := range notifications {
+		notifications[j].SetSendTime()
+		notifications[j].Status = domain.SendStatusSending
+	}
+	// 免打扰时段内的通知创建为待发送，不参与本次发送
+	deferTo := i.quietHours.Defer(ctx, notifications, time.Now())
+	for j := range notifications {
+		if !deferTo[j].IsZero() {
+			notifications[j].Status = domain.SendStatusPending
+			notifications[j].DeferTo(deferTo[j])
+		}
 	}
 
 	// 创建通知记录
@@ -80,6 +126,39 @@ func (i *ImmediateSendStrategy) BatchSend(ctx context.Context, notifications []d
 	if err != nil {
 		return nil, fmt.Errorf("通知创建失败: %w", err)
 	}
+	// 推迟发送的通知直接返回待发送，skipped 为不参与本次发送的通知的响应
+	skipped := make(map[int64]domain.SendResponse)
+	sending := make([]domain.Notification, 0, len(createdNotifications))
+	for j := range createdNotifications {
+		if createdNotifications[j].Status != domain.SendStatusSending {
+			skipped[createdNotifications[j].ID] = domain.SendResponse{
+				NotificationID: createdNotifications[j].ID,
+				Status:         createdNotifications[j].Status,
+			}
+			continue
+		}
+		sending = append(sending, createdNotifications[j])
+	}
 	// 立即发送
-	return i.sender.BatchSend(ctx, createdNotifications)
+	responses, err := i.sender.BatchSend(ctx, sending)
+	return mergeResponses(notifications, responses, skipped), err
+}
+
+// mergeResponses 按通知的顺序合并发送和推迟发送的响应
+func mergeResponses(notifications []domain.Notification, sent []domain.SendResponse, skipped map[int64]domain.SendResponse) []domain.SendResponse {
+	if len(skipped) == 0 {
+		return sent
+	}
+	byID := make(map[int64]domain.SendResponse, len(sent)+len(skipped))
+	for i := range sent {
+		byID[sent[i].NotificationID] = sent[i]
+	}
+	for id, resp := range skipped {
+		byID[id] = resp
+	}
+	responses := make([]domain.SendResponse, 0, len(notifications))
+	for i := range notifications {
+		responses = append(responses, byID[notifications[i].ID])
+	}
+	return responses
 }
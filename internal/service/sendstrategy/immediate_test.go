@@ -0,0 +1,196 @@
+package sendstrategy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/config"
+	"go-notification/internal/service/quiethours"
+	"go-notification/internal/service/sender"
+	"go-notification/internal/service/template/manage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeNotificationRepo struct {
+	repository.NotificationRepository
+	created []domain.Notification
+	causes  []error
+}
+
+func (r *fakeNotificationRepo) Create(ctx context.Context, n domain.Notification) (domain.Notification, error) {
+	_, cause := domain.TransitionFromCtx(ctx)
+	r.created = append(r.created, n)
+	r.causes = append(r.causes, cause)
+	return n, nil
+}
+
+func (r *fakeNotificationRepo) BatchCreate(_ context.Context, ns []domain.Notification) ([]domain.Notification, error) {
+	r.created = append(r.created, ns...)
+	return ns, nil
+}
+
+type fakeSender struct {
+	sender.NotificationSender
+	sent []domain.Notification
+}
+
+func (s *fakeSender) Send(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+	s.sent = append(s.sent, n)
+	return domain.SendResponse{NotificationID: n.ID, Status: domain.SendStatusSucceeded}, nil
+}
+
+func (s *fakeSender) BatchSend(_ context.Context, ns []domain.Notification) ([]domain.SendResponse, error) {
+	s.sent = append(s.sent, ns...)
+	resps := make([]domain.SendResponse, 0, len(ns))
+	for i := range ns {
+		resps = append(resps, domain.SendResponse{NotificationID: ns[i].ID, Status: domain.SendStatusSucceeded})
+	}
+	return resps, nil
+}
+
+type fakeConfigSvc struct {
+	config.BusinessConfigService
+	configs map[int64]domain.BusinessConfig
+}
+
+func (s *fakeConfigSvc) GetByIDs(_ context.Context, _ []int64) (map[int64]domain.BusinessConfig, error) {
+	return s.configs, nil
+}
+
+type fakeTemplateSvc struct {
+	manage.ChannelTemplateService
+	businessTypes map[int64]domain.BusinessType
+}
+
+func (s *fakeTemplateSvc) GetTemplateByID(_ context.Context, templateID int64) (domain.ChannelTemplate, error) {
+	return domain.ChannelTemplate{ID: templateID, BusinessType: s.businessTypes[templateID]}, nil
+}
+
+const (
+	promotionTemplateID    = 1
+	verificationTemplateID = 2
+)
+
+// newImmediateStrategy 业务 1 当前处于短信推广类通知的免打扰时段内
+func newImmediateStrategy(repo *fakeNotificationRepo, s *fakeSender) *ImmediateSendStrategy {
+	now := time.Now().UTC()
+	configSvc := &fakeConfigSvc{configs: map[int64]domain.BusinessConfig{
+		1: {
+			ID: 1,
+			QuietHours: &domain.QuietHoursConfig{
+				Rules: []domain.QuietHoursRule{
+					{
+						Channels:      []domain.Channel{domain.ChannelSMS},
+						BusinessTypes: []domain.BusinessType{domain.BusinessTypePromotion},
+						Start:         now.Add(-time.Hour).Format("15:04"),
+						End:           now.Add(time.Hour).Format("15:04"),
+						Timezone:      "UTC",
+					},
+				},
+			},
+		},
+	}}
+	templateSvc := &fakeTemplateSvc{businessTypes: map[int64]domain.BusinessType{
+		promotionTemplateID:    domain.BusinessTypePromotion,
+		verificationTemplateID: domain.BusinessTypeVerificationCode,
+	}}
+	return NewImmediateSendStrategy(repo, s, quiethours.NewService(configSvc, templateSvc, logger.NewNopLogger()))
+}
+
+func newImmediateNotification(id, bizID, templateID int64) domain.Notification {
+	return domain.Notification{
+		ID:                 id,
+		BizID:              bizID,
+		Key:                "key",
+		Receivers:          []string{"13800000000"},
+		Channel:            domain.ChannelSMS,
+		Template:           domain.Template{ID: templateID, VersionID: 1},
+		SendStrategyConfig: domain.SendStrategyConfig{Type: domain.SendStrategyImmediate},
+	}
+}
+
+func TestImmediateSendStrategy_SendInQuietHours(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		notification domain.Notification
+		wantStatus   domain.SendStatus
+		wantDeferred bool
+	}{
+		{
+			name:         "免打扰时段内推迟发送",
+			notification: newImmediateNotification(1, 1, promotionTemplateID),
+			wantStatus:   domain.SendStatusPending,
+			wantDeferred: true,
+		},
+		{
+			name:         "验证码不受免打扰限制",
+			notification: newImmediateNotification(1, 1, verificationTemplateID),
+			wantStatus:   domain.SendStatusSucceeded,
+		},
+		{
+			name:         "没有业务配置时立即发送",
+			notification: newImmediateNotification(1, 2, promotionTemplateID),
+			wantStatus:   domain.SendStatusSucceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeNotificationRepo{}
+			s := &fakeSender{}
+			strategy := newImmediateStrategy(repo, s)
+
+			start := time.Now()
+			resp, err := strategy.Send(t.Context(), tc.notification)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, resp.Status)
+
+			require.Len(t, repo.created, 1)
+			created := repo.created[0]
+			if !tc.wantDeferred {
+				assert.Equal(t, domain.SendStatusSending, created.Status)
+				assert.Len(t, s.sent, 1)
+				return
+			}
+			assert.Empty(t, s.sent)
+			assert.Equal(t, domain.SendStatusPending, created.Status)
+			// 推迟到时段结束，此时距离开始不超过一小时
+			assert.True(t, created.ScheduledSTime.After(start))
+			assert.True(t, created.ScheduledSTime.Before(start.Add(time.Hour+time.Minute)))
+			assert.True(t, created.ScheduledETime.After(created.ScheduledSTime))
+			assert.ErrorIs(t, repo.causes[0], errs.ErrQuietHours)
+		})
+	}
+}
+
+func TestImmediateSendStrategy_BatchSendInQuietHours(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeNotificationRepo{}
+	s := &fakeSender{}
+	strategy := newImmediateStrategy(repo, s)
+
+	resps, err := strategy.BatchSend(t.Context(), []domain.Notification{
+		newImmediateNotification(1, 1, promotionTemplateID),
+		newImmediateNotification(2, 1, verificationTemplateID),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.SendResponse{
+		{NotificationID: 1, Status: domain.SendStatusPending},
+		{NotificationID: 2, Status: domain.SendStatusSucceeded},
+	}, resps)
+	require.Len(t, s.sent, 1)
+	assert.Equal(t, int64(2), s.sent[0].ID)
+}
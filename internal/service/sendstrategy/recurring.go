@@ -0,0 +1,51 @@
+package sendstrategy
+
+import (
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/repository"
+	"time"
+)
+
+// RecurringSendStrategy 周期发送策略，只创建周期发送记录，由周期发送任务到点生成子通知
+type RecurringSendStrategy struct {
+	repo repository.NotificationSeriesRepository
+}
+
+func NewRecurringSendStrategy(repo repository.NotificationSeriesRepository) *RecurringSendStrategy {
+	return &RecurringSendStrategy{repo: repo}
+}
+
+// Send 创建周期发送，返回的通知ID为周期发送ID
+func (r *RecurringSendStrategy) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	series, err := domain.NewNotificationSeries(notification, time.Now())
+	if err != nil {
+		return domain.SendResponse{}, err
+	}
+	created, err := r.repo.Create(ctx, series)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("创建周期发送失败: %w", err)
+	}
+	return domain.SendResponse{
+		NotificationID: created.ID,
+		Status:         domain.SendStatusPending,
+	}, nil
+}
+
+// BatchSend 逐条创建周期发送
+func (r *RecurringSendStrategy) BatchSend(ctx context.Context, notifications []domain.Notification) ([]domain.SendResponse, error) {
+	if len(notifications) == 0 {
+		return nil, fmt.Errorf("%w: 通知列表不能为空", errs.ErrInvalidParameter)
+	}
+	responses := make([]domain.SendResponse, 0, len(notifications))
+	for i := range notifications {
+		resp, err := r.Send(ctx, notifications[i])
+		if err != nil {
+			return responses, err
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"go-notification/internal/domain"
+	"go-notification/internal/errs"
 )
 
 // SendStrategy 发送策略接口
@@ -21,10 +22,12 @@ type SendStrategy interface {
 type Dispatcher struct {
 	immediate       *ImmediateSendStrategy
 	defaultStrategy *DefaultSendStrategy
+	recurring       *RecurringSendStrategy
+	digest          *DigestSendStrategy
 }
 
-func NewDispatcher(immediate *ImmediateSendStrategy, defaultStrategy *DefaultSendStrategy) SendStrategy {
-	return &Dispatcher{immediate: immediate, defaultStrategy: defaultStrategy}
+func NewDispatcher(immediate *ImmediateSendStrategy, defaultStrategy *DefaultSendStrategy, recurring *RecurringSendStrategy, digest *DigestSendStrategy) SendStrategy {
+	return &Dispatcher{immediate: immediate, defaultStrategy: defaultStrategy, recurring: recurring, digest: digest}
 }
 
 // Send 发送通知
@@ -36,15 +39,21 @@ func (d *Dispatcher) Send(ctx context.Context, notification domain.Notification)
 // BatchSend 批量发送通知
 func (d *Dispatcher) BatchSend(ctx context.Context, notifications []domain.Notification) ([]domain.SendResponse, error) {
 	if len(notifications) == 0 {
-		return nil, fmt.Errorf("%w: 通知列表不能为空")
+		return nil, fmt.Errorf("%w: 通知列表不能为空", errs.ErrInvalidParameter)
 	}
 	// 同一批发送策略是一致的
 	return d.selectStrategy(notifications[0]).BatchSend(ctx, notifications)
 }
 
 func (d *Dispatcher) selectStrategy(notification domain.Notification) SendStrategy {
-	if notification.SendStrategyConfig.Type == domain.SendStrategyImmediate {
+	switch notification.SendStrategyConfig.Type {
+	case domain.SendStrategyImmediate:
 		return d.immediate
+	case domain.SendStrategyRecurring:
+		return d.recurring
+	case domain.SendStrategyDigest:
+		return d.digest
+	default:
+		return d.defaultStrategy
 	}
-	return d.defaultStrategy
 }
@@ -0,0 +1,92 @@
+package series
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/repository"
+	"time"
+)
+
+// Service 暂停、恢复和停止周期发送，已经生成的子通知不受影响，可以单独取消
+type Service interface {
+	Pause(ctx context.Context, bizID int64, key string) (domain.NotificationSeries, error)
+	// Resume 从恢复时刻之后的下一个触发时间继续发送，暂停期间错过的发送不再补发
+	Resume(ctx context.Context, bizID int64, key string) (domain.NotificationSeries, error)
+	// Stop 停止后不能再恢复
+	Stop(ctx context.Context, bizID int64, key string) (domain.NotificationSeries, error)
+}
+
+// maxCASRetries 与生成子通知的任务并发修改时的重试次数
+const maxCASRetries = 3
+
+type service struct {
+	repo repository.NotificationSeriesRepository
+}
+
+func NewService(repo repository.NotificationSeriesRepository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) Pause(ctx context.Context, bizID int64, key string) (domain.NotificationSeries, error) {
+	return s.update(ctx, bizID, key, func(series *domain.NotificationSeries) error {
+		if series.Status != domain.SeriesStatusActive {
+			return fmt.Errorf("%w: 周期发送状态为 %s，只能暂停发送中的周期发送", errs.ErrInvalidOperation, series.Status)
+		}
+		series.Status = domain.SeriesStatusPaused
+		return nil
+	})
+}
+
+func (s *service) Resume(ctx context.Context, bizID int64, key string) (domain.NotificationSeries, error) {
+	return s.update(ctx, bizID, key, func(series *domain.NotificationSeries) error {
+		if series.Status != domain.SeriesStatusPaused {
+			return fmt.Errorf("%w: 周期发送状态为 %s，只能恢复已暂停的周期发送", errs.ErrInvalidOperation, series.Status)
+		}
+		schedule, err := series.Notification.SendStrategyConfig.RecurringSchedule()
+		if err != nil {
+			return err
+		}
+		series.Resume(schedule, time.Now())
+		return nil
+	})
+}
+
+func (s *service) Stop(ctx context.Context, bizID int64, key string) (domain.NotificationSeries, error) {
+	return s.update(ctx, bizID, key, func(series *domain.NotificationSeries) error {
+		if series.IsFinished() {
+			return fmt.Errorf("%w: 周期发送状态为 %s，已经结束", errs.ErrInvalidOperation, series.Status)
+		}
+		series.Status = domain.SeriesStatusStopped
+		return nil
+	})
+}
+
+// update 读取后按版本号更新，版本号不匹配说明任务刚好生成了子通知，重新读取后再试
+func (s *service) update(ctx context.Context, bizID int64, key string, fn func(series *domain.NotificationSeries) error) (domain.NotificationSeries, error) {
+	if key == "" {
+		return domain.NotificationSeries{}, fmt.Errorf("%w: 业务内唯一标识不能为空", errs.ErrInvalidParameter)
+	}
+	var err error
+	for i := 0; i < maxCASRetries; i++ {
+		var series domain.NotificationSeries
+		series, err = s.repo.GetByKey(ctx, bizID, key)
+		if err != nil {
+			return domain.NotificationSeries{}, err
+		}
+		if err = fn(&series); err != nil {
+			return domain.NotificationSeries{}, err
+		}
+		err = s.repo.CASUpdate(ctx, series)
+		if err == nil {
+			series.Version++
+			return series, nil
+		}
+		if !errors.Is(err, errs.ErrNotificationVersionMismatch) {
+			return domain.NotificationSeries{}, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+		}
+	}
+	return domain.NotificationSeries{}, err
+}
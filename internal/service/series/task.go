@@ -0,0 +1,95 @@
+package series
+
+import (
+	"context"
+	"errors"
+	"github.com/meoying/dlock-go"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/id_generator"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/pkg/loopjob"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/sendstrategy"
+	"time"
+)
+
+// Task 到了发送时间的周期发送生成子通知，子通知按时间窗口策略创建，由调度发送
+type Task struct {
+	repo         repository.NotificationSeriesRepository
+	sendStrategy sendstrategy.SendStrategy
+	idGenerator  *id_generator.Generator
+	dclient      dlock.Client
+	log          logger.Logger
+	batchSize    int
+}
+
+func NewTask(repo repository.NotificationSeriesRepository, sendStrategy sendstrategy.SendStrategy, dclient dlock.Client, log logger.Logger) *Task {
+	const defaultBatchSize = 10
+	return &Task{
+		repo:         repo,
+		sendStrategy: sendStrategy,
+		idGenerator:  id_generator.NewGenerator(),
+		dclient:      dclient,
+		log:          log,
+		batchSize:    defaultBatchSize,
+	}
+}
+
+func (t *Task) Start(ctx context.Context) {
+	const key = "notification_series_materialize"
+	lj := loopjob.NewInfiniteLoop(t.dclient, t.log, t.Materialize, key)
+	lj.Run(ctx)
+}
+
+// Materialize 处理一批到了发送时间的周期发送
+func (t *Task) Materialize(ctx context.Context) error {
+	ctx = domain.CtxWithTransitionActor(ctx, domain.TransitionActorSeries)
+	due, err := t.repo.FindDue(ctx, t.batchSize)
+	if err != nil {
+		return err
+	}
+	for i := range due {
+		t.occur(ctx, due[i])
+	}
+	if len(due) < t.batchSize {
+		time.Sleep(time.Second)
+	}
+	return nil
+}
+
+// occur 生成本次的子通知并推进到下一次，子通知已经存在说明上一次生成后没有推进成功
+func (t *Task) occur(ctx context.Context, series domain.NotificationSeries) {
+	schedule, err := series.Notification.SendStrategyConfig.RecurringSchedule()
+	if err != nil {
+		t.log.Error("周期发送的 cron 表达式无效",
+			logger.Int64("seriesID", series.ID),
+			logger.Error(err))
+		return
+	}
+
+	now := time.Now()
+	occurred := false
+	if !series.Missed(now) {
+		n := series.NextOccurrence()
+		n.ID = t.idGenerator.GenerateID(n.BizID, n.Key)
+		_, err = t.sendStrategy.Send(ctx, n)
+		if err != nil && !errors.Is(err, errs.ErrNotificationDuplicate) {
+			// 下一轮重试
+			t.log.Warn("周期发送生成子通知失败",
+				logger.Int64("seriesID", series.ID),
+				logger.String("key", n.Key),
+				logger.Error(err))
+			return
+		}
+		occurred = true
+	}
+
+	series.Advance(schedule, occurred, now)
+	if err = t.repo.CASUpdate(ctx, series); err != nil {
+		// 版本号不匹配说明业务方刚好暂停或者停止了周期发送，以业务方的修改为准
+		t.log.Warn("周期发送推进到下一次失败",
+			logger.Int64("seriesID", series.ID),
+			logger.Error(err))
+	}
+}
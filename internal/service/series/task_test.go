@@ -0,0 +1,153 @@
+package series
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"go-notification/internal/service/sendstrategy"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSeriesRepo struct {
+	repository.NotificationSeriesRepository
+	due     []domain.NotificationSeries
+	updated []domain.NotificationSeries
+}
+
+func (r *fakeSeriesRepo) FindDue(_ context.Context, _ int) ([]domain.NotificationSeries, error) {
+	return r.due, nil
+}
+
+func (r *fakeSeriesRepo) CASUpdate(_ context.Context, series domain.NotificationSeries) error {
+	r.updated = append(r.updated, series)
+	return nil
+}
+
+type fakeSendStrategy struct {
+	sendstrategy.SendStrategy
+	sent []domain.Notification
+	err  error
+}
+
+func (s *fakeSendStrategy) Send(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+	s.sent = append(s.sent, n)
+	return domain.SendResponse{}, s.err
+}
+
+func TestTask_Materialize(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	// 每分钟一次，下一次发送时间取整到分钟，保证落在触发时间上
+	next := now.Truncate(time.Minute)
+	newSeries := func(nextTime time.Time, maxOccurrences, occurrences int32) domain.NotificationSeries {
+		return domain.NotificationSeries{
+			ID:    1,
+			BizID: 1,
+			Key:   "daily",
+			Notification: domain.Notification{
+				BizID: 1,
+				Key:   "daily",
+				SendStrategyConfig: domain.SendStrategyConfig{
+					Type:           domain.SendStrategyRecurring,
+					CronExpr:       "* * * * *",
+					MaxOccurrences: maxOccurrences,
+				},
+			},
+			Occurrences: occurrences,
+			NextTime:    nextTime,
+			Status:      domain.SeriesStatusActive,
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		series          domain.NotificationSeries
+		sendErr         error
+		wantSentKey     string
+		wantUpdated     bool
+		wantOccurrences int32
+		wantStatus      domain.SeriesStatus
+	}{
+		{
+			name:            "生成子通知并推进到下一次",
+			series:          newSeries(next, 0, 0),
+			wantSentKey:     "daily#" + strconv.FormatInt(next.Unix(), 10),
+			wantUpdated:     true,
+			wantOccurrences: 1,
+			wantStatus:      domain.SeriesStatusActive,
+		},
+		{
+			name:            "子通知已经存在时照常推进",
+			series:          newSeries(next, 0, 0),
+			sendErr:         errs.ErrNotificationDuplicate,
+			wantSentKey:     "daily#" + strconv.FormatInt(next.Unix(), 10),
+			wantUpdated:     true,
+			wantOccurrences: 1,
+			wantStatus:      domain.SeriesStatusActive,
+		},
+		{
+			name:            "达到最多次数后结束",
+			series:          newSeries(next, 2, 1),
+			wantSentKey:     "daily#" + strconv.FormatInt(next.Unix(), 10),
+			wantUpdated:     true,
+			wantOccurrences: 2,
+			wantStatus:      domain.SeriesStatusCompleted,
+		},
+		{
+			name:            "错过发送窗口不再补发",
+			series:          newSeries(next.Add(-time.Hour), 0, 0),
+			wantUpdated:     true,
+			wantOccurrences: 0,
+			wantStatus:      domain.SeriesStatusActive,
+		},
+		{
+			name:        "生成失败时不推进",
+			series:      newSeries(next, 0, 0),
+			sendErr:     errs.ErrDatabaseError,
+			wantSentKey: "daily#" + strconv.FormatInt(next.Unix(), 10),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &fakeSeriesRepo{due: []domain.NotificationSeries{tc.series}}
+			strategy := &fakeSendStrategy{err: tc.sendErr}
+			task := NewTask(repo, strategy, nil, logger.NewNopLogger())
+			task.batchSize = 1
+
+			require.NoError(t, task.Materialize(t.Context()))
+
+			if tc.wantSentKey == "" {
+				assert.Empty(t, strategy.sent)
+			} else {
+				require.Len(t, strategy.sent, 1)
+				assert.Equal(t, tc.wantSentKey, strategy.sent[0].Key)
+				assert.Equal(t, domain.SendStrategyWindow, strategy.sent[0].SendStrategyConfig.Type)
+				assert.NotZero(t, strategy.sent[0].ID)
+			}
+			if !tc.wantUpdated {
+				assert.Empty(t, repo.updated)
+				return
+			}
+			require.Len(t, repo.updated, 1)
+			updated := repo.updated[0]
+			assert.Equal(t, tc.wantOccurrences, updated.Occurrences)
+			assert.Equal(t, tc.wantStatus, updated.Status)
+			if tc.wantStatus == domain.SeriesStatusActive {
+				// 下一次发送时间在当前时间的发送窗口之后
+				assert.True(t, updated.NextTime.After(now.Add(-domain.SeriesOccurrenceWindow)))
+			}
+		})
+	}
+}
@@ -25,6 +25,9 @@ type ChannelTemplateService interface {
 	// GetTemplateByIDAndProviderInfo 根据模板ID和供应商信息获取模板
 	GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, providerName string, channel domain.Channel) (domain.ChannelTemplate, error)
 
+	// GetTemplateVersionByProviderInfo 获取指定版本的模板，versionID 为 0 时使用当前发布的版本
+	GetTemplateVersionByProviderInfo(ctx context.Context, templateID, versionID int64, providerName string, channel domain.Channel) (domain.ChannelTemplate, error)
+
 	// GetTemplateByID 根据模板ID获取模板
 	GetTemplateByID(ctx context.Context, templateID int64) (domain.ChannelTemplate, error)
 
@@ -95,6 +98,10 @@ func (t *templateService) GetTemplatesByOwner(ctx context.Context, ownerID int64
 }
 
 func (t *templateService) GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, providerName string, channel domain.Channel) (domain.ChannelTemplate, error) {
+	return t.GetTemplateVersionByProviderInfo(ctx, templateID, 0, providerName, channel)
+}
+
+func (t *templateService) GetTemplateVersionByProviderInfo(ctx context.Context, templateID, versionID int64, providerName string, channel domain.Channel) (domain.ChannelTemplate, error) {
 	// 1. 获取模版基本信息
 	template, err := t.repo.GetTemplateByID(ctx, templateID)
 	if err != nil {
@@ -106,11 +113,18 @@ func (t *templateService) GetTemplateByIDAndProviderInfo(ctx context.Context, te
 	}
 
 	// 2. 获取指定的版本信息
-	version, err := t.repo.GetTemplateVersionByID(ctx, template.ActiveVersionID)
+	if versionID == 0 {
+		versionID = template.ActiveVersionID
+	}
+	version, err := t.repo.GetTemplateVersionByID(ctx, versionID)
 	if err != nil {
 		return domain.ChannelTemplate{}, err
 	}
 
+	if version.ChannelTemplateID != template.ID {
+		return domain.ChannelTemplate{}, fmt.Errorf("%w: templateID=%d, versionID=%d", errs.ErrTemplateAndVersionMisMatch, templateID, versionID)
+	}
+
 	if version.AuditStatus != domain.AuditStatusApproved {
 		return domain.ChannelTemplate{}, fmt.Errorf("%w: versionID=%d", errs.ErrTemplateVersionNotApprovedByPlatform, version.Id)
 	}
@@ -125,8 +139,9 @@ func (t *templateService) GetTemplateByIDAndProviderInfo(ctx context.Context, te
 		return domain.ChannelTemplate{}, fmt.Errorf("%w: providerName=%s, channel=%s", errs.ErrTemplateNotFound, providerName, channel)
 	}
 
-	// 4. 组装完整模版
+	// 4. 组装完整模版，只包含发送使用的版本，渠道通过 ActiveVersion 获取
 	version.Providers = providers
+	template.ActiveVersionID = version.Id
 	template.Versions = []domain.ChannelTemplateVersion{version}
 	return template, nil
 }
@@ -283,6 +298,7 @@ func (t *templateService) UpdateVersion(ctx context.Context, version domain.Chan
 		Id:        version.Id,
 		Name:      version.Name,
 		Signature: version.Signature,
+		Subject:   version.Subject,
 		Content:   version.Content,
 		Remark:    version.Remark,
 	}
@@ -0,0 +1,77 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"go-notification/internal/pkg/logger"
+)
+
+// StatusEvent 通知状态变化事件，只用于唤醒订阅者，具体变化由订阅者按游标从状态流转记录中拉取
+type StatusEvent struct {
+	BizID           int64   `json:"bizId"`
+	NotificationIDs []int64 `json:"notificationIds"`
+}
+
+// Publisher 发布状态变化事件
+type Publisher interface {
+	Publish(ctx context.Context, evt StatusEvent) error
+}
+
+// Subscriber 订阅状态变化事件，阻塞直到 ctx 结束
+type Subscriber interface {
+	Subscribe(ctx context.Context, handler func(evt StatusEvent)) error
+}
+
+var (
+	_ Publisher  = (*RedisBroker)(nil)
+	_ Subscriber = (*RedisBroker)(nil)
+)
+
+// RedisBroker 基于 Redis pub/sub 在多个平台实例之间扇出状态变化事件
+type RedisBroker struct {
+	client  *redis.Client
+	channel string
+	logger  logger.Logger
+}
+
+func NewRedisBroker(client *redis.Client, channel string, logger logger.Logger) *RedisBroker {
+	return &RedisBroker{client: client, channel: channel, logger: logger}
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, evt StatusEvent) error {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	return b.client.Publish(ctx, b.channel, payload).Err()
+}
+
+func (b *RedisBroker) Subscribe(ctx context.Context, handler func(evt StatusEvent)) error {
+	pubsub := b.client.Subscribe(ctx, b.channel)
+	defer pubsub.Close()
+
+	// 确认订阅成功后再开始消费
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return fmt.Errorf("订阅通知状态变化频道失败: %w", err)
+	}
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			var evt StatusEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &evt); err != nil {
+				b.logger.Warn("解析通知状态变化事件失败", logger.Error(err), logger.String("payload", msg.Payload))
+				continue
+			}
+			handler(evt)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package watch
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+	"slices"
+)
+
+// NotificationRepository 状态更新成功后发布状态变化事件的装饰器
+type NotificationRepository struct {
+	repository.NotificationRepository
+	publisher Publisher
+	logger    logger.Logger
+}
+
+func NewNotificationRepository(repo repository.NotificationRepository, publisher Publisher, logger logger.Logger) *NotificationRepository {
+	return &NotificationRepository{NotificationRepository: repo, publisher: publisher, logger: logger}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	res, err := r.NotificationRepository.Create(ctx, notification)
+	if err == nil {
+		publish(ctx, r.publisher, r.logger, res)
+	}
+	return res, err
+}
+
+func (r *NotificationRepository) CreateWithCallbackLog(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	res, err := r.NotificationRepository.CreateWithCallbackLog(ctx, notification)
+	if err == nil {
+		publish(ctx, r.publisher, r.logger, res)
+	}
+	return res, err
+}
+
+func (r *NotificationRepository) BatchCreate(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	res, err := r.NotificationRepository.BatchCreate(ctx, notifications)
+	if err == nil {
+		publish(ctx, r.publisher, r.logger, res...)
+	}
+	return res, err
+}
+
+func (r *NotificationRepository) BatchCreateWithCallbackLog(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	res, err := r.NotificationRepository.BatchCreateWithCallbackLog(ctx, notifications)
+	if err == nil {
+		publish(ctx, r.publisher, r.logger, res...)
+	}
+	return res, err
+}
+
+func (r *NotificationRepository) CASStatus(ctx context.Context, notification domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.CASStatus(ctx, notification), notification)
+}
+
+func (r *NotificationRepository) UpdateStatus(ctx context.Context, notification domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.UpdateStatus(ctx, notification), notification)
+}
+
+func (r *NotificationRepository) BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification) ([]int64, error) {
+	ids, err := r.NotificationRepository.BatchUpdateStatusSucceededOrFailed(ctx, succeededNotifications, failedNotifications)
+	// 只推送实际更新了状态的通知
+	updated := slices.DeleteFunc(slices.Concat(succeededNotifications, failedNotifications), func(n domain.Notification) bool {
+		return !slices.Contains(ids, n.ID)
+	})
+	return ids, r.publishIfOK(ctx, err, updated...)
+}
+
+func (r *NotificationRepository) MarkSuccess(ctx context.Context, notification domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.MarkSuccess(ctx, notification), notification)
+}
+
+func (r *NotificationRepository) MarkFailed(ctx context.Context, notification domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.MarkFailed(ctx, notification), notification)
+}
+
+func (r *NotificationRepository) Requeue(ctx context.Context, notification domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.Requeue(ctx, notification), notification)
+}
+
+func (r *NotificationRepository) MarkRetry(ctx context.Context, notification domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.MarkRetry(ctx, notification), notification)
+}
+
+func (r *NotificationRepository) Replay(ctx context.Context, notification domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.Replay(ctx, notification), notification)
+}
+
+func (r *NotificationRepository) MarkSending(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
+	claimed, err := r.NotificationRepository.MarkSending(ctx, notifications)
+	if err == nil {
+		publish(ctx, r.publisher, r.logger, claimed...)
+	}
+	return claimed, err
+}
+
+func (r *NotificationRepository) Cancel(ctx context.Context, notification domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.Cancel(ctx, notification), notification)
+}
+
+func (r *NotificationRepository) Reschedule(ctx context.Context, notification domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.Reschedule(ctx, notification), notification)
+}
+
+func (r *NotificationRepository) Defer(ctx context.Context, notification domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.Defer(ctx, notification), notification)
+}
+
+func (r *NotificationRepository) CreateDigestItem(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	res, err := r.NotificationRepository.CreateDigestItem(ctx, notification)
+	if err == nil {
+		publish(ctx, r.publisher, r.logger, res)
+	}
+	return res, err
+}
+
+func (r *NotificationRepository) CreateDigest(ctx context.Context, digest domain.Notification, items []domain.Notification) (domain.Notification, error) {
+	res, err := r.NotificationRepository.CreateDigest(ctx, digest, items)
+	if err == nil {
+		publish(ctx, r.publisher, r.logger, append([]domain.Notification{res}, items...)...)
+	}
+	return res, err
+}
+
+func (r *NotificationRepository) SettleDigestItems(ctx context.Context, digest domain.Notification, items []domain.Notification) error {
+	return r.publishIfOK(ctx, r.NotificationRepository.SettleDigestItems(ctx, digest, items), items...)
+}
+
+func (r *NotificationRepository) publishIfOK(ctx context.Context, err error, notifications ...domain.Notification) error {
+	if err == nil {
+		publish(ctx, r.publisher, r.logger, notifications...)
+	}
+	return err
+}
+
+// TxNotificationRepository 事务消息提交、取消和回查后发布状态变化事件的装饰器
+type TxNotificationRepository struct {
+	repository.TxNotificationRepository
+	publisher Publisher
+	logger    logger.Logger
+}
+
+func NewTxNotificationRepository(repo repository.TxNotificationRepository, publisher Publisher, logger logger.Logger) *TxNotificationRepository {
+	return &TxNotificationRepository{TxNotificationRepository: repo, publisher: publisher, logger: logger}
+}
+
+func (r *TxNotificationRepository) UpdateStatus(ctx context.Context, bizID int64, key string, status domain.TxNotificationStatus, notificationStatus domain.SendStatus) error {
+	err := r.TxNotificationRepository.UpdateStatus(ctx, bizID, key, status, notificationStatus)
+	if err == nil {
+		publish(ctx, r.publisher, r.logger, domain.Notification{BizID: bizID})
+	}
+	return err
+}
+
+func (r *TxNotificationRepository) UpdateCheckStatus(ctx context.Context, txNotifications []domain.TxNotification, notificationStatus domain.SendStatus) error {
+	err := r.TxNotificationRepository.UpdateCheckStatus(ctx, txNotifications, notificationStatus)
+	if err == nil && notificationStatus != domain.SendStatusPrepare {
+		notifications := make([]domain.Notification, 0, len(txNotifications))
+		for i := range txNotifications {
+			notifications = append(notifications, domain.Notification{
+				ID:    txNotifications[i].Notification.ID,
+				BizID: txNotifications[i].BizID,
+			})
+		}
+		publish(ctx, r.publisher, r.logger, notifications...)
+	}
+	return err
+}
+
+// publish 按业务分组发布事件，发布只是尽力而为，订阅者会定期兜底拉取
+func publish(ctx context.Context, publisher Publisher, l logger.Logger, notifications ...domain.Notification) {
+	events := make(map[int64]*StatusEvent)
+	for i := range notifications {
+		evt, ok := events[notifications[i].BizID]
+		if !ok {
+			evt = &StatusEvent{BizID: notifications[i].BizID}
+			events[notifications[i].BizID] = evt
+		}
+		if notifications[i].ID > 0 {
+			evt.NotificationIDs = append(evt.NotificationIDs, notifications[i].ID)
+		}
+	}
+	for _, evt := range events {
+		if err := publisher.Publish(ctx, *evt); err != nil {
+			l.Warn("发布通知状态变化事件失败", logger.Error(err), logger.Int64("bizID", evt.BizID))
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"go-notification/internal/domain"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/pkg/task"
+	"go-notification/internal/repository"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	replayBatchSize = 100
+	// maxWatchTargets 单个订阅最多指定的通知ID和业务内唯一标识数量
+	maxWatchTargets = 100
+	// checkpointInterval 记录游标检查点的最小间隔，检查点越稀疏回看的起点越早，只会多读不会漏读
+	checkpointInterval = time.Second
+)
+
+// Request 订阅条件，NotificationIDs 和 Keys 都为空时订阅业务下的所有通知
+type Request struct {
+	BizID           int64
+	NotificationIDs []int64
+	Keys            []string
+	// ResumeToken 重连时传入最后收到的事件的恢复令牌，为空时只推送之后的新变化
+	ResumeToken string
+}
+
+// Service 订阅通知的状态变化
+type Service interface {
+	// Watch 先按恢复令牌重放错过的变化，之后每次被唤醒都从游标处继续推送，阻塞直到 ctx 结束或 send 返回错误
+	Watch(ctx context.Context, req Request, send func(t domain.NotificationTransition) error) error
+}
+
+var (
+	_ Service   = (*Hub)(nil)
+	_ Publisher = (*Hub)(nil)
+	_ task.Task = (*Hub)(nil)
+)
+
+// Hub 本实例的状态变化事件总线。
+// 本实例的状态变化直接唤醒本地订阅者，同时经 Redis pub/sub 扇出到其他实例；
+// 订阅者被唤醒后按游标从状态流转记录中拉取，因此实时推送和断线重放是同一条路径。
+type Hub struct {
+	repo         repository.NotificationTransitionRepository
+	remote       Publisher
+	subscriber   Subscriber
+	pollInterval time.Duration
+	lookback     time.Duration
+	logger       logger.Logger
+
+	mu       sync.RWMutex
+	watchers map[int64]map[*watcher]struct{}
+}
+
+// NewHub pollInterval 为兜底的拉取间隔，避免丢失事件时订阅者一直收不到变化；
+// lookback 为回看窗口，记录ID在事务提交前分配，晚提交的记录ID可能小于已推送的游标，
+// 每次拉取都会重新读取窗口内的记录并去重，窗口需大于写入状态流转记录的事务的最长耗时
+func NewHub(repo repository.NotificationTransitionRepository, remote Publisher, subscriber Subscriber,
+	pollInterval, lookback time.Duration, logger logger.Logger,
+) *Hub {
+	return &Hub{
+		repo:         repo,
+		remote:       remote,
+		subscriber:   subscriber,
+		pollInterval: pollInterval,
+		lookback:     lookback,
+		logger:       logger,
+		watchers:     make(map[int64]map[*watcher]struct{}),
+	}
+}
+
+// Start 订阅其他实例的事件直到 ctx 结束，订阅中断时自动重试
+func (h *Hub) Start(ctx context.Context) {
+	const retryInterval = time.Second
+	for {
+		err := h.subscriber.Subscribe(ctx, h.dispatch)
+		if ctx.Err() != nil {
+			return
+		}
+		h.logger.Warn("通知状态变化订阅中断，稍后重试", logger.Error(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// Publish 唤醒本地订阅者并发布到其他实例，发布失败时订阅者仍可通过兜底拉取得到变化
+func (h *Hub) Publish(ctx context.Context, evt StatusEvent) error {
+	h.dispatch(evt)
+	return h.remote.Publish(ctx, evt)
+}
+
+func (h *Hub) Watch(ctx context.Context, req Request, send func(t domain.NotificationTransition) error) error {
+	if req.BizID <= 0 {
+		return fmt.Errorf("%w: 业务ID", errs.ErrInvalidParameter)
+	}
+	if len(req.NotificationIDs)+len(req.Keys) > maxWatchTargets {
+		return fmt.Errorf("%w: %d > %d", errs.ErrBatchSizeOverLimit, len(req.NotificationIDs)+len(req.Keys), maxWatchTargets)
+	}
+	cursor, err := h.cursor(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	w := &watcher{req: req, cursor: cursor, lookback: h.lookback, sent: make(map[int64]struct{}), notify: make(chan struct{}, 1)}
+	h.register(w)
+	defer h.unregister(w)
+	return w.serve(ctx, h.repo, h.pollInterval, send)
+}
+
+// cursor 解析恢复令牌，未传时从最新的变化开始
+func (h *Hub) cursor(ctx context.Context, req Request) (int64, error) {
+	if req.ResumeToken == "" {
+		id, err := h.repo.LatestID(ctx, req.BizID)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+		}
+		return id, nil
+	}
+	cursor, err := strconv.ParseInt(req.ResumeToken, 10, 64)
+	if err != nil || cursor < 0 {
+		return 0, fmt.Errorf("%w: 恢复令牌 %s", errs.ErrInvalidParameter, req.ResumeToken)
+	}
+	return cursor, nil
+}
+
+// dispatch 唤醒本实例上该业务的所有订阅者
+func (h *Hub) dispatch(evt StatusEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for w := range h.watchers[evt.BizID] {
+		w.wake()
+	}
+}
+
+func (h *Hub) register(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ws, ok := h.watchers[w.req.BizID]
+	if !ok {
+		ws = make(map[*watcher]struct{})
+		h.watchers[w.req.BizID] = ws
+	}
+	ws[w] = struct{}{}
+}
+
+func (h *Hub) unregister(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ws := h.watchers[w.req.BizID]
+	delete(ws, w)
+	if len(ws) == 0 {
+		delete(h.watchers, w.req.BizID)
+	}
+}
+
+// ResumeToken 事件的恢复令牌，即状态流转记录的ID
+func ResumeToken(t domain.NotificationTransition) string {
+	return strconv.FormatInt(t.ID, 10)
+}
+
+// checkpoint 某次拉取前的游标
+type checkpoint struct {
+	at     time.Time
+	cursor int64
+}
+
+// watcher 一个订阅流
+type watcher struct {
+	req Request
+	// cursor 已推送的最大状态流转记录ID
+	cursor   int64
+	lookback time.Duration
+	// checkpoints 按时间升序的游标检查点，第一个是回看窗口开始前的最后一个检查点
+	checkpoints []checkpoint
+	// sent 回看起点之后已推送的记录ID，重新读取时去重
+	sent map[int64]struct{}
+	// notify 收到事件时唤醒，容量为1，多个事件合并为一次拉取
+	notify chan struct{}
+}
+
+// wake 非阻塞唤醒
+func (w *watcher) wake() {
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *watcher) serve(ctx context.Context, repo repository.NotificationTransitionRepository, pollInterval time.Duration,
+	send func(t domain.NotificationTransition) error,
+) error {
+	if err := w.flush(ctx, repo, send); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-w.notify:
+		case <-ticker.C:
+		}
+		if err := w.flush(ctx, repo, send); err != nil {
+			return err
+		}
+	}
+}
+
+// flush 从回看起点开始拉取，跳过已经推送过的记录
+func (w *watcher) flush(ctx context.Context, repo repository.NotificationTransitionRepository, send func(t domain.NotificationTransition) error) error {
+	after := w.lookbackFrom(time.Now())
+	for {
+		transitions, err := repo.FindAfter(ctx, w.req.BizID, after, w.req.NotificationIDs, w.req.Keys, replayBatchSize)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errs.ErrDatabaseError, err)
+		}
+		for i := range transitions {
+			after = transitions[i].ID
+			if _, ok := w.sent[after]; ok {
+				continue
+			}
+			if err = send(transitions[i]); err != nil {
+				return err
+			}
+			w.sent[after] = struct{}{}
+			w.cursor = max(w.cursor, after)
+		}
+		if len(transitions) < replayBatchSize {
+			return nil
+		}
+	}
+}
+
+// lookbackFrom 记录当前游标，返回回看窗口开始前的游标作为拉取起点，并清理起点之前的去重记录
+func (w *watcher) lookbackFrom(now time.Time) int64 {
+	if n := len(w.checkpoints); n == 0 || now.Sub(w.checkpoints[n-1].at) >= checkpointInterval {
+		w.checkpoints = append(w.checkpoints, checkpoint{at: now, cursor: w.cursor})
+	}
+	for len(w.checkpoints) > 1 && now.Sub(w.checkpoints[1].at) >= w.lookback {
+		w.checkpoints = w.checkpoints[1:]
+	}
+	from := w.checkpoints[0].cursor
+	for id := range w.sent {
+		if id <= from {
+			delete(w.sent, id)
+		}
+	}
+	return from
+}
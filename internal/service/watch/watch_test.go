@@ -0,0 +1,217 @@
+package watch
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTransitions 内存中的状态流转记录
+type memoryTransitions struct {
+	repository.NotificationTransitionRepository
+	mu          sync.Mutex
+	lastID      int64
+	transitions []domain.NotificationTransition
+}
+
+func (m *memoryTransitions) add(t domain.NotificationTransition) {
+	m.commit(m.reserve(), t)
+}
+
+// reserve 模拟事务中分配了自增ID但还没有提交
+func (m *memoryTransitions) reserve() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastID++
+	return m.lastID
+}
+
+// commit 按ID有序写入，记录此后才能被读到
+func (m *memoryTransitions) commit(id int64, t domain.NotificationTransition) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t.ID = id
+	m.transitions = append(m.transitions, t)
+	slices.SortFunc(m.transitions, func(a, b domain.NotificationTransition) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+}
+
+func (m *memoryTransitions) FindAfter(_ context.Context, bizID, afterID int64, notificationIDs []int64, keys []string, limit int) ([]domain.NotificationTransition, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var res []domain.NotificationTransition
+	for _, t := range m.transitions {
+		if t.BizID != bizID || t.ID <= afterID || len(res) >= limit {
+			continue
+		}
+		if (len(notificationIDs) > 0 || len(keys) > 0) &&
+			!slices.Contains(notificationIDs, t.NotificationID) && !slices.Contains(keys, t.Key) {
+			continue
+		}
+		res = append(res, t)
+	}
+	return res, nil
+}
+
+func (m *memoryTransitions) LatestID(_ context.Context, bizID int64) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var id int64
+	for _, t := range m.transitions {
+		if t.BizID == bizID {
+			id = t.ID
+		}
+	}
+	return id, nil
+}
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(context.Context, StatusEvent) error {
+	return nil
+}
+
+func TestHub_Watch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		req         Request
+		wantHistory []int64 // 订阅时重放的记录ID
+		wantLive    []int64 // 订阅之后推送的记录ID
+	}{
+		{
+			name:     "不传恢复令牌只推送新变化",
+			req:      Request{BizID: 1},
+			wantLive: []int64{4, 6},
+		},
+		{
+			name:        "按恢复令牌重放错过的变化",
+			req:         Request{BizID: 1, ResumeToken: "1"},
+			wantHistory: []int64{2, 3},
+			wantLive:    []int64{4, 6},
+		},
+		{
+			name:        "只订阅指定的通知",
+			req:         Request{BizID: 1, Keys: []string{"k2"}, ResumeToken: "0"},
+			wantHistory: []int64{2},
+			wantLive:    []int64{6},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &memoryTransitions{}
+			repo.add(domain.NotificationTransition{BizID: 1, NotificationID: 10, Key: "k1"})
+			repo.add(domain.NotificationTransition{BizID: 1, NotificationID: 20, Key: "k2"})
+			repo.add(domain.NotificationTransition{BizID: 1, NotificationID: 10, Key: "k1"})
+			hub := NewHub(repo, nopPublisher{}, nil, time.Hour, time.Minute, logger.NewNopLogger())
+
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+			received := make(chan int64, 10)
+			done := make(chan error, 1)
+			go func() {
+				done <- hub.Watch(ctx, tc.req, func(tr domain.NotificationTransition) error {
+					received <- tr.ID
+					return nil
+				})
+			}()
+
+			for _, id := range tc.wantHistory {
+				assert.Equal(t, id, <-received)
+			}
+			// 等待订阅注册后再产生新的变化
+			require.Eventually(t, func() bool {
+				hub.mu.RLock()
+				defer hub.mu.RUnlock()
+				return len(hub.watchers[1]) == 1
+			}, time.Second, 10*time.Millisecond)
+
+			repo.add(domain.NotificationTransition{BizID: 1, NotificationID: 10, Key: "k1"})
+			repo.add(domain.NotificationTransition{BizID: 2, NotificationID: 30, Key: "k2"})
+			repo.add(domain.NotificationTransition{BizID: 1, NotificationID: 20, Key: "k2"})
+			require.NoError(t, hub.Publish(ctx, StatusEvent{BizID: 1}))
+
+			for _, id := range tc.wantLive {
+				select {
+				case got := <-received:
+					assert.Equal(t, id, got)
+				case <-time.After(time.Second):
+					t.Fatalf("没有收到状态变化 %d", id)
+				}
+			}
+			cancel()
+			assert.ErrorIs(t, <-done, context.Canceled)
+		})
+	}
+}
+
+func TestHub_WatchLateCommit(t *testing.T) {
+	t.Parallel()
+
+	repo := &memoryTransitions{}
+	repo.add(domain.NotificationTransition{BizID: 1, NotificationID: 10, Key: "k1"})
+	hub := NewHub(repo, nopPublisher{}, nil, time.Hour, time.Minute, logger.NewNopLogger())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	received := make(chan int64, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- hub.Watch(ctx, Request{BizID: 1}, func(tr domain.NotificationTransition) error {
+			received <- tr.ID
+			return nil
+		})
+	}()
+	require.Eventually(t, func() bool {
+		hub.mu.RLock()
+		defer hub.mu.RUnlock()
+		return len(hub.watchers[1]) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	expect := func(want int64) {
+		select {
+		case got := <-received:
+			assert.Equal(t, want, got)
+		case <-time.After(time.Second):
+			t.Fatalf("没有收到状态变化 %d", want)
+		}
+	}
+
+	// 记录 2 所在的事务先分配ID，但晚于记录 3 提交
+	late := repo.reserve()
+	repo.add(domain.NotificationTransition{BizID: 1, NotificationID: 20, Key: "k2"})
+	require.NoError(t, hub.Publish(ctx, StatusEvent{BizID: 1}))
+	expect(3)
+
+	repo.commit(late, domain.NotificationTransition{BizID: 1, NotificationID: 10, Key: "k1"})
+	require.NoError(t, hub.Publish(ctx, StatusEvent{BizID: 1}))
+	expect(late)
+
+	// 回看窗口内再次拉取时不会重复推送
+	repo.add(domain.NotificationTransition{BizID: 1, NotificationID: 20, Key: "k2"})
+	require.NoError(t, hub.Publish(ctx, StatusEvent{BizID: 1}))
+	expect(4)
+	select {
+	case got := <-received:
+		t.Fatalf("重复推送了状态变化 %d", got)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
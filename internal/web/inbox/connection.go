@@ -0,0 +1,81 @@
+package inbox
+
+import (
+	"context"
+	"go-notification/internal/domain"
+	inboxsvc "go-notification/internal/service/inbox"
+	"time"
+)
+
+const replayBatchSize = 100
+
+// connKey 连接按业务和接收者分组
+type connKey struct {
+	bizID    int64
+	receiver string
+}
+
+// connection 一个 WebSocket 或 SSE 长连接
+type connection struct {
+	key connKey
+	// cursor 已推送的最后一条消息ID
+	cursor int64
+	// notify 收到推送事件时唤醒，容量为1，多个事件合并为一次拉取
+	notify chan struct{}
+}
+
+func newConnection(key connKey, cursor int64) *connection {
+	return &connection{key: key, cursor: cursor, notify: make(chan struct{}, 1)}
+}
+
+// wake 非阻塞唤醒
+func (c *connection) wake() {
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+// serve 先按游标重放未收到的消息，之后每次被唤醒都从游标处继续拉取并推送，直到连接关闭
+func (c *connection) serve(ctx context.Context, svc inboxsvc.Service, heartbeatInterval time.Duration,
+	write func(msg domain.InboxMessage) error, heartbeat func() error,
+) error {
+	if err := c.flush(ctx, svc, write); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.notify:
+			if err := c.flush(ctx, svc, write); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := heartbeat(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (c *connection) flush(ctx context.Context, svc inboxsvc.Service, write func(msg domain.InboxMessage) error) error {
+	for {
+		messages, err := svc.ListSince(ctx, c.key.bizID, c.key.receiver, c.cursor, replayBatchSize)
+		if err != nil {
+			return err
+		}
+		for i := range messages {
+			if err = write(messages[i]); err != nil {
+				return err
+			}
+			c.cursor = messages[i].ID
+		}
+		if len(messages) < replayBatchSize {
+			return nil
+		}
+	}
+}
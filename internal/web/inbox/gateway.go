@@ -0,0 +1,270 @@
+package inbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"go-notification/internal/api/grpc/interceptor/jwt"
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/ginx"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/pkg/task"
+	inboxsvc "go-notification/internal/service/inbox"
+	"golang.org/x/net/websocket"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	_ ginx.Handler = (*Gateway)(nil)
+	_ task.Task    = (*Gateway)(nil)
+
+	errUnauthorized = errors.New("未授权")
+	errBadRequest   = errors.New("请求参数错误")
+)
+
+// receiverClaim 令牌中可选的接收者声明，存在时连接只能订阅该接收者
+const receiverClaim = "receiver"
+
+// Gateway 站内信实时推送网关，维持每个接收者的 WebSocket/SSE 长连接。
+// 推送事件经 Redis pub/sub 扇出到所有实例，实例只唤醒本地连接，由连接按游标从收件箱拉取，
+// 因此实时推送和断线重放是同一条路径。
+type Gateway struct {
+	svc               inboxsvc.Service
+	subscriber        inboxsvc.Subscriber
+	auth              *jwt.InterceptorBuilder
+	heartbeatInterval time.Duration
+	logger            logger.Logger
+
+	mu    sync.RWMutex
+	conns map[connKey]map[*connection]struct{}
+}
+
+func NewGateway(svc inboxsvc.Service, subscriber inboxsvc.Subscriber, auth *jwt.InterceptorBuilder,
+	heartbeatInterval time.Duration, logger logger.Logger,
+) *Gateway {
+	return &Gateway{
+		svc:               svc,
+		subscriber:        subscriber,
+		auth:              auth,
+		heartbeatInterval: heartbeatInterval,
+		logger:            logger,
+		conns:             make(map[connKey]map[*connection]struct{}),
+	}
+}
+
+func (g *Gateway) PrivateRoutes(_ *gin.Engine) {
+}
+
+func (g *Gateway) PublicRoutes(server *gin.Engine) {
+	r := server.Group("/inbox")
+	r.GET("/ws", g.WebSocket)
+	r.GET("/sse", g.SSE)
+}
+
+// Start 订阅推送事件直到 ctx 结束，订阅中断时自动重试
+func (g *Gateway) Start(ctx context.Context) {
+	const retryInterval = time.Second
+	for {
+		err := g.subscriber.Subscribe(ctx, g.dispatch)
+		if ctx.Err() != nil {
+			return
+		}
+		g.logger.Warn("站内信推送订阅中断，稍后重试", logger.Error(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// dispatch 唤醒本实例上相关接收者的所有连接
+func (g *Gateway) dispatch(evt inboxsvc.PushEvent) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for _, receiver := range evt.Receivers {
+		for conn := range g.conns[connKey{bizID: evt.BizID, receiver: receiver}] {
+			conn.wake()
+		}
+	}
+}
+
+// WebSocket 通过 WebSocket 推送，重连时通过 last_id 参数传入最后收到的消息ID
+func (g *Gateway) WebSocket(ctx *gin.Context) {
+	key, err := g.authenticate(ctx)
+	if err != nil {
+		g.abort(ctx, err)
+		return
+	}
+	cursor, err := g.cursor(ctx, key, ctx.Query("last_id"))
+	if err != nil {
+		g.abort(ctx, err)
+		return
+	}
+
+	// 鉴权已通过令牌完成，不再校验 Origin
+	websocket.Server{Handler: func(ws *websocket.Conn) {
+		defer ws.Close()
+		connCtx, cancel := context.WithCancel(ctx.Request.Context())
+		defer cancel()
+		// 客户端无需上行消息，读取只为感知连接关闭
+		go func() {
+			defer cancel()
+			var discard string
+			for websocket.Message.Receive(ws, &discard) == nil {
+			}
+		}()
+
+		g.serve(connCtx, newConnection(key, cursor),
+			func(msg domain.InboxMessage) error {
+				m := toMessage(msg)
+				return websocket.JSON.Send(ws, Frame{Type: frameTypeMessage, Data: &m})
+			},
+			func() error {
+				return websocket.JSON.Send(ws, Frame{Type: frameTypePing})
+			})
+	}}.ServeHTTP(ctx.Writer, ctx.Request)
+}
+
+// SSE 通过 Server-Sent Events 推送，事件ID即消息ID，浏览器重连时会自动携带 Last-Event-ID
+func (g *Gateway) SSE(ctx *gin.Context) {
+	key, err := g.authenticate(ctx)
+	if err != nil {
+		g.abort(ctx, err)
+		return
+	}
+	lastID := ctx.GetHeader("Last-Event-ID")
+	if lastID == "" {
+		lastID = ctx.Query("last_id")
+	}
+	cursor, err := g.cursor(ctx, key, lastID)
+	if err != nil {
+		g.abort(ctx, err)
+		return
+	}
+
+	w := ctx.Writer
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	g.serve(ctx.Request.Context(), newConnection(key, cursor),
+		func(msg domain.InboxMessage) error {
+			data, er := json.Marshal(toMessage(msg))
+			if er != nil {
+				return er
+			}
+			if _, er = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", msg.ID, frameTypeMessage, data); er != nil {
+				return er
+			}
+			w.Flush()
+			return nil
+		},
+		func() error {
+			if _, er := fmt.Fprint(w, ": ping\n\n"); er != nil {
+				return er
+			}
+			w.Flush()
+			return nil
+		})
+}
+
+func (g *Gateway) serve(ctx context.Context, conn *connection,
+	write func(msg domain.InboxMessage) error, heartbeat func() error,
+) {
+	g.register(conn)
+	defer g.unregister(conn)
+
+	err := conn.serve(ctx, g.svc, g.heartbeatInterval, write, heartbeat)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		g.logger.Warn("站内信推送连接异常断开",
+			logger.Error(err),
+			logger.Int64("bizID", conn.key.bizID),
+			logger.String("receiver", conn.key.receiver))
+	}
+}
+
+func (g *Gateway) register(conn *connection) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	conns, ok := g.conns[conn.key]
+	if !ok {
+		conns = make(map[*connection]struct{})
+		g.conns[conn.key] = conns
+	}
+	conns[conn] = struct{}{}
+}
+
+func (g *Gateway) unregister(conn *connection) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	conns := g.conns[conn.key]
+	delete(conns, conn)
+	if len(conns) == 0 {
+		delete(g.conns, conn.key)
+	}
+}
+
+// authenticate 使用与 gRPC 相同的 HMAC JWT 鉴权。
+// 浏览器的 WebSocket 和 EventSource 无法设置请求头，因此也支持通过 token 参数传递令牌。
+func (g *Gateway) authenticate(ctx *gin.Context) (connKey, error) {
+	token := ctx.GetHeader("Authorization")
+	if token == "" {
+		token = ctx.Query("token")
+	}
+	if token == "" {
+		return connKey{}, fmt.Errorf("%w: 缺少令牌", errUnauthorized)
+	}
+	claims, err := g.auth.Decode(token)
+	if err != nil {
+		return connKey{}, fmt.Errorf("%w: %w", errUnauthorized, err)
+	}
+	bizID, err := jwt.BizIDFromClaims(claims)
+	if err != nil {
+		return connKey{}, fmt.Errorf("%w: %w", errUnauthorized, err)
+	}
+
+	receiver := ctx.Query("receiver")
+	if claimed, ok := claims[receiverClaim].(string); ok && claimed != "" {
+		if receiver != "" && receiver != claimed {
+			return connKey{}, fmt.Errorf("%w: 接收者与令牌不匹配", errUnauthorized)
+		}
+		receiver = claimed
+	}
+	if receiver == "" {
+		return connKey{}, fmt.Errorf("%w: 接收者不能为空", errBadRequest)
+	}
+	return connKey{bizID: bizID, receiver: receiver}, nil
+}
+
+// cursor 解析客户端最后收到的消息ID，未传时从最新消息开始，只推送之后的新消息
+func (g *Gateway) cursor(ctx *gin.Context, key connKey, lastID string) (int64, error) {
+	if lastID == "" {
+		return g.svc.LatestID(ctx.Request.Context(), key.bizID, key.receiver)
+	}
+	cursor, err := strconv.ParseInt(lastID, 10, 64)
+	if err != nil || cursor < 0 {
+		return 0, fmt.Errorf("%w: last_id=%s", errBadRequest, lastID)
+	}
+	return cursor, nil
+}
+
+func (g *Gateway) abort(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, errUnauthorized):
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, ginx.Result{Code: http.StatusUnauthorized, Msg: err.Error()})
+	case errors.Is(err, errBadRequest):
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, ginx.Result{Code: http.StatusBadRequest, Msg: err.Error()})
+	default:
+		g.logger.Error("建立站内信推送连接失败", logger.Error(err))
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, ginx.Result{Code: http.StatusInternalServerError, Msg: "系统错误"})
+	}
+}
@@ -0,0 +1,203 @@
+package inbox
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	gojwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go-notification/internal/api/grpc/interceptor/jwt"
+	"go-notification/internal/domain"
+	"go-notification/internal/pkg/logger"
+	inboxsvc "go-notification/internal/service/inbox"
+	"golang.org/x/net/websocket"
+)
+
+// memoryInbox 内存收件箱
+type memoryInbox struct {
+	inboxsvc.Service
+	mu       sync.Mutex
+	messages []domain.InboxMessage
+}
+
+func (m *memoryInbox) add(msg domain.InboxMessage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+}
+
+func (m *memoryInbox) ListSince(_ context.Context, bizID int64, receiver string, afterID int64, limit int) ([]domain.InboxMessage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var res []domain.InboxMessage
+	for _, msg := range m.messages {
+		if msg.BizID == bizID && msg.Receiver == receiver && msg.ID > afterID && len(res) < limit {
+			res = append(res, msg)
+		}
+	}
+	return res, nil
+}
+
+func (m *memoryInbox) LatestID(_ context.Context, bizID int64, receiver string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var id int64
+	for _, msg := range m.messages {
+		if msg.BizID == bizID && msg.Receiver == receiver && msg.ID > id {
+			id = msg.ID
+		}
+	}
+	return id, nil
+}
+
+func newTestGateway(t *testing.T) (*Gateway, *memoryInbox, *httptest.Server, *jwt.InterceptorBuilder) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	store := &memoryInbox{}
+	auth := jwt.NewJwtAuth("test_key")
+	gateway := NewGateway(store, nil, auth, time.Minute, logger.NewNopLogger())
+	server := gin.New()
+	gateway.PublicRoutes(server)
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+	return gateway, store, ts, auth
+}
+
+func encodeToken(t *testing.T, auth *jwt.InterceptorBuilder, claims gojwt.MapClaims) string {
+	t.Helper()
+	token, err := auth.Encode(claims)
+	require.NoError(t, err)
+	return token
+}
+
+// waitConnected 等待连接注册到网关
+func waitConnected(t *testing.T, g *Gateway, key connKey) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		g.mu.RLock()
+		defer g.mu.RUnlock()
+		return len(g.conns[key]) > 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGateway_SSE(t *testing.T) {
+	t.Parallel()
+	gateway, store, ts, auth := newTestGateway(t)
+	store.add(domain.InboxMessage{ID: 1, BizID: 1, Receiver: "u1", Title: "旧消息"})
+	store.add(domain.InboxMessage{ID: 2, BizID: 1, Receiver: "u1", Title: "断线期间的消息"})
+
+	token := encodeToken(t, auth, gojwt.MapClaims{jwt.BizIDName: 1, receiverClaim: "u1"})
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/inbox/sse?token="+token, nil)
+	require.NoError(t, err)
+	// 模拟浏览器重连
+	req.Header.Set("Last-Event-ID", "1")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	readEventID := func() string {
+		for {
+			line, er := reader.ReadString('\n')
+			require.NoError(t, er)
+			if strings.HasPrefix(line, "id: ") {
+				return strings.TrimSpace(strings.TrimPrefix(line, "id: "))
+			}
+		}
+	}
+
+	// 重放游标之后的消息
+	assert.Equal(t, "2", readEventID())
+
+	// 发送成功后实时推送
+	waitConnected(t, gateway, connKey{bizID: 1, receiver: "u1"})
+	store.add(domain.InboxMessage{ID: 3, BizID: 1, Receiver: "u1", Title: "新消息"})
+	store.add(domain.InboxMessage{ID: 4, BizID: 2, Receiver: "u1", Title: "其他业务"})
+	gateway.dispatch(inboxsvc.PushEvent{BizID: 1, NotificationID: 100, Receivers: []string{"u1"}})
+	assert.Equal(t, "3", readEventID())
+}
+
+func TestGateway_WebSocket(t *testing.T) {
+	t.Parallel()
+	gateway, store, ts, auth := newTestGateway(t)
+	store.add(domain.InboxMessage{ID: 1, BizID: 1, Receiver: "u1", Title: "旧消息"})
+
+	wsURL := "ws" + strings.TrimPrefix(ts.URL, "http")
+	token := encodeToken(t, auth, gojwt.MapClaims{jwt.BizIDName: 1})
+	ws, err := websocket.Dial(fmt.Sprintf("%s/inbox/ws?token=%s&receiver=u1", wsURL, token), "", ts.URL)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	// 未携带 last_id 时不重放历史消息，只推送新消息
+	waitConnected(t, gateway, connKey{bizID: 1, receiver: "u1"})
+	store.add(domain.InboxMessage{ID: 2, BizID: 1, Receiver: "u1", Title: "新消息"})
+	gateway.dispatch(inboxsvc.PushEvent{BizID: 1, NotificationID: 100, Receivers: []string{"u1"}})
+
+	var frame Frame
+	require.NoError(t, ws.SetReadDeadline(time.Now().Add(5*time.Second)))
+	require.NoError(t, websocket.JSON.Receive(ws, &frame))
+	assert.Equal(t, frameTypeMessage, frame.Type)
+	require.NotNil(t, frame.Data)
+	assert.Equal(t, int64(2), frame.Data.ID)
+	assert.Equal(t, "新消息", frame.Data.Title)
+}
+
+func TestGateway_Authenticate(t *testing.T) {
+	t.Parallel()
+	_, _, ts, auth := newTestGateway(t)
+
+	testCases := []struct {
+		name     string
+		query    string
+		wantCode int
+	}{
+		{
+			name:     "缺少令牌",
+			query:    "receiver=u1",
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "令牌签名错误",
+			query:    "receiver=u1&token=" + encodeToken(t, jwt.NewJwtAuth("other_key"), gojwt.MapClaims{jwt.BizIDName: 1}),
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "接收者与令牌不匹配",
+			query:    "receiver=u2&token=" + encodeToken(t, auth, gojwt.MapClaims{jwt.BizIDName: 1, receiverClaim: "u1"}),
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "缺少接收者",
+			query:    "token=" + encodeToken(t, auth, gojwt.MapClaims{jwt.BizIDName: 1}),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "游标非法",
+			query:    "receiver=u1&last_id=abc&token=" + encodeToken(t, auth, gojwt.MapClaims{jwt.BizIDName: 1}),
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resp, err := http.Get(ts.URL + "/inbox/sse?" + tc.query)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.wantCode, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package inbox
+
+import "go-notification/internal/domain"
+
+const (
+	frameTypeMessage = "message"
+	frameTypePing    = "ping"
+)
+
+// Frame WebSocket 下发的数据帧
+type Frame struct {
+	Type string   `json:"type"`
+	Data *Message `json:"data,omitempty"`
+}
+
+// Message 推送给客户端的站内信
+type Message struct {
+	ID             int64  `json:"id"`             // 消息ID，重连时作为 last_id
+	NotificationID int64  `json:"notificationId"` // 关联的通知ID
+	Title          string `json:"title"`          // 标题
+	Content        string `json:"content"`        // 内容
+	Read           bool   `json:"read"`           // 是否已读
+	Ctime          int64  `json:"ctime"`          // 创建时间
+}
+
+func toMessage(src domain.InboxMessage) Message {
+	return Message{
+		ID:             src.ID,
+		NotificationID: src.NotificationID,
+		Title:          src.Title,
+		Content:        src.Content,
+		Read:           src.Read,
+		Ctime:          src.Ctime,
+	}
+}
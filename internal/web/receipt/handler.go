@@ -0,0 +1,59 @@
+package receipt
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go-notification/internal/errs"
+	"go-notification/internal/pkg/ginx"
+	"go-notification/internal/pkg/logger"
+	"go-notification/internal/service/delivery"
+	"io"
+	"net/http"
+)
+
+var _ ginx.Handler = &Handler{}
+
+// Handler 接收供应商推送的送达回执
+type Handler struct {
+	svc    delivery.Service
+	logger logger.Logger
+}
+
+func NewHandler(svc delivery.Service, logger logger.Logger) *Handler {
+	return &Handler{svc: svc, logger: logger}
+}
+
+func (h *Handler) PrivateRoutes(_ *gin.Engine) {
+}
+
+func (h *Handler) PublicRoutes(server *gin.Engine) {
+	g := server.Group("/receipts")
+	// 在供应商控制台配置回执地址时带上供应商名称和供应商表中的回执令牌，例如 /receipts/sms/aliyun/{token}
+	g.POST("/sms/:provider/:token", ginx.W(h.SMSReceipt))
+}
+
+// SMSReceipt 处理短信回执推送，按供应商要求的格式应答，应答失败时供应商会重推
+func (h *Handler) SMSReceipt(ctx *gin.Context) (ginx.Result, error) {
+	provider := ctx.Param("provider")
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.Status(http.StatusBadRequest)
+		return ginx.Result{}, ginx.ErrNoResponse
+	}
+
+	ack, err := h.svc.HandleReceipt(ctx.Request.Context(), provider, ctx.Param("token"), body)
+	if err != nil {
+		h.logger.Warn("处理短信回执失败", logger.String("provider", provider), logger.Error(err))
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, errs.ErrReceiptUnauthorized):
+			status = http.StatusUnauthorized
+		case errors.Is(err, errs.ErrProviderNotFound) || errors.Is(err, errs.ErrInvalidParameter):
+			status = http.StatusBadRequest
+		}
+		ctx.Status(status)
+		return ginx.Result{}, ginx.ErrNoResponse
+	}
+	ctx.Data(http.StatusOK, "application/json; charset=utf-8", ack)
+	return ginx.Result{}, ginx.ErrNoResponse
+}
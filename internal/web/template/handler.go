@@ -115,6 +115,7 @@ func (h *Handler) UpdateVersion(ctx *gin.Context, req UpdateVersionReq) (ginx.Re
 		Id:        req.VersionID,
 		Name:      req.Name,
 		Signature: req.Signature,
+		Subject:   req.Subject,
 		Content:   req.Content,
 		Remark:    req.Remark,
 	}
@@ -160,6 +161,7 @@ func (h *Handler) toVersionVO(src domain.ChannelTemplateVersion) ChannelTemplate
 		ChannelTemplateID:        src.ChannelTemplateID,
 		Name:                     src.Name,
 		Signature:                src.Signature,
+		Subject:                  src.Subject,
 		Content:                  src.Content,
 		Remark:                   src.Remark,
 		AuditID:                  src.AuditId,
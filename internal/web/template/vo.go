@@ -31,6 +31,7 @@ type ChannelTemplateVersion struct {
 	ChannelTemplateID        int64  `json:"channelTemplateId"`        // 模版ID
 	Name                     string `json:"name"`                     // 模版名称
 	Signature                string `json:"signature"`                // 签名
+	Subject                  string `json:"subject"`                  // 主题，邮件主题或站内信标题
 	Content                  string `json:"content"`                  // 模版内容
 	Remark                   string `json:"remark"`                   // 申请说明
 	AuditID                  int64  `json:"auditId"`                  // 审核记录ID
@@ -99,6 +100,7 @@ type UpdateVersionReq struct {
 	VersionID int64  `json:"versionId"`
 	Name      string `json:"name"`
 	Signature string `json:"signature"`
+	Subject   string `json:"subject"`
 	Content   string `json:"content"`
 	Remark    string `json:"remark"`
 }
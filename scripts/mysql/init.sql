@@ -0,0 +1,4 @@
+CREATE DATABASE IF NOT EXISTS `notification`;
+-- 开启分库检索时使用的分库，表在服务启动时创建
+CREATE DATABASE IF NOT EXISTS `notification_0`;
+CREATE DATABASE IF NOT EXISTS `notification_1`;